@@ -120,7 +120,13 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/client/docs/statik"
 )
 
-const appName = "WasmApp"
+const (
+	appName = "WasmApp"
+
+	// ibcCallbackGasLimit is the max gas a contract can spend on the callback for an ICS-20 transfer or interchain
+	// account tx it sent. The gas is paid by the relayer of the acknowledgement or timeout, so it is kept low.
+	ibcCallbackGasLimit = 200_000
+)
 
 // We pull these out so we can set them with LDFLAGS in the Makefile
 var (
@@ -277,6 +283,7 @@ type WasmApp struct {
 	ScopedTransferKeeper      capabilitykeeper.ScopedKeeper
 	ScopedIBCFeeKeeper        capabilitykeeper.ScopedKeeper
 	ScopedWasmKeeper          capabilitykeeper.ScopedKeeper
+	ScopedWasmICAKeeper       capabilitykeeper.ScopedKeeper
 
 	// the module manager
 	mm *module.Manager
@@ -318,7 +325,7 @@ func NewWasmApp(
 		feegrant.StoreKey, authzkeeper.StoreKey, wasm.StoreKey, icahosttypes.StoreKey,
 		icacontrollertypes.StoreKey, intertxtypes.StoreKey, ibcfeetypes.StoreKey,
 	)
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, wasm.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	app := &WasmApp{
@@ -354,6 +361,7 @@ func NewWasmApp(
 	scopedInterTxKeeper := app.CapabilityKeeper.ScopeToModule(intertxtypes.ModuleName)
 	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
 	scopedWasmKeeper := app.CapabilityKeeper.ScopeToModule(wasm.ModuleName)
+	scopedWasmICAKeeper := app.CapabilityKeeper.ScopeToModule(wasm.ICAControllerModuleName)
 	app.CapabilityKeeper.Seal()
 
 	// add keepers
@@ -515,6 +523,22 @@ func NewWasmApp(
 	// if we want to allow any custom callbacks
 	// See https://github.com/CosmWasm/cosmwasm/blob/main/docs/CAPABILITIES-BUILT-IN.md
 	availableCapabilities := "iterator,staking,stargate,cosmwasm_1_1,cosmwasm_1_2"
+	// call back contracts on acknowledgement or timeout of the ICS-20 transfers they send, let contracts own
+	// interchain accounts, read and pay ICS-29 fees and acknowledge packets asynchronously. This reserves the
+	// `ibc_fee` and `write_acknowledgement` custom message keys. Asynchronous acks are written through the fee
+	// middleware that wraps the wasm stack. Custom options can overwrite it
+	wasmOpts = append([]wasm.Option{
+		wasmkeeper.WithIBCTransferCallbacks(ibcCallbackGasLimit),
+		wasmkeeper.WithICAController(app.ICAControllerKeeper, scopedWasmICAKeeper, ibcCallbackGasLimit),
+		wasmkeeper.WithIBCFeeQueries(app.IBCFeeKeeper),
+		wasmkeeper.WithIBCFeeMessages(),
+		wasmkeeper.WithIBCAsyncAcks(),
+		wasmkeeper.WithScheduledCallbacks(),
+		wasmkeeper.WithICS4Wrapper(app.IBCFeeKeeper),
+	}, wasmOpts...)
+	if wasmConfig.StateStreamingFile != "" {
+		wasmOpts = append(wasmOpts, wasmkeeper.WithStateStreaming(tkeys[wasm.TStoreKey]))
+	}
 	app.WasmKeeper = wasm.NewKeeper(
 		appCodec,
 		keys[wasm.StoreKey],
@@ -543,6 +567,9 @@ func NewWasmApp(
 	// Create Transfer Stack
 	var transferStack porttypes.IBCModule
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
+	// execute contracts with the received tokens when the ICS-20 memo contains a wasm hook
+	transferStack = wasm.NewIBCHooksMiddleware(transferStack, wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper))
+	transferStack = wasm.NewIBCTransferCallbacksMiddleware(transferStack, app.WasmKeeper)
 	transferStack = ibcfee.NewIBCMiddleware(transferStack, app.IBCFeeKeeper)
 
 	// Create Interchain Accounts Stack
@@ -554,6 +581,8 @@ func NewWasmApp(
 	var icaControllerStack porttypes.IBCModule
 	// You will likely want to use your own reviewed and maintained ica auth module
 	icaControllerStack = intertx.NewIBCModule(app.InterTxKeeper)
+	// contracts own the interchain accounts for their controller ports, all other ports are handled by intertx
+	icaControllerStack = wasm.NewICAControllerMiddleware(icaControllerStack, app.WasmKeeper)
 	icaControllerStack = icacontroller.NewIBCMiddleware(icaControllerStack, app.ICAControllerKeeper)
 	icaControllerStack = ibcfee.NewIBCMiddleware(icaControllerStack, app.IBCFeeKeeper)
 
@@ -573,6 +602,7 @@ func NewWasmApp(
 		AddRoute(ibctransfertypes.ModuleName, transferStack).
 		AddRoute(wasm.ModuleName, wasmStack).
 		AddRoute(intertxtypes.ModuleName, icaControllerStack).
+		AddRoute(wasm.ICAControllerModuleName, icaControllerStack).
 		AddRoute(icacontrollertypes.SubModuleName, icaControllerStack).
 		AddRoute(icahosttypes.SubModuleName, icaHostStack)
 	app.IBCKeeper.SetRouter(ibcRouter)
@@ -774,6 +804,22 @@ func NewWasmApp(
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 
+	// stream the contract state changes when enabled in the wasm config
+	if path := wasmConfig.StateStreamingFile; path != "" {
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(homePath, path)
+		}
+		sink, err := wasmkeeper.NewFileStateChangeSink(path)
+		if err != nil {
+			panic(fmt.Errorf("failed to create wasm state streaming sink: %s", err))
+		}
+		app.SetStreamingService(wasmkeeper.NewStateStreamingService(tkeys[wasm.TStoreKey], sink))
+	}
+	// publish the contract events to gRPC subscribers when enabled in the wasm config
+	if broker := app.WasmKeeper.ContractEventBroker(); broker != nil {
+		app.SetStreamingService(broker)
+	}
+
 	// must be before Loading version
 	// requires the snapshot store to be created and registered as a BaseAppOption
 	// see cmd/wasmd/root.go: 206 - 214 approx
@@ -789,6 +835,7 @@ func NewWasmApp(
 	app.ScopedIBCKeeper = scopedIBCKeeper
 	app.ScopedTransferKeeper = scopedTransferKeeper
 	app.ScopedWasmKeeper = scopedWasmKeeper
+	app.ScopedWasmICAKeeper = scopedWasmICAKeeper
 	app.ScopedICAHostKeeper = scopedICAHostKeeper
 	app.ScopedICAControllerKeeper = scopedICAControllerKeeper
 	app.ScopedInterTxKeeper = scopedInterTxKeeper
@@ -1,7 +1,9 @@
 package cli
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -123,6 +125,239 @@ func ClearContractAdminCmd() *cobra.Command {
 	return cmd
 }
 
+// DeleteContractCmd removes a contract with all its state
+func DeleteContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete-contract [contract_addr_bech32] [recipient_addr_bech32]",
+		Short:   "Deletes a contract with all its state and sends the remaining balance to the recipient",
+		Aliases: []string{"delete", "del"},
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgDeleteContract{
+				Sender:    clientCtx.GetFromAddress().String(),
+				Contract:  args[0],
+				Recipient: args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// RemoveCodeCmd removes a code that is not used by any contract
+func RemoveCodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-code [code_id_int64]",
+		Short: "Removes a code that is not used by any contract and not pinned",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "code id")
+			}
+
+			msg := types.MsgRemoveCode{
+				Sender: clientCtx.GetFromAddress().String(),
+				CodeID: codeID,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// UpdateCodeMetadataCmd sets the source and builder of a code to allow verification of the wasm byte code
+func UpdateCodeMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-code-metadata [code_id_int64] [source_url] [builder]",
+		Short: "Sets the source URL and builder image of a code to link it to a reproducible build",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "code id")
+			}
+
+			msg := types.MsgUpdateCodeMetadata{
+				Sender:  clientCtx.GetFromAddress().String(),
+				CodeID:  codeID,
+				Source:  args[1],
+				Builder: args[2],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// FreezeContractCmd pauses a contract in an emergency
+func FreezeContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-contract [contract_addr_bech32]",
+		Short: "Freezes a contract so that it rejects executions, migrations and IBC packets",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgFreezeContract{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// UnfreezeContractCmd resumes a frozen contract
+func UnfreezeContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze-contract [contract_addr_bech32]",
+		Short: "Unfreezes a frozen contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUnfreezeContract{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// DisableOperationsCmd trips the circuit breaker for wasm operations
+func DisableOperationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disable-operations [operation]...",
+		Short: "Disables wasm operations module wide",
+		Long: fmt.Sprintf("Disables wasm operations module wide. Valid operations are: %s",
+			strings.Join(operationTypeNames(), ", ")),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			ops, err := parseOperationTypes(args)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgDisableOperations{
+				Sender:     clientCtx.GetFromAddress().String(),
+				Operations: ops,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// EnableOperationsCmd resets the circuit breaker for wasm operations
+func EnableOperationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enable-operations [operation]...",
+		Short: "Enables disabled wasm operations",
+		Long: fmt.Sprintf("Enables disabled wasm operations. Valid operations are: %s",
+			strings.Join(operationTypeNames(), ", ")),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			ops, err := parseOperationTypes(args)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgEnableOperations{
+				Sender:     clientCtx.GetFromAddress().String(),
+				Operations: ops,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseOperationTypes(args []string) ([]types.OperationType, error) {
+	ops := make([]types.OperationType, len(args))
+	for i, a := range args {
+		op, err := types.ParseOperationType(a)
+		if err != nil {
+			return nil, err
+		}
+		ops[i] = op
+	}
+	return ops, nil
+}
+
+func operationTypeNames() []string {
+	names := make([]string, len(types.AllOperationTypes))
+	for i, op := range types.AllOperationTypes {
+		names[i] = strings.ToLower(strings.TrimPrefix(op.String(), "OPERATION_TYPE_"))
+	}
+	return names
+}
+
 // UpdateInstantiateConfigCmd updates instantiate config for a smart contract.
 func UpdateInstantiateConfigCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -43,6 +43,13 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdBuildAddress(),
 		GetCmdListContractsByCreator(),
+		GetCmdListContractsByAdmin(),
+		GetCmdListContractsByLabel(),
+		GetCmdGetContractStorageStats(),
+		GetCmdQueryDisabledOperations(),
+		GetCmdListCronJobs(),
+		GetCmdListContractCallbacks(),
+		GetCmdListCodeIDsByChecksum(),
 	)
 	return queryCmd
 }
@@ -311,6 +318,42 @@ func GetCmdGetContractInfo() *cobra.Command {
 	return cmd
 }
 
+// GetCmdGetContractStorageStats prints the accounted size of a contract's state
+func GetCmdGetContractStorageStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "contract-storage-stats [bech32_address]",
+		Short:   "Prints out the number of entries and bytes stored by a contract",
+		Long:    "Prints out the number of entries and bytes stored by a contract",
+		Aliases: []string{"storage-stats"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractStorageStats(
+				context.Background(),
+				&types.QueryContractStorageStatsRequest{
+					Address: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdGetContractState dumps full internal state of a given contract
 func GetCmdGetContractState() *cobra.Command {
 	cmd := &cobra.Command{
@@ -326,16 +369,20 @@ func GetCmdGetContractState() *cobra.Command {
 		GetCmdGetContractStateAll(),
 		GetCmdGetContractStateRaw(),
 		GetCmdGetContractStateSmart(),
+		GetCmdGetContractStateSmartBatch(),
 	)
 	return cmd
 }
 
 func GetCmdGetContractStateAll() *cobra.Command {
+	decoder := newArgDecoder(hex.DecodeString)
 	cmd := &cobra.Command{
 		Use:   "all [bech32_address]",
 		Short: "Prints out all internal state of a contract given its address",
-		Long:  "Prints out all internal state of a contract given its address",
-		Args:  cobra.ExactArgs(1),
+		Long: `Prints out all internal state of a contract given its address.
+The state can be restricted to a key prefix and a range with start and end bounds relative to the prefix.
+Keys are hex encoded by default.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -352,13 +399,46 @@ func GetCmdGetContractStateAll() *cobra.Command {
 				return err
 			}
 			queryClient := types.NewQueryClient(clientCtx)
-			res, err := queryClient.AllContractState(
-				context.Background(),
-				&types.QueryAllContractStateRequest{
-					Address:    args[0],
-					Pagination: pageReq,
-				},
-			)
+			if !isRangeQuery(cmd.Flags()) {
+				res, err := queryClient.AllContractState(
+					context.Background(),
+					&types.QueryAllContractStateRequest{
+						Address:    args[0],
+						Pagination: pageReq,
+					},
+				)
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
+			req := types.QueryContractStateRangeRequest{
+				Address:    args[0],
+				Pagination: pageReq,
+			}
+			for flagName, target := range map[string]*[]byte{flagPrefix: &req.Prefix, flagStart: &req.Start, flagEnd: &req.End} {
+				v, err := cmd.Flags().GetString(flagName)
+				if err != nil {
+					return err
+				}
+				if v == "" {
+					continue
+				}
+				if *target, err = decoder.DecodeString(v); err != nil {
+					return fmt.Errorf("decode %s: %s", flagName, err)
+				}
+			}
+			if req.StartExclusive, err = cmd.Flags().GetBool(flagStartExclusive); err != nil {
+				return err
+			}
+			if req.EndInclusive, err = cmd.Flags().GetBool(flagEndInclusive); err != nil {
+				return err
+			}
+			if req.KeysOnly, err = cmd.Flags().GetBool(flagKeysOnly); err != nil {
+				return err
+			}
+			res, err := queryClient.ContractStateRange(context.Background(), &req)
 			if err != nil {
 				return err
 			}
@@ -366,11 +446,28 @@ func GetCmdGetContractStateAll() *cobra.Command {
 		},
 		SilenceUsage: true,
 	}
+	cmd.Flags().String(flagPrefix, "", "Only keys starting with this prefix")
+	cmd.Flags().String(flagStart, "", "Lower bound of the keys, relative to the prefix (inclusive)")
+	cmd.Flags().String(flagEnd, "", "Upper bound of the keys, relative to the prefix (exclusive)")
+	cmd.Flags().Bool(flagStartExclusive, false, "Exclude the start key")
+	cmd.Flags().Bool(flagEndInclusive, false, "Include the end key")
+	cmd.Flags().Bool(flagKeysOnly, false, "Print keys without values")
+	decoder.RegisterFlags(cmd.PersistentFlags(), "prefix, start and end keys")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "contract state")
 	return cmd
 }
 
+// isRangeQuery returns true when any of the range flags was set
+func isRangeQuery(flagSet *flag.FlagSet) bool {
+	for _, n := range []string{flagPrefix, flagStart, flagEnd, flagStartExclusive, flagEndInclusive, flagKeysOnly} {
+		if flagSet.Changed(n) {
+			return true
+		}
+	}
+	return false
+}
+
 func GetCmdGetContractStateRaw() *cobra.Command {
 	decoder := newArgDecoder(hex.DecodeString)
 	cmd := &cobra.Command{
@@ -462,6 +559,60 @@ func GetCmdGetContractStateSmart() *cobra.Command {
 	return cmd
 }
 
+// GetCmdGetContractStateSmartBatch queries multiple contracts against the same store version
+func GetCmdGetContractStateSmartBatch() *cobra.Command {
+	decoder := newArgDecoder(asciiDecodeString)
+	cmd := &cobra.Command{
+		Use:   "smart-batch [bech32_address] [query] [[bech32_address] [query]...]",
+		Short: "Calls multiple contracts with query data and prints the returned results",
+		Long:  "Calls multiple contracts with query data against the same block height and prints the returned results. Arguments are pairs of contract address and query.",
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 || len(args)%2 != 0 {
+				return errors.New("expected pairs of contract address and query")
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queries := make([]types.BatchSmartQuery, 0, len(args)/2)
+			for i := 0; i < len(args); i += 2 {
+				if _, err := sdk.AccAddressFromBech32(args[i]); err != nil {
+					return err
+				}
+				if args[i+1] == "" {
+					return errors.New("query data must not be empty")
+				}
+				queryData, err := decoder.DecodeString(args[i+1])
+				if err != nil {
+					return fmt.Errorf("decode query: %s", err)
+				}
+				if !json.Valid(queryData) {
+					return errors.New("query data must be json")
+				}
+				queries = append(queries, types.BatchSmartQuery{Address: args[i], QueryData: queryData})
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BatchSmartContractState(
+				context.Background(),
+				&types.QueryBatchSmartContractStateRequest{Queries: queries},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	decoder.RegisterFlags(cmd.PersistentFlags(), "query argument")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdGetContractHistory prints the code history for a given contract
 func GetCmdGetContractHistory() *cobra.Command {
 	cmd := &cobra.Command{
@@ -485,12 +636,25 @@ func GetCmdGetContractHistory() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			opsArg, err := cmd.Flags().GetStringSlice(flagOperations)
+			if err != nil {
+				return err
+			}
+			var ops []types.ContractCodeHistoryOperationType
+			for _, a := range opsArg {
+				op, err := types.ParseContractCodeHistoryOperationType(a)
+				if err != nil {
+					return err
+				}
+				ops = append(ops, op)
+			}
 			queryClient := types.NewQueryClient(clientCtx)
 			res, err := queryClient.ContractHistory(
 				context.Background(),
 				&types.QueryContractHistoryRequest{
 					Address:    args[0],
 					Pagination: pageReq,
+					Operations: ops,
 				},
 			)
 			if err != nil {
@@ -504,6 +668,7 @@ func GetCmdGetContractHistory() *cobra.Command {
 
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "contract history")
+	cmd.Flags().StringSlice(flagOperations, nil, "Only list entries of the given operation types, for example init,migrate,admin_update,admin_clear")
 	return cmd
 }
 
@@ -543,6 +708,44 @@ func GetCmdListPinnedCode() *cobra.Command {
 	return cmd
 }
 
+// GetCmdListCodeIDsByChecksum lists all code ids that were stored with the same wasm code
+func GetCmdListCodeIDsByChecksum() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list-code-by-checksum [checksum_hex]",
+		Short:   "List all code ids stored with the given checksum",
+		Long:    "List all code ids stored with the given checksum",
+		Aliases: []string{"code-by-checksum"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CodeIDsByChecksum(
+				context.Background(),
+				&types.QueryCodeIDsByChecksumRequest{
+					Checksum:   args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list code ids by checksum")
+	return cmd
+}
+
 // GetCmdListContractsByCreator lists all contracts by creator
 func GetCmdListContractsByCreator() *cobra.Command {
 	cmd := &cobra.Command{
@@ -583,6 +786,101 @@ func GetCmdListContractsByCreator() *cobra.Command {
 	return cmd
 }
 
+// GetCmdListContractsByAdmin lists all contracts by admin
+func GetCmdListContractsByAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-contracts-by-admin [admin]",
+		Short: "List all contracts by admin",
+		Long:  "List all contracts that can be migrated by the given admin",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractsByAdmin(
+				context.Background(),
+				&types.QueryContractsByAdminRequest{
+					AdminAddress: args[0],
+					Pagination:   pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list contracts by admin")
+	return cmd
+}
+
+// GetCmdListContractsByLabel lists all contracts with the given label
+func GetCmdListContractsByLabel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-contracts-by-label [label]",
+		Short: "List all contracts by label",
+		Long:  "List all contracts with the given label or all labels starting with it, optionally limited to a creator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			creator, err := cmd.Flags().GetString(flagCreator)
+			if err != nil {
+				return err
+			}
+			if creator != "" {
+				if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+					return err
+				}
+			}
+			labelPrefix, err := cmd.Flags().GetBool(flagLabelPrefix)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractsByLabel(
+				context.Background(),
+				&types.QueryContractsByLabelRequest{
+					Label:          args[0],
+					Prefix:         labelPrefix,
+					CreatorAddress: creator,
+					Pagination:     pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().String(flagCreator, "", "Only list contracts instantiated by this creator address")
+	cmd.Flags().Bool(flagLabelPrefix, false, "List all contracts with a label starting with the given label")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list contracts by label")
+	return cmd
+}
+
 type argumentDecoder struct {
 	// dec is the default decoder
 	dec                func(string) ([]byte, error)
@@ -672,3 +970,108 @@ func GetCmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryDisabledOperations implements a command to return the operations disabled by the circuit breaker.
+func GetCmdQueryDisabledOperations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disabled-operations",
+		Short: "Query the operations disabled by the circuit breaker",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DisabledOperations(cmd.Context(), &types.QueryDisabledOperationsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdListCronJobs lists the contracts scheduled to receive periodic sudo calls
+func GetCmdListCronJobs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cron-jobs",
+		Short: "List the contracts scheduled to receive periodic sudo calls",
+		Long:  "List the contracts scheduled to receive periodic sudo calls",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CronJobs(
+				context.Background(),
+				&types.QueryCronJobsRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list cron jobs")
+	return cmd
+}
+
+// GetCmdListContractCallbacks lists the pending callbacks scheduled by a contract
+func GetCmdListContractCallbacks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "contract-callbacks [bech32_address]",
+		Short:   "List the pending callbacks scheduled by a contract",
+		Long:    "List the pending callbacks scheduled by a contract",
+		Aliases: []string{"callbacks"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractCallbacks(
+				context.Background(),
+				&types.QueryContractCallbacksRequest{
+					Address:    args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list contract callbacks")
+	return cmd
+}
@@ -28,6 +28,7 @@ const (
 	flagSource                    = "code-source-url"
 	flagBuilder                   = "builder"
 	flagCodeHash                  = "code-hash"
+	flagReuseExistingCode         = "reuse-existing-code"
 	flagAdmin                     = "admin"
 	flagNoAdmin                   = "no-admin"
 	flagFixMsg                    = "fix-msg"
@@ -44,6 +45,16 @@ const (
 	flagMaxFunds                  = "max-funds"
 	flagAllowAllMsgs              = "allow-all-messages"
 	flagNoTokenTransfer           = "no-token-transfer" //nolint:gosec
+	flagMaxFailures               = "max-failures"
+	flagOperations                = "operations"
+	flagCreator                   = "creator"
+	flagLabelPrefix               = "prefix"
+	flagPrefix                    = "prefix"
+	flagStart                     = "start"
+	flagEnd                       = "end"
+	flagStartExclusive            = "start-exclusive"
+	flagEndInclusive              = "end-inclusive"
+	flagKeysOnly                  = "keys-only"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -66,6 +77,13 @@ func GetTxCmd() *cobra.Command {
 		ClearContractAdminCmd(),
 		GrantAuthorizationCmd(),
 		UpdateInstantiateConfigCmd(),
+		DeleteContractCmd(),
+		RemoveCodeCmd(),
+		UpdateCodeMetadataCmd(),
+		FreezeContractCmd(),
+		UnfreezeContractCmd(),
+		DisableOperationsCmd(),
+		EnableOperationsCmd(),
 	)
 	return txCmd
 }
@@ -86,6 +104,15 @@ func StoreCodeCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if msg.Source, err = cmd.Flags().GetString(flagSource); err != nil {
+				return fmt.Errorf("source: %s", err)
+			}
+			if msg.Builder, err = cmd.Flags().GetString(flagBuilder); err != nil {
+				return fmt.Errorf("builder: %s", err)
+			}
+			if msg.ReuseExistingCode, err = cmd.Flags().GetBool(flagReuseExistingCode); err != nil {
+				return fmt.Errorf("reuse existing code: %s", err)
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -95,6 +122,9 @@ func StoreCodeCmd() *cobra.Command {
 	}
 
 	addInstantiatePermissionFlags(cmd)
+	cmd.Flags().String(flagSource, "", "Code Source URL is a valid absolute HTTPS URI to the contract's source code,")
+	cmd.Flags().String(flagBuilder, "", "Builder is a valid docker image name with tag, such as \"cosmwasm/workspace-optimizer:0.12.9\"")
+	cmd.Flags().Bool(flagReuseExistingCode, false, "Return the code id of an existing code with the same checksum and instantiate permission instead of storing a duplicate")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -22,4 +22,10 @@ var ProposalHandlers = []govclient.ProposalHandler{
 	govclient.NewProposalHandler(cli.ProposalUpdateInstantiateConfigCmd, rest.UpdateInstantiateConfigProposalHandler),
 	govclient.NewProposalHandler(cli.ProposalStoreAndInstantiateContractCmd, rest.EmptyRestHandler),
 	govclient.NewProposalHandler(cli.ProposalInstantiateContract2Cmd, rest.EmptyRestHandler),
+	govclient.NewProposalHandler(cli.ProposalDeleteContractCmd, rest.EmptyRestHandler),
+	govclient.NewProposalHandler(cli.ProposalRemoveCodeCmd, rest.EmptyRestHandler),
+	govclient.NewProposalHandler(cli.ProposalFreezeContractCmd, rest.EmptyRestHandler),
+	govclient.NewProposalHandler(cli.ProposalUnfreezeContractCmd, rest.EmptyRestHandler),
+	govclient.NewProposalHandler(cli.ProposalRegisterCronJobCmd, rest.EmptyRestHandler),
+	govclient.NewProposalHandler(cli.ProposalDeregisterCronJobCmd, rest.EmptyRestHandler),
 }
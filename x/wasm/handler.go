@@ -41,6 +41,20 @@ func NewHandler(k types.ContractOpsKeeper) sdk.Handler {
 			res, err = msgServer.ClearAdmin(sdk.WrapSDKContext(ctx), msg)
 		case *types.MsgUpdateInstantiateConfig:
 			res, err = msgServer.UpdateInstantiateConfig(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgDeleteContract:
+			res, err = msgServer.DeleteContract(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgRemoveCode:
+			res, err = msgServer.RemoveCode(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgUpdateCodeMetadata:
+			res, err = msgServer.UpdateCodeMetadata(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgFreezeContract:
+			res, err = msgServer.FreezeContract(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgUnfreezeContract:
+			res, err = msgServer.UnfreezeContract(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgDisableOperations:
+			res, err = msgServer.DisableOperations(sdk.WrapSDKContext(ctx), msg)
+		case *types.MsgEnableOperations:
+			res, err = msgServer.EnableOperations(sdk.WrapSDKContext(ctx), msg)
 		default:
 			errMsg := fmt.Sprintf("unrecognized wasm message type: %T", msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -271,6 +271,13 @@ func (i IBCHandler) OnRecvPacket(
 	if err != nil {
 		return channeltypes.NewErrorAcknowledgement(err)
 	}
+	if len(ack) == 0 {
+		// no ack yet: the contract writes the acknowledgement later
+		if err := i.keeper.StoreAsyncAckPacket(ctx, packet); err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+		return nil
+	}
 	return ContractConfirmStateAck(ack)
 }
 
@@ -0,0 +1,134 @@
+package wasm
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// IBCHooksSenderPrefix is the address derivation prefix for the intermediate senders of ICS-20 memo hooks
+const IBCHooksSenderPrefix = "ibc-wasm-hooks-sender"
+
+var _ porttypes.IBCModule = IBCHooksMiddleware{}
+
+// IBCHooksMiddleware wraps the ICS-20 transfer module and executes a contract with the received tokens when the
+// packet memo contains a `wasm` object like:
+//
+//	{"wasm": {"contract": "<contract address>", "msg": {<json execute message>}}}
+//
+// The contract address must be the receiver of the transfer. The tokens are credited to an intermediate sender
+// that is derived from the destination channel and original sender (see DeriveIBCHooksSender) and then sent as
+// funds with the contract execution. When the execution fails or contract executions are disabled by the circuit
+// breaker, an error acknowledgement is returned so that all state changes are reverted and the tokens are refunded on
+// the source chain.
+type IBCHooksMiddleware struct {
+	porttypes.IBCModule
+	keeper types.ContractOpsKeeper
+}
+
+// NewIBCHooksMiddleware constructor
+func NewIBCHooksMiddleware(app porttypes.IBCModule, k types.ContractOpsKeeper) IBCHooksMiddleware {
+	return IBCHooksMiddleware{IBCModule: app, keeper: k}
+}
+
+// wasmHookMemo is the `wasm` object of an ICS-20 packet memo
+type wasmHookMemo struct {
+	Contract string                   `json:"contract"`
+	Msg      types.RawContractMessage `json:"msg"`
+}
+
+// OnRecvPacket implements the IBCModule interface
+func (m IBCHooksMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data ibctransfertypes.FungibleTokenPacketData
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return m.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+	hook, err := parseWasmHookMemo(data.Memo)
+	switch {
+	case err != nil:
+		return channeltypes.NewErrorAcknowledgement(err)
+	case hook == nil:
+		return m.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+	if hook.Contract != data.Receiver {
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(types.ErrInvalid, "wasm hook contract must be the receiver"))
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(hook.Contract)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(err, "wasm hook contract"))
+	}
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amount: %s", data.Amount))
+	}
+	if m.keeper.IsOperationDisabled(ctx, types.OperationTypeExecute) {
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(types.ErrOperationDisabled, types.OperationTypeExecute.String()))
+	}
+
+	// credit the tokens to the intermediate sender instead of the contract
+	sender := DeriveIBCHooksSender(packet.GetDestChannel(), data.Sender)
+	data.Receiver = sender.String()
+	packet.Data = data.GetBytes()
+	ack := m.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	funds := sdk.NewCoins(sdk.NewCoin(receivedDenom(packet, data.Denom), amount))
+	if _, err := m.keeper.Execute(ctx, contractAddr, sender, hook.Msg, funds); err != nil {
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(err, "wasm hook"))
+	}
+	return ack
+}
+
+// parseWasmHookMemo returns the wasm hook of an ICS-20 memo or nil when the memo does not contain a `wasm` object.
+func parseWasmHookMemo(memo string) (*wasmHookMemo, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return nil, nil // not a json object
+	}
+	raw, ok := fields["wasm"]
+	if !ok {
+		return nil, nil
+	}
+	var hook wasmHookMemo
+	if err := json.Unmarshal(raw, &hook); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "wasm hook memo")
+	}
+	if hook.Contract == "" {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "wasm hook contract")
+	}
+	if err := hook.Msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(err, "wasm hook msg")
+	}
+	return &hook, nil
+}
+
+// receivedDenom returns the denom of the tokens of an ICS-20 packet on the receiving chain
+func receivedDenom(packet channeltypes.Packet, denom string) string {
+	if ibctransfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		// tokens return to this chain, remove the prefix added by the sender chain
+		voucherPrefix := ibctransfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		return ibctransfertypes.ParseDenomTrace(denom[len(voucherPrefix):]).IBCDenom()
+	}
+	prefixedDenom := ibctransfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), denom)
+	return ibctransfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
+
+// DeriveIBCHooksSender returns the intermediate sender address that executes a contract for an ICS-20 memo hook.
+// The address is unique for the original sender on the other chain and the channel on this chain.
+func DeriveIBCHooksSender(channelID, originalSender string) sdk.AccAddress {
+	return address.Hash(IBCHooksSenderPrefix, []byte(channelID+"/"+originalSender))
+}
@@ -0,0 +1,94 @@
+package wasm
+
+import (
+	"testing"
+
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestParseWasmHookMemo(t *testing.T) {
+	specs := map[string]struct {
+		src    string
+		exp    *wasmHookMemo
+		expErr bool
+	}{
+		"wasm hook": {
+			src: `{"wasm":{"contract":"myContract","msg":{"foo":"bar"}}}`,
+			exp: &wasmHookMemo{Contract: "myContract", Msg: types.RawContractMessage(`{"foo":"bar"}`)},
+		},
+		"wasm hook with other fields": {
+			src: `{"forward":{},"wasm":{"contract":"myContract","msg":{}}}`,
+			exp: &wasmHookMemo{Contract: "myContract", Msg: types.RawContractMessage(`{}`)},
+		},
+		"empty memo": {
+			src: "",
+		},
+		"plain text memo": {
+			src: "my memo",
+		},
+		"json without wasm hook": {
+			src: `{"forward":{}}`,
+		},
+		"json array": {
+			src: `[{"wasm":{}}]`,
+		},
+		"invalid wasm hook": {
+			src:    `{"wasm":"myContract"}`,
+			expErr: true,
+		},
+		"empty contract": {
+			src:    `{"wasm":{"msg":{}}}`,
+			expErr: true,
+		},
+		"empty msg": {
+			src:    `{"wasm":{"contract":"myContract"}}`,
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := parseWasmHookMemo(spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestReceivedDenom(t *testing.T) {
+	packet := channeltypes.Packet{SourcePort: "transfer", SourceChannel: "channel-1", DestinationPort: "transfer", DestinationChannel: "channel-2"}
+	specs := map[string]struct {
+		denom string
+		exp   string
+	}{
+		"native denom of sender chain": {
+			denom: "uatom",
+			exp:   ibctransfertypes.ParseDenomTrace("transfer/channel-2/uatom").IBCDenom(),
+		},
+		"native denom returns": {
+			denom: "transfer/channel-1/stake",
+			exp:   "stake",
+		},
+		"voucher returns": {
+			denom: "transfer/channel-1/transfer/channel-9/uosmo",
+			exp:   ibctransfertypes.ParseDenomTrace("transfer/channel-9/uosmo").IBCDenom(),
+		},
+		"voucher of other chain": {
+			denom: "transfer/channel-9/uosmo",
+			exp:   ibctransfertypes.ParseDenomTrace("transfer/channel-2/transfer/channel-9/uosmo").IBCDenom(),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, spec.exp, receivedDenom(packet, spec.denom))
+		})
+	}
+}
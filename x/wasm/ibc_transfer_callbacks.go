@@ -0,0 +1,47 @@
+package wasm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+var _ porttypes.IBCModule = IBCTransferCallbacksMiddleware{}
+
+// IBCTransferCallbacksMiddleware wraps the ICS-20 transfer module and calls the sudo entry point of the contract
+// that sent a transfer with an `ibc_lifecycle_complete` message when the packet was acknowledged or timed out.
+// The transfer module handles the packet first so that refunds are done before the contract is called.
+type IBCTransferCallbacksMiddleware struct {
+	porttypes.IBCModule
+	keeper types.IBCTransferCallbackKeeper
+}
+
+// NewIBCTransferCallbacksMiddleware constructor
+func NewIBCTransferCallbacksMiddleware(app porttypes.IBCModule, k types.IBCTransferCallbackKeeper) IBCTransferCallbacksMiddleware {
+	return IBCTransferCallbacksMiddleware{IBCModule: app, keeper: k}
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (m IBCTransferCallbacksMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := m.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+	m.keeper.OnIBCTransferAcknowledgement(ctx, packet, acknowledgement)
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (m IBCTransferCallbacksMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	if err := m.IBCModule.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+	m.keeper.OnIBCTransferTimeout(ctx, packet)
+	return nil
+}
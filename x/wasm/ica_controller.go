@@ -0,0 +1,95 @@
+package wasm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+var _ porttypes.IBCModule = ICAControllerMiddleware{}
+
+// ICAControllerMiddleware is the ICS-27 authentication module for the interchain accounts owned by contracts. It
+// wraps the authentication module of the ICS-27 controller stack and handles the callbacks for the controller ports
+// that were registered by contracts:
+//
+//   - the channel capability is claimed with the `types.ICAControllerModuleName` scope
+//   - the contract is called back with an `ica_registered` sudo message when the channel is open
+//   - the contract is called back with an `ica_tx_complete` sudo message when a packet was acknowledged or timed out
+//
+// All other ports are passed to the wrapped module.
+type ICAControllerMiddleware struct {
+	porttypes.IBCModule
+	keeper types.ICAControllerCallbackKeeper
+}
+
+// NewICAControllerMiddleware constructor
+func NewICAControllerMiddleware(app porttypes.IBCModule, k types.ICAControllerCallbackKeeper) ICAControllerMiddleware {
+	return ICAControllerMiddleware{IBCModule: app, keeper: k}
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (m ICAControllerMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterParty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	if !m.keeper.IsContractICAControllerPort(ctx, portID) {
+		return m.IBCModule.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterParty, version)
+	}
+	if err := m.keeper.OnICAChannelOpenInit(ctx, portID, channelID, chanCap); err != nil {
+		return "", err
+	}
+	return version, nil
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (m ICAControllerMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID, channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	if !m.keeper.IsContractICAControllerPort(ctx, portID) {
+		return m.IBCModule.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+	}
+	// the metadata was validated by the ICS-27 controller before
+	var metadata icatypes.Metadata
+	if err := icatypes.ModuleCdc.UnmarshalJSON([]byte(counterpartyVersion), &metadata); err != nil {
+		return sdkerrors.Wrap(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain accounts metadata")
+	}
+	m.keeper.OnICAChannelOpenAck(ctx, portID, channelID, metadata.ControllerConnectionId, metadata.Address)
+	return nil
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (m ICAControllerMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if !m.keeper.IsContractICAControllerPort(ctx, packet.SourcePort) {
+		return m.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	}
+	m.keeper.OnICAAcknowledgement(ctx, packet, acknowledgement)
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (m ICAControllerMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	if !m.keeper.IsContractICAControllerPort(ctx, packet.SourcePort) {
+		return m.IBCModule.OnTimeoutPacket(ctx, packet, relayer)
+	}
+	m.keeper.OnICATimeout(ctx, packet)
+	return nil
+}
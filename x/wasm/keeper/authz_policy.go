@@ -22,6 +22,11 @@ type AuthorizationPolicy interface {
 	CanInstantiateContract(c types.AccessConfig, actor sdk.AccAddress) bool
 	CanModifyContract(admin, actor sdk.AccAddress) bool
 	CanModifyCodeAccessConfig(creator, actor sdk.AccAddress, isSubset bool) bool
+	CanRemoveCode(creator, actor sdk.AccAddress) bool
+	CanModifyCodeMetadata(creator, actor sdk.AccAddress) bool
+	CanFreezeContract(emergencyAuthority, actor sdk.AccAddress) bool
+	CanMigrateFrozenContract() bool
+	CanDisableOperations(emergencyAuthority, actor sdk.AccAddress) bool
 }
 
 type DefaultAuthorizationPolicy struct{}
@@ -43,6 +48,26 @@ func (p DefaultAuthorizationPolicy) CanModifyCodeAccessConfig(creator, actor sdk
 	return creator != nil && creator.Equals(actor) && isSubset
 }
 
+func (p DefaultAuthorizationPolicy) CanRemoveCode(creator, actor sdk.AccAddress) bool {
+	return creator != nil && creator.Equals(actor)
+}
+
+func (p DefaultAuthorizationPolicy) CanModifyCodeMetadata(creator, actor sdk.AccAddress) bool {
+	return creator != nil && creator.Equals(actor)
+}
+
+func (p DefaultAuthorizationPolicy) CanFreezeContract(emergencyAuthority, actor sdk.AccAddress) bool {
+	return emergencyAuthority != nil && emergencyAuthority.Equals(actor)
+}
+
+func (p DefaultAuthorizationPolicy) CanMigrateFrozenContract() bool {
+	return false
+}
+
+func (p DefaultAuthorizationPolicy) CanDisableOperations(emergencyAuthority, actor sdk.AccAddress) bool {
+	return emergencyAuthority != nil && emergencyAuthority.Equals(actor)
+}
+
 type GovAuthorizationPolicy struct{}
 
 // CanCreateCode implements AuthorizationPolicy.CanCreateCode to allow gov actions. Always returns true.
@@ -61,3 +86,28 @@ func (p GovAuthorizationPolicy) CanModifyContract(sdk.AccAddress, sdk.AccAddress
 func (p GovAuthorizationPolicy) CanModifyCodeAccessConfig(sdk.AccAddress, sdk.AccAddress, bool) bool {
 	return true
 }
+
+// CanRemoveCode implements AuthorizationPolicy.CanRemoveCode to allow gov actions. Always returns true.
+func (p GovAuthorizationPolicy) CanRemoveCode(sdk.AccAddress, sdk.AccAddress) bool {
+	return true
+}
+
+// CanModifyCodeMetadata implements AuthorizationPolicy.CanModifyCodeMetadata to allow gov actions. Always returns true.
+func (p GovAuthorizationPolicy) CanModifyCodeMetadata(sdk.AccAddress, sdk.AccAddress) bool {
+	return true
+}
+
+// CanFreezeContract implements AuthorizationPolicy.CanFreezeContract to allow gov actions. Always returns true.
+func (p GovAuthorizationPolicy) CanFreezeContract(sdk.AccAddress, sdk.AccAddress) bool {
+	return true
+}
+
+// CanMigrateFrozenContract implements AuthorizationPolicy.CanMigrateFrozenContract to allow gov actions. Always returns true.
+func (p GovAuthorizationPolicy) CanMigrateFrozenContract() bool {
+	return true
+}
+
+// CanDisableOperations implements AuthorizationPolicy.CanDisableOperations to allow gov actions. Always returns true.
+func (p GovAuthorizationPolicy) CanDisableOperations(sdk.AccAddress, sdk.AccAddress) bool {
+	return true
+}
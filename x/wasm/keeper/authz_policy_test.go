@@ -198,6 +198,122 @@ func TestDefaultAuthzPolicyCanModifyCodeAccessConfig(t *testing.T) {
 	}
 }
 
+func TestDefaultAuthzPolicyCanRemoveCode(t *testing.T) {
+	myActorAddress := RandomAccountAddress(t)
+	otherAddress := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		creator sdk.AccAddress
+		exp     bool
+	}{
+		"same as actor": {
+			creator: myActorAddress,
+			exp:     true,
+		},
+		"different creator": {
+			creator: otherAddress,
+			exp:     false,
+		},
+		"no creator": {
+			exp: false,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			policy := DefaultAuthorizationPolicy{}
+			got := policy.CanRemoveCode(spec.creator, myActorAddress)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestDefaultAuthzPolicyCanModifyCodeMetadata(t *testing.T) {
+	myActorAddress := RandomAccountAddress(t)
+	otherAddress := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		creator sdk.AccAddress
+		exp     bool
+	}{
+		"same as actor": {
+			creator: myActorAddress,
+			exp:     true,
+		},
+		"different creator": {
+			creator: otherAddress,
+			exp:     false,
+		},
+		"no creator": {
+			exp: false,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			policy := DefaultAuthorizationPolicy{}
+			got := policy.CanModifyCodeMetadata(spec.creator, myActorAddress)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestDefaultAuthzPolicyCanFreezeContract(t *testing.T) {
+	myActorAddress := RandomAccountAddress(t)
+	otherAddress := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		authority sdk.AccAddress
+		exp       bool
+	}{
+		"same as actor": {
+			authority: myActorAddress,
+			exp:       true,
+		},
+		"different authority": {
+			authority: otherAddress,
+			exp:       false,
+		},
+		"no authority": {
+			exp: false,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			policy := DefaultAuthorizationPolicy{}
+			got := policy.CanFreezeContract(spec.authority, myActorAddress)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestDefaultAuthzPolicyCanDisableOperations(t *testing.T) {
+	myActorAddress := RandomAccountAddress(t)
+	otherAddress := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		authority sdk.AccAddress
+		exp       bool
+	}{
+		"same as actor": {
+			authority: myActorAddress,
+			exp:       true,
+		},
+		"different authority": {
+			authority: otherAddress,
+			exp:       false,
+		},
+		"no authority": {
+			exp: false,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			policy := DefaultAuthorizationPolicy{}
+			got := policy.CanDisableOperations(spec.authority, myActorAddress)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
 func TestGovAuthzPolicyCanCreateCode(t *testing.T) {
 	myActorAddress := RandomAccountAddress(t)
 	otherAddress := RandomAccountAddress(t)
@@ -343,3 +459,99 @@ func TestGovAuthzPolicyCanModifyCodeAccessConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestGovAuthzPolicyCanRemoveCode(t *testing.T) {
+	myActorAddress := RandomAccountAddress(t)
+	otherAddress := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		creator sdk.AccAddress
+	}{
+		"same as actor": {
+			creator: myActorAddress,
+		},
+		"different creator": {
+			creator: otherAddress,
+		},
+		"no creator": {},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			policy := GovAuthorizationPolicy{}
+			got := policy.CanRemoveCode(spec.creator, myActorAddress)
+			assert.True(t, got)
+		})
+	}
+}
+
+func TestGovAuthzPolicyCanModifyCodeMetadata(t *testing.T) {
+	myActorAddress := RandomAccountAddress(t)
+	otherAddress := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		creator sdk.AccAddress
+	}{
+		"same as actor": {
+			creator: myActorAddress,
+		},
+		"different creator": {
+			creator: otherAddress,
+		},
+		"no creator": {},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			policy := GovAuthorizationPolicy{}
+			got := policy.CanModifyCodeMetadata(spec.creator, myActorAddress)
+			assert.True(t, got)
+		})
+	}
+}
+
+func TestGovAuthzPolicyCanFreezeContract(t *testing.T) {
+	myActorAddress := RandomAccountAddress(t)
+	otherAddress := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		authority sdk.AccAddress
+	}{
+		"same as actor": {
+			authority: myActorAddress,
+		},
+		"different authority": {
+			authority: otherAddress,
+		},
+		"no authority": {},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			policy := GovAuthorizationPolicy{}
+			got := policy.CanFreezeContract(spec.authority, myActorAddress)
+			assert.True(t, got)
+		})
+	}
+}
+
+func TestGovAuthzPolicyCanDisableOperations(t *testing.T) {
+	myActorAddress := RandomAccountAddress(t)
+	otherAddress := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		authority sdk.AccAddress
+	}{
+		"same as actor": {
+			authority: myActorAddress,
+		},
+		"different authority": {
+			authority: otherAddress,
+		},
+		"no authority": {},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			policy := GovAuthorizationPolicy{}
+			got := policy.CanDisableOperations(spec.authority, myActorAddress)
+			assert.True(t, got)
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// callTracer records the contract calls of an operation as a tree
+type callTracer struct {
+	root  *types.CallTrace
+	stack []*callTraceNode
+	// logOnComplete dumps the trace as json into the logs when the root call ends
+	logOnComplete bool
+}
+
+func newCallTracer(logOnComplete bool) *callTracer {
+	return &callTracer{logOnComplete: logOnComplete}
+}
+
+// withCallTracer stores the tracer in the context
+func withCallTracer(ctx sdk.Context, t *callTracer) sdk.Context {
+	return ctx.WithValue(contextKeyCallTracer, t)
+}
+
+// callTracerFromContext returns the tracer or nil when not tracing
+func callTracerFromContext(ctx sdk.Context) *callTracer {
+	if ctx.Context() == nil {
+		return nil
+	}
+	t, _ := ctx.Value(contextKeyCallTracer).(*callTracer)
+	return t
+}
+
+// callTraceNode is an active node in the call tree. All methods are no-ops on a nil node.
+type callTraceNode struct {
+	tracer     *callTracer
+	trace      *types.CallTrace
+	gasMeter   sdk.GasMeter
+	startGas   sdk.Gas
+	events     *sdk.EventManager
+	startEvent int
+}
+
+// startCallTrace starts a new node for a contract call. When the node is not running in debug mode and no tracer
+// is set in the context, the returned node is nil.
+func (k Keeper) startCallTrace(ctx sdk.Context, entryPoint string, contractAddr sdk.AccAddress) (sdk.Context, *callTraceNode) {
+	if k.debugMode && callTracerFromContext(ctx) == nil {
+		ctx = withCallTracer(ctx, newCallTracer(true))
+	}
+	return ctx, startCallTrace(ctx, entryPoint, contractAddr)
+}
+
+// startCallTrace starts a new node for a call when a tracer is set in the context.
+func startCallTrace(ctx sdk.Context, entryPoint string, contractAddr sdk.AccAddress) *callTraceNode {
+	t := callTracerFromContext(ctx)
+	if t == nil {
+		return nil
+	}
+	n := &callTraceNode{
+		tracer:     t,
+		trace:      &types.CallTrace{EntryPoint: entryPoint},
+		gasMeter:   ctx.GasMeter(),
+		startGas:   ctx.GasMeter().GasConsumed(),
+		events:     ctx.EventManager(),
+		startEvent: len(ctx.EventManager().Events()),
+	}
+	n.setContract(contractAddr)
+	if len(t.stack) == 0 {
+		t.root = n.trace
+	} else {
+		parent := t.stack[len(t.stack)-1].trace
+		parent.Children = append(parent.Children, n.trace)
+	}
+	t.stack = append(t.stack, n)
+	return n
+}
+
+// setContract sets the contract address when it was not known at start
+func (n *callTraceNode) setContract(contractAddr sdk.AccAddress) {
+	if n == nil || contractAddr == nil {
+		return
+	}
+	n.trace.ContractAddress = contractAddr.String()
+}
+
+// setSubmsgID sets the sub message id
+func (n *callTraceNode) setSubmsgID(id uint64) {
+	if n == nil {
+		return
+	}
+	n.trace.SubmsgID = id
+}
+
+// addWasmGas adds the gas consumed by the wasm VM
+func (n *callTraceNode) addWasmGas(gas sdk.Gas) {
+	if n == nil {
+		return
+	}
+	n.trace.WasmGas += gas
+}
+
+// addEvents adds events that are not emitted to the event manager of the context
+func (n *callTraceNode) addEvents(events []sdk.Event) {
+	if n == nil {
+		return
+	}
+	n.trace.Events = append(n.trace.Events, sdk.Events(events).ToABCIEvents()...)
+}
+
+// end completes the node with the error and the panic recovered, if any. A panic is raised again so that
+// it can be handled by the caller.
+func (n *callTraceNode) end(ctx sdk.Context, err error, panicked interface{}) {
+	if n == nil {
+		if panicked != nil {
+			panic(panicked)
+		}
+		return
+	}
+	switch {
+	case err != nil:
+		n.trace.Error = err.Error()
+	case panicked != nil:
+		if oog, ok := panicked.(sdk.ErrorOutOfGas); ok {
+			n.trace.Error = "out of gas: " + oog.Descriptor
+		} else {
+			n.trace.Error = "panic"
+		}
+	}
+	n.trace.GasUsed = n.gasMeter.GasConsumedToLimit() - n.startGas
+	for _, c := range n.trace.Children {
+		n.trace.WasmGas += c.WasmGas
+	}
+	if n.trace.WasmGas <= n.trace.GasUsed {
+		n.trace.SDKGas = n.trace.GasUsed - n.trace.WasmGas
+	}
+	if events := n.events.Events(); len(events) > n.startEvent {
+		n.trace.Events = append(sdk.Events(events[n.startEvent:]).ToABCIEvents(), n.trace.Events...)
+	}
+
+	// pop this node and any nested node that was not completed due to a panic
+	t := n.tracer
+	for i := len(t.stack) - 1; i >= 0; i-- {
+		if t.stack[i] == n {
+			t.stack = t.stack[:i]
+			break
+		}
+	}
+	if len(t.stack) == 0 && t.logOnComplete {
+		if bz, err := json.Marshal(t.root); err == nil {
+			moduleLogger(ctx).Debug("contract call trace", "trace", string(bz))
+		}
+	}
+	if panicked != nil {
+		panic(panicked)
+	}
+}
+
+// currentCallTrace returns the innermost active node or nil
+func currentCallTrace(ctx sdk.Context) *callTraceNode {
+	t := callTracerFromContext(ctx)
+	if t == nil || len(t.stack) == 0 {
+		return nil
+	}
+	return t.stack[len(t.stack)-1]
+}
@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestCallTrace(t *testing.T) {
+	myContract, otherContract := RandomAccountAddress(t), RandomAccountAddress(t)
+	specs := map[string]struct {
+		debugMode   bool
+		withTracer  bool
+		doInChild   func(ctx sdk.Context)
+		expTraced   bool
+		expChildErr string
+		expPanic    bool
+	}{
+		"not tracing": {
+			doInChild: func(ctx sdk.Context) {},
+		},
+		"tracer in context": {
+			withTracer: true,
+			doInChild:  func(ctx sdk.Context) {},
+			expTraced:  true,
+		},
+		"debug mode": {
+			debugMode: true,
+			doInChild: func(ctx sdk.Context) {},
+			expTraced: true,
+		},
+		"out of gas panic": {
+			withTracer: true,
+			doInChild: func(ctx sdk.Context) {
+				ctx.GasMeter().ConsumeGas(ctx.GasMeter().Limit()+1, "testing")
+			},
+			expTraced:   true,
+			expChildErr: "out of gas: testing",
+			expPanic:    true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			k := Keeper{debugMode: spec.debugMode}
+			ctx := sdk.Context{}.WithContext(context.Background()).
+				WithGasMeter(sdk.NewGasMeter(1000)).
+				WithEventManager(sdk.NewEventManager()).
+				WithLogger(log.TestingLogger())
+			var tracer *callTracer
+			if spec.withTracer {
+				tracer = newCallTracer(false)
+				ctx = withCallTracer(ctx, tracer)
+			}
+
+			// when
+			var root *callTraceNode
+			run := func() {
+				var rootCtx sdk.Context
+				rootCtx, root = k.startCallTrace(ctx, "execute", myContract)
+				defer func() { root.end(rootCtx, nil, recover()) }()
+				rootCtx.GasMeter().ConsumeGas(10, "testing")
+				currentCallTrace(rootCtx).addWasmGas(4)
+				rootCtx.EventManager().EmitEvent(sdk.NewEvent("root"))
+
+				func() {
+					childCtx, child := k.startCallTrace(rootCtx, "query", otherContract)
+					defer func() { child.end(childCtx, nil, recover()) }()
+					childCtx.GasMeter().ConsumeGas(5, "testing")
+					currentCallTrace(childCtx).addWasmGas(2)
+					spec.doInChild(childCtx)
+				}()
+
+				sub := startCallTrace(rootCtx, "submsg", myContract)
+				sub.setSubmsgID(7)
+				sub.addEvents([]sdk.Event{sdk.NewEvent("bank")})
+				sub.end(rootCtx, errors.New("my error"), nil)
+			}
+			if spec.expPanic {
+				require.Panics(t, run)
+			} else {
+				run()
+			}
+
+			// then
+			if !spec.expTraced {
+				assert.Nil(t, root)
+				return
+			}
+			require.NotNil(t, root)
+			got := root.trace
+			assert.Equal(t, "execute", got.EntryPoint)
+			assert.Equal(t, myContract.String(), got.ContractAddress)
+			assert.Empty(t, root.tracer.stack)
+			if spec.withTracer {
+				assert.Equal(t, got, tracer.root)
+			}
+			if spec.expPanic {
+				require.Len(t, got.Children, 1)
+				assert.Equal(t, spec.expChildErr, got.Children[0].Error)
+				assert.Equal(t, spec.expChildErr, got.Error)
+				return
+			}
+			assert.Equal(t, uint64(15), got.GasUsed)
+			assert.Equal(t, uint64(6), got.WasmGas)
+			assert.Equal(t, uint64(9), got.SDKGas)
+			assert.Empty(t, got.Error)
+			require.Len(t, got.Events, 1)
+			assert.Equal(t, "root", got.Events[0].Type)
+
+			require.Len(t, got.Children, 2)
+			query := got.Children[0]
+			assert.Equal(t, "query", query.EntryPoint)
+			assert.Equal(t, otherContract.String(), query.ContractAddress)
+			assert.Equal(t, uint64(5), query.GasUsed)
+			assert.Equal(t, uint64(2), query.WasmGas)
+			assert.Equal(t, uint64(3), query.SDKGas)
+
+			sub := got.Children[1]
+			assert.Equal(t, "submsg", sub.EntryPoint)
+			assert.Equal(t, uint64(7), sub.SubmsgID)
+			assert.Equal(t, "my error", sub.Error)
+			require.Len(t, sub.Events, 1)
+			assert.Equal(t, "bank", sub.Events[0].Type)
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// getCallbackParams returns the params that limit and price contract callbacks
+func (k Keeper) getCallbackParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.Get(ctx, types.ParamStoreKeyMaxCallbacksPerBlock, &params.MaxCallbacksPerBlock)
+	k.paramSpace.Get(ctx, types.ParamStoreKeyMaxCallbackGasLimit, &params.MaxCallbackGasLimit)
+	k.paramSpace.Get(ctx, types.ParamStoreKeyCallbackFeeDenom, &params.CallbackFeeDenom)
+	k.paramSpace.Get(ctx, types.ParamStoreKeyCallbackGasPrice, &params.CallbackGasPrice)
+	return params
+}
+
+// GetCallback returns the callback scheduled by the contract or nil when not found
+func (k Keeper) GetCallback(ctx sdk.Context, contractAddress sdk.AccAddress, callbackID uint64) *types.Callback {
+	store := ctx.KVStore(k.storeKey)
+	heightBz := store.Get(types.GetContractCallbackKey(contractAddress, callbackID))
+	if heightBz == nil {
+		return nil
+	}
+	bz := store.Get(types.GetCallbackQueueKey(int64(binary.BigEndian.Uint64(heightBz)), callbackID))
+	if bz == nil {
+		return nil
+	}
+	var callback types.Callback
+	k.cdc.MustUnmarshal(bz, &callback)
+	return &callback
+}
+
+// IterateCallbacks iterates over all pending callbacks in order of their execution until the callback returns true
+func (k Keeper) IterateCallbacks(ctx sdk.Context, cb func(types.Callback) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.CallbackQueuePrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var callback types.Callback
+		k.cdc.MustUnmarshal(iter.Value(), &callback)
+		if cb(callback) {
+			break
+		}
+	}
+}
+
+// storeCallback persists the callback in the queue and indexes it by contract
+func (k Keeper) storeCallback(ctx sdk.Context, contractAddress sdk.AccAddress, callback types.Callback) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCallbackQueueKey(callback.Height, callback.ID), k.cdc.MustMarshal(&callback))
+	store.Set(types.GetContractCallbackKey(contractAddress, callback.ID), sdk.Uint64ToBigEndian(uint64(callback.Height)))
+}
+
+// removeCallback deletes the callback from the queue and the contract index
+func (k Keeper) removeCallback(ctx sdk.Context, contractAddress sdk.AccAddress, callback types.Callback) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCallbackQueueKey(callback.Height, callback.ID))
+	store.Delete(types.GetContractCallbackKey(contractAddress, callback.ID))
+}
+
+// scheduleCallback queues a sudo call to the contract with the given payload at the given block height. The fee
+// for the gas limit is prepaid from the contract balance and held in the module account until the callback is
+// executed or cancelled. Returns the id of the new callback.
+func (k Keeper) scheduleCallback(ctx sdk.Context, contractAddress sdk.AccAddress, height int64, gasLimit uint64, msg types.RawContractMessage) (uint64, error) {
+	params := k.getCallbackParams(ctx)
+	if params.MaxCallbacksPerBlock == 0 || params.MaxCallbackGasLimit == 0 {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "callbacks disabled")
+	}
+	if height <= ctx.BlockHeight() {
+		return 0, sdkerrors.Wrap(types.ErrInvalid, "height must be in the future")
+	}
+	if gasLimit == 0 {
+		return 0, sdkerrors.Wrap(types.ErrEmpty, "gas limit")
+	}
+	if gasLimit > params.MaxCallbackGasLimit {
+		return 0, sdkerrors.Wrapf(types.ErrInvalid, "gas limit exceeds max of %d", params.MaxCallbackGasLimit)
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return 0, sdkerrors.Wrap(err, "payload msg")
+	}
+	if !k.HasContractInfo(ctx, contractAddress) {
+		return 0, sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+
+	var fee sdk.Coins
+	if params.CallbackFeeEnabled() {
+		amount := params.CallbackGasPrice.MulInt(sdk.NewIntFromUint64(gasLimit)).Ceil().TruncateInt()
+		fee = sdk.NewCoins(sdk.NewCoin(params.CallbackFeeDenom, amount))
+	}
+	if !fee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, contractAddress, types.ModuleName, fee); err != nil {
+			return 0, sdkerrors.Wrap(err, "callback fee")
+		}
+	}
+
+	callback := types.Callback{
+		ID:       k.autoIncrementID(ctx, types.KeyLastCallbackID),
+		Contract: contractAddress.String(),
+		Height:   height,
+		GasLimit: gasLimit,
+		Msg:      msg,
+		Fee:      fee,
+	}
+	k.storeCallback(ctx, contractAddress, callback)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeScheduleCallback,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, callback.Contract),
+		sdk.NewAttribute(types.AttributeKeyCallbackID, strconv.FormatUint(callback.ID, 10)),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(callback.Height, 10)),
+	))
+	return callback.ID, nil
+}
+
+// cancelCallback removes a pending callback of the contract and refunds the prepaid fee to the contract
+func (k Keeper) cancelCallback(ctx sdk.Context, contractAddress sdk.AccAddress, callbackID uint64) error {
+	callback := k.GetCallback(ctx, contractAddress, callbackID)
+	if callback == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "callback")
+	}
+	if !callback.Fee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, contractAddress, callback.Fee); err != nil {
+			return sdkerrors.Wrap(err, "refund callback fee")
+		}
+	}
+	k.removeCallback(ctx, contractAddress, *callback)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCancelCallback,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, callback.Contract),
+		sdk.NewAttribute(types.AttributeKeyCallbackID, strconv.FormatUint(callback.ID, 10)),
+	))
+	return nil
+}
+
+// cancelContractCallbacks cancels all pending callbacks of the contract
+func (k Keeper) cancelContractCallbacks(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	var ids []uint64
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractCallbacksPrefix(contractAddress)).Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		ids = append(ids, binary.BigEndian.Uint64(iter.Key()))
+	}
+	iter.Close()
+	for _, id := range ids {
+		if err := k.cancelCallback(ctx, contractAddress, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// importCallback stores a pending callback from genesis
+func (k Keeper) importCallback(ctx sdk.Context, callback types.Callback) error {
+	contractAddress, err := sdk.AccAddressFromBech32(callback.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if !k.HasContractInfo(ctx, contractAddress) {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if ctx.KVStore(k.storeKey).Has(types.GetContractCallbackKey(contractAddress, callback.ID)) {
+		return sdkerrors.Wrap(types.ErrDuplicate, "callback")
+	}
+	k.storeCallback(ctx, contractAddress, callback)
+	return nil
+}
+
+// ExecuteCallbacks calls the sudo entry point of the contracts with the payload of their callbacks that are due at
+// the current block height, in order of height and id. At most max callbacks per block are executed, others stay
+// due for the next block. Each call runs in a cached context with the gas limit of the callback so that a failure
+// reverts all its state changes. The prepaid fee is burned independent of the execution result. Callbacks of frozen
+// contracts are dropped without execution.
+func (k Keeper) ExecuteCallbacks(ctx sdk.Context) {
+	maxCallbacks := k.getCallbackParams(ctx).MaxCallbacksPerBlock
+	if maxCallbacks == 0 {
+		return
+	}
+	var due []types.Callback
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.CallbackQueuePrefix).
+		Iterator(nil, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()+1)))
+	for ; iter.Valid() && len(due) < int(maxCallbacks); iter.Next() {
+		var callback types.Callback
+		k.cdc.MustUnmarshal(iter.Value(), &callback)
+		due = append(due, callback)
+	}
+	iter.Close()
+
+	for _, callback := range due {
+		contractAddress := sdk.MustAccAddressFromBech32(callback.Contract)
+		k.removeCallback(ctx, contractAddress, callback)
+		if !callback.Fee.IsZero() {
+			if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, callback.Fee); err != nil {
+				panic(err)
+			}
+		}
+
+		var gasUsed uint64
+		err := sdkerrors.Wrap(types.ErrContractFrozen, "callback dropped")
+		if info := k.GetContractInfo(ctx, contractAddress); info != nil && !info.IsFrozen {
+			gasUsed, err = k.sudoWithGasLimit(ctx, contractAddress, callback.Msg, callback.GasLimit)
+		}
+		if err != nil {
+			k.Logger(ctx).Debug("callback", "contract", callback.Contract, "id", callback.ID, "error", err.Error())
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeCallback,
+			sdk.NewAttribute(types.AttributeKeyContractAddr, callback.Contract),
+			sdk.NewAttribute(types.AttributeKeyCallbackID, strconv.FormatUint(callback.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+		))
+	}
+}
@@ -0,0 +1,456 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	wasmvm "github.com/CosmWasm/wasmvm"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func setCallbackParams(ctx sdk.Context, k *Keeper, maxPerBlock uint32, maxGasLimit uint64, price sdk.Dec) {
+	params := types.DefaultParams()
+	params.MaxCallbacksPerBlock = maxPerBlock
+	params.MaxCallbackGasLimit = maxGasLimit
+	params.CallbackFeeDenom = "denom"
+	params.CallbackGasPrice = price
+	k.SetParams(ctx, params)
+}
+
+func TestScheduleCallback(t *testing.T) {
+	var mock wasmtesting.MockWasmer
+	wasmtesting.MakeInstantiable(&mock)
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithWasmEngine(&mock))
+	k := keepers.WasmKeeper
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	keepers.Faucet.Fund(parentCtx, example.Contract, sdk.NewInt64Coin("denom", 100))
+	parentCtx = parentCtx.WithBlockHeight(100)
+	moduleAddr := keepers.AccountKeeper.GetModuleAddress(types.ModuleName)
+
+	specs := map[string]struct {
+		maxPerBlock uint32
+		price       sdk.Dec
+		contract    sdk.AccAddress
+		height      int64
+		gasLimit    uint64
+		msg         types.RawContractMessage
+		expFee      sdk.Coins
+		expErr      *sdkerrors.Error
+	}{
+		"all good": {
+			maxPerBlock: 1,
+			contract:    example.Contract,
+			height:      101,
+			gasLimit:    100_000,
+			msg:         []byte(`{"wake_up":{}}`),
+		},
+		"fee prepaid": {
+			maxPerBlock: 1,
+			price:       sdk.NewDecWithPrec(1, 4),
+			contract:    example.Contract,
+			height:      101,
+			gasLimit:    100_001,
+			msg:         []byte(`{"wake_up":{}}`),
+			expFee:      sdk.NewCoins(sdk.NewInt64Coin("denom", 11)),
+		},
+		"insufficient funds for fee": {
+			maxPerBlock: 1,
+			price:       sdk.OneDec(),
+			contract:    example.Contract,
+			height:      101,
+			gasLimit:    100_000,
+			msg:         []byte(`{"wake_up":{}}`),
+			expErr:      sdkerrors.ErrInsufficientFunds,
+		},
+		"callbacks disabled": {
+			contract: example.Contract,
+			height:   101,
+			gasLimit: 100_000,
+			msg:      []byte(`{"wake_up":{}}`),
+			expErr:   sdkerrors.ErrInvalidRequest,
+		},
+		"current height": {
+			maxPerBlock: 1,
+			contract:    example.Contract,
+			height:      100,
+			gasLimit:    100_000,
+			msg:         []byte(`{"wake_up":{}}`),
+			expErr:      types.ErrInvalid,
+		},
+		"empty gas limit": {
+			maxPerBlock: 1,
+			contract:    example.Contract,
+			height:      101,
+			msg:         []byte(`{"wake_up":{}}`),
+			expErr:      types.ErrEmpty,
+		},
+		"gas limit exceeds max": {
+			maxPerBlock: 1,
+			contract:    example.Contract,
+			height:      101,
+			gasLimit:    1_000_001,
+			msg:         []byte(`{"wake_up":{}}`),
+			expErr:      types.ErrInvalid,
+		},
+		"invalid msg": {
+			maxPerBlock: 1,
+			contract:    example.Contract,
+			height:      101,
+			gasLimit:    100_000,
+			msg:         []byte(`not json`),
+			expErr:      types.ErrInvalid,
+		},
+		"unknown contract": {
+			maxPerBlock: 1,
+			contract:    RandomAccountAddress(t),
+			height:      101,
+			gasLimit:    100_000,
+			msg:         []byte(`{"wake_up":{}}`),
+			expErr:      types.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			price := sdk.ZeroDec()
+			if !spec.price.IsNil() {
+				price = spec.price
+			}
+			setCallbackParams(ctx, k, spec.maxPerBlock, 1_000_000, price)
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			// when
+			gotID, gotErr := k.scheduleCallback(ctx, spec.contract, spec.height, spec.gasLimit, spec.msg)
+			// then
+			require.True(t, spec.expErr.Is(gotErr), "expected %v but got %+v", spec.expErr, gotErr)
+			if spec.expErr != nil {
+				assert.Empty(t, keepers.BankKeeper.GetAllBalances(ctx, moduleAddr))
+				return
+			}
+			exp := types.Callback{
+				ID:       gotID,
+				Contract: example.Contract.String(),
+				Height:   spec.height,
+				GasLimit: spec.gasLimit,
+				Msg:      spec.msg,
+				Fee:      spec.expFee,
+			}
+			assert.Equal(t, &exp, k.GetCallback(ctx, example.Contract, gotID))
+			assert.Equal(t, spec.expFee.String(), keepers.BankKeeper.GetAllBalances(ctx, moduleAddr).String())
+			events := ctx.EventManager().Events()
+			assert.Equal(t, sdk.NewEvent(
+				"schedule_callback",
+				sdk.NewAttribute("_contract_address", example.Contract.String()),
+				sdk.NewAttribute("callback_id", "1"),
+				sdk.NewAttribute("height", "101"),
+			), events[len(events)-1])
+		})
+	}
+}
+
+func TestCancelCallback(t *testing.T) {
+	var mock wasmtesting.MockWasmer
+	wasmtesting.MakeInstantiable(&mock)
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithWasmEngine(&mock))
+	k := keepers.WasmKeeper
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	other := SeedNewContractInstance(t, ctx, keepers, &mock)
+	keepers.Faucet.Fund(ctx, example.Contract, sdk.NewInt64Coin("denom", 100))
+	setCallbackParams(ctx, k, 1, 1_000_000, sdk.NewDecWithPrec(1, 3))
+	id, err := k.scheduleCallback(ctx.WithBlockHeight(1), example.Contract, 10, 10_000, []byte(`{}`))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom", 90)), keepers.BankKeeper.GetAllBalances(ctx, example.Contract))
+
+	// when cancelled by other contract
+	gotErr := k.cancelCallback(ctx, other.Contract, id)
+	// then
+	assert.ErrorIs(t, gotErr, types.ErrNotFound)
+
+	// when cancelled by owner
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.cancelCallback(ctx, example.Contract, id))
+	// then
+	assert.Nil(t, k.GetCallback(ctx, example.Contract, id))
+	assert.False(t, ctx.KVStore(k.storeKey).Has(types.GetCallbackQueueKey(10, id)))
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom", 100)), keepers.BankKeeper.GetAllBalances(ctx, example.Contract))
+	events := ctx.EventManager().Events()
+	assert.Equal(t, sdk.NewEvent(
+		"cancel_callback",
+		sdk.NewAttribute("_contract_address", example.Contract.String()),
+		sdk.NewAttribute("callback_id", "1"),
+	), events[len(events)-1])
+
+	// when cancelled again
+	gotErr = k.cancelCallback(ctx, example.Contract, id)
+	// then
+	assert.ErrorIs(t, gotErr, types.ErrNotFound)
+}
+
+func TestExecuteCallbacks(t *testing.T) {
+	const height = 100
+	specs := map[string]struct {
+		maxPerBlock  uint32
+		heights      []int64
+		frozen       bool
+		sudoErr      error
+		sudoGas      uint64
+		expExecuted  []uint64
+		expRemaining []uint64
+		expSuccess   bool
+	}{
+		"due callbacks executed in order": {
+			maxPerBlock: 3,
+			heights:     []int64{height, height - 1, height + 1},
+			expExecuted: []uint64{2, 1},
+			expSuccess:  true,
+			expRemaining: []uint64{
+				3,
+			},
+		},
+		"max callbacks per block": {
+			maxPerBlock:  1,
+			heights:      []int64{height, height - 1},
+			expExecuted:  []uint64{2},
+			expRemaining: []uint64{1},
+			expSuccess:   true,
+		},
+		"callbacks disabled": {
+			heights:      []int64{height},
+			expRemaining: []uint64{1},
+		},
+		"failure not retried": {
+			maxPerBlock: 1,
+			heights:     []int64{height},
+			sudoErr:     errors.New("testing"),
+			expExecuted: []uint64{1},
+		},
+		"out of gas not retried": {
+			maxPerBlock: 1,
+			heights:     []int64{height},
+			sudoGas:     DefaultGasMultiplier * 1_000_000,
+			expExecuted: []uint64{1},
+		},
+		"frozen contract dropped": {
+			maxPerBlock: 1,
+			heights:     []int64{height},
+			frozen:      true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var mock wasmtesting.MockWasmer
+			wasmtesting.MakeInstantiable(&mock)
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithWasmEngine(&mock))
+			k := keepers.WasmKeeper
+			example := SeedNewContractInstance(t, ctx, keepers, &mock)
+			keepers.Faucet.Fund(ctx, example.Contract, sdk.NewInt64Coin("denom", 100))
+			setCallbackParams(ctx, k, 10, 500_000, sdk.NewDecWithPrec(1, 4))
+			for _, h := range spec.heights {
+				_, err := k.scheduleCallback(ctx.WithBlockHeight(1), example.Contract, h, 100_000, []byte(`{}`))
+				require.NoError(t, err)
+			}
+			setCallbackParams(ctx, k, spec.maxPerBlock, 500_000, sdk.NewDecWithPrec(1, 4))
+			if spec.frozen {
+				info := k.GetContractInfo(ctx, example.Contract)
+				info.IsFrozen = true
+				k.storeContractInfo(ctx, example.Contract, info)
+			}
+			moduleAddr := keepers.AccountKeeper.GetModuleAddress(types.ModuleName)
+			feesBefore := keepers.BankKeeper.GetAllBalances(ctx, moduleAddr)
+
+			var executed []uint64
+			mock.SudoFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+				executed = append(executed, uint64(len(executed)))
+				store.Set([]byte("foo"), []byte("bar"))
+				return &wasmvmtypes.Response{}, spec.sudoGas, spec.sudoErr
+			}
+			ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+
+			// when
+			k.ExecuteCallbacks(ctx)
+
+			// then
+			var gotExecuted []uint64
+			for _, e := range ctx.EventManager().Events() {
+				if e.Type != types.EventTypeCallback {
+					continue
+				}
+				attrs := make(map[string]string)
+				for _, a := range e.Attributes {
+					attrs[string(a.Key)] = string(a.Value)
+				}
+				if attrs[types.AttributeKeySuccess] == "true" {
+					assert.True(t, spec.expSuccess)
+				}
+				gotExecuted = append(gotExecuted, sdk.NewUintFromString(attrs[types.AttributeKeyCallbackID]).Uint64())
+			}
+			if spec.frozen {
+				assert.Len(t, gotExecuted, 1)
+				assert.Empty(t, executed)
+			} else {
+				assert.Equal(t, spec.expExecuted, gotExecuted)
+				assert.Len(t, executed, len(spec.expExecuted))
+			}
+			var gotRemaining []uint64
+			k.IterateCallbacks(ctx, func(c types.Callback) bool {
+				gotRemaining = append(gotRemaining, c.ID)
+				return false
+			})
+			assert.Equal(t, spec.expRemaining, gotRemaining)
+			// state committed on success only
+			assert.Equal(t, spec.expSuccess, k.QueryRaw(ctx, example.Contract, []byte("foo")) != nil)
+			// fees of due callbacks burned
+			expFees := sdk.NewCoins(sdk.NewInt64Coin("denom", 10*int64(len(spec.expRemaining))))
+			assert.Equal(t, expFees.String(), keepers.BankKeeper.GetAllBalances(ctx, moduleAddr).String())
+			assert.True(t, feesBefore.IsAllGTE(expFees))
+		})
+	}
+}
+
+func TestCallbackMessageHandler(t *testing.T) {
+	var mock wasmtesting.MockWasmer
+	wasmtesting.MakeInstantiable(&mock)
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithWasmEngine(&mock))
+	k := keepers.WasmKeeper
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	setCallbackParams(ctx, k, 1, 1_000_000, sdk.ZeroDec())
+	ctx = ctx.WithBlockHeight(10)
+	h := NewCallbackMessageHandler(k)
+
+	// when scheduled
+	gotEvents, gotData, gotErr := h.DispatchMsg(ctx, example.Contract, "", wasmvmtypes.CosmosMsg{
+		Custom: []byte(`{"schedule_callback":{"height":11,"gas_limit":100000,"msg":{"wake_up":{}}}}`),
+	})
+	// then
+	require.NoError(t, gotErr)
+	assert.Equal(t, [][]byte{[]byte(`{"callback_id":1}`)}, gotData)
+	require.Len(t, gotEvents, 1)
+	assert.Equal(t, types.EventTypeScheduleCallback, gotEvents[0].Type)
+	gotCallback := k.GetCallback(ctx, example.Contract, 1)
+	require.NotNil(t, gotCallback)
+	assert.Equal(t, int64(11), gotCallback.Height)
+	assert.Equal(t, types.RawContractMessage(`{"wake_up":{}}`), gotCallback.Msg)
+
+	// when cancelled
+	gotEvents, _, gotErr = h.DispatchMsg(ctx, example.Contract, "", wasmvmtypes.CosmosMsg{
+		Custom: []byte(`{"cancel_callback":{"callback_id":1}}`),
+	})
+	// then
+	require.NoError(t, gotErr)
+	require.Len(t, gotEvents, 1)
+	assert.Equal(t, types.EventTypeCancelCallback, gotEvents[0].Type)
+	assert.Nil(t, k.GetCallback(ctx, example.Contract, 1))
+
+	// when other messages
+	for _, msg := range []wasmvmtypes.CosmosMsg{
+		{Custom: []byte(`{"foo":{}}`)},
+		{Custom: []byte(`"foo"`)},
+		{Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{}}},
+	} {
+		_, _, gotErr = h.DispatchMsg(ctx, example.Contract, "", msg)
+		// then
+		assert.ErrorIs(t, gotErr, types.ErrUnknownMsg)
+	}
+}
+
+func TestScheduleCallbackFromContract(t *testing.T) {
+	specs := map[string]struct {
+		opts   []Option
+		expErr bool
+	}{
+		"enabled": {
+			opts: []Option{WithScheduledCallbacks()},
+		},
+		"disabled - passed on to custom encoder": {
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var mock wasmtesting.MockWasmer
+			wasmtesting.MakeInstantiable(&mock)
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, append(spec.opts, WithWasmEngine(&mock))...)
+			k := keepers.WasmKeeper
+			example := SeedNewContractInstance(t, ctx, keepers, &mock)
+			setCallbackParams(ctx, k, 1, 1_000_000, sdk.ZeroDec())
+			mock.ExecuteFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+				return &wasmvmtypes.Response{Messages: []wasmvmtypes.SubMsg{{
+					Msg:     wasmvmtypes.CosmosMsg{Custom: []byte(`{"schedule_callback":{"height":5,"gas_limit":100000,"msg":{"wake_up":{}}}}`)},
+					ReplyOn: wasmvmtypes.ReplyNever,
+				}}}, 0, nil
+			}
+			var sudoMsgs [][]byte
+			mock.SudoFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+				sudoMsgs = append(sudoMsgs, sudoMsg)
+				return &wasmvmtypes.Response{}, 0, nil
+			}
+
+			// when
+			_, err := keepers.ContractKeeper.Execute(ctx.WithBlockHeight(1), example.Contract, example.CreatorAddr, []byte(`{}`), nil)
+			if spec.expErr {
+				require.Error(t, err)
+				assert.Nil(t, k.GetCallback(ctx, example.Contract, 1))
+				return
+			}
+			require.NoError(t, err)
+			k.ExecuteCallbacks(ctx.WithBlockHeight(4))
+			// then
+			assert.Empty(t, sudoMsgs)
+
+			// and when due
+			k.ExecuteCallbacks(ctx.WithBlockHeight(5))
+			// then
+			assert.Equal(t, [][]byte{[]byte(`{"wake_up":{}}`)}, sudoMsgs)
+			assert.Nil(t, k.GetCallback(ctx, example.Contract, 1))
+		})
+	}
+}
+
+func TestDeleteContractCancelsCallbacks(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	setCallbackParams(ctx, k, 1, 1_000_000, sdk.NewDecWithPrec(1, 4))
+	id, err := k.scheduleCallback(ctx, example.Contract, ctx.BlockHeight()+1, 100_000, []byte(`{}`))
+	require.NoError(t, err)
+	recipient := RandomAccountAddress(t)
+
+	// when
+	require.NoError(t, keepers.ContractKeeper.DeleteContract(ctx, example.Contract, example.CreatorAddr, recipient))
+
+	// then
+	assert.Nil(t, k.GetCallback(ctx, example.Contract, id))
+	assert.Equal(t, example.Deposit, keepers.BankKeeper.GetAllBalances(ctx, recipient))
+}
+
+func TestCallbacksGenesisExportImport(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	setCallbackParams(ctx, k, 1, 1_000_000, sdk.ZeroDec())
+	for _, h := range []int64{20, 10} {
+		_, err := k.scheduleCallback(ctx, example.Contract, ctx.BlockHeight()+h, 100_000, []byte(`{}`))
+		require.NoError(t, err)
+	}
+
+	// when
+	exported := ExportGenesis(ctx, k)
+	require.Len(t, exported.Callbacks, 2)
+	assert.Equal(t, uint64(2), exported.Callbacks[0].ID)
+
+	dstKeeper, dstCtx, _ := setupKeeper(t)
+	_, err := InitGenesis(dstCtx, dstKeeper, *exported)
+	require.NoError(t, err)
+
+	// then
+	for _, c := range exported.Callbacks {
+		assert.Equal(t, &c, dstKeeper.GetCallback(dstCtx, example.Contract, c.ID))
+	}
+	assert.Equal(t, uint64(3), dstKeeper.PeekAutoIncrementID(dstCtx, types.KeyLastCallbackID))
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// IsOperationDisabled returns true when the operation was disabled by the circuit breaker
+func (k Keeper) IsOperationDisabled(ctx sdk.Context, op types.OperationType) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetDisabledOperationKey(op))
+}
+
+// GetDisabledOperations returns all operations disabled by the circuit breaker
+func (k Keeper) GetDisabledOperations(ctx sdk.Context) []types.OperationType {
+	var ops []types.OperationType
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.DisabledOperationPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		ops = append(ops, types.OperationType(iter.Key()[0]))
+	}
+	return ops
+}
+
+func (k Keeper) setOperationDisabled(ctx sdk.Context, op types.OperationType, disabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	if disabled {
+		store.Set(types.GetDisabledOperationKey(op), []byte{1})
+		return
+	}
+	store.Delete(types.GetDisabledOperationKey(op))
+}
+
+// setOperationsDisabled trips or resets the circuit breaker for the given operations. Disabled operations are
+// rejected for transactions and for messages dispatched by contracts.
+func (k Keeper) setOperationsDisabled(ctx sdk.Context, caller sdk.AccAddress, ops []types.OperationType, disabled bool, authZ AuthorizationPolicy) error {
+	if !authZ.CanDisableOperations(k.getEmergencyAuthority(ctx), caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not disable or enable operations")
+	}
+	if err := types.ValidateOperationTypes(ops); err != nil {
+		return err
+	}
+	names := make([]string, len(ops))
+	for i, op := range ops {
+		k.setOperationDisabled(ctx, op, disabled)
+		names[i] = op.String()
+	}
+
+	eventType := types.EventTypeDisableOperations
+	if !disabled {
+		eventType = types.EventTypeEnableOperations
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(types.AttributeKeyOperations, strings.Join(names, ",")),
+	))
+	return nil
+}
@@ -0,0 +1,280 @@
+package keeper
+
+import (
+	"testing"
+
+	wasmvm "github.com/CosmWasm/wasmvm"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestSetOperationsDisabled(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	emergencyAuthority := RandomAccountAddress(t)
+	params := types.DefaultParams()
+	params.EmergencyAuthority = emergencyAuthority.String()
+	k.SetParams(parentCtx, params)
+
+	specs := map[string]struct {
+		ops    []types.OperationType
+		caller sdk.AccAddress
+		authZ  AuthorizationPolicy
+		expErr *sdkerrors.Error
+	}{
+		"all good when called by emergency authority": {
+			ops:    []types.OperationType{types.OperationTypeExecute, types.OperationTypeIBCSend},
+			caller: emergencyAuthority,
+			authZ:  DefaultAuthorizationPolicy{},
+		},
+		"all good with gov": {
+			ops:   []types.OperationType{types.OperationTypeStoreCode},
+			authZ: GovAuthorizationPolicy{},
+		},
+		"prevent by other address": {
+			ops:    []types.OperationType{types.OperationTypeExecute},
+			caller: RandomAccountAddress(t),
+			authZ:  DefaultAuthorizationPolicy{},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"unknown operation rejected": {
+			ops:    []types.OperationType{types.OperationTypeUnspecified},
+			caller: emergencyAuthority,
+			authZ:  DefaultAuthorizationPolicy{},
+			expErr: types.ErrInvalid,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			// when
+			err := k.setOperationsDisabled(ctx, spec.caller, spec.ops, true, spec.authZ)
+			// then
+			require.True(t, spec.expErr.Is(err), "expected %v but got %+v", spec.expErr, err)
+			if spec.expErr != nil {
+				assert.Empty(t, k.GetDisabledOperations(ctx))
+				return
+			}
+			assert.Equal(t, spec.ops, k.GetDisabledOperations(ctx))
+			for _, op := range spec.ops {
+				assert.True(t, k.IsOperationDisabled(ctx, op))
+			}
+			assert.Len(t, ctx.EventManager().Events(), 1)
+			assert.Equal(t, "disable_operations", ctx.EventManager().Events()[0].Type)
+
+			// and when enabled
+			err = k.setOperationsDisabled(ctx, spec.caller, spec.ops, false, spec.authZ)
+			// then
+			require.NoError(t, err)
+			assert.Empty(t, k.GetDisabledOperations(ctx))
+		})
+	}
+}
+
+func TestCircuitBreakerMsgServer(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	msgServer := NewMsgServerImpl(NewDefaultPermissionKeeper(k))
+	sender := example.CreatorAddr.String()
+
+	specs := map[string]struct {
+		op   types.OperationType
+		call func(ctx sdk.Context) error
+	}{
+		"store code": {
+			op: types.OperationTypeStoreCode,
+			call: func(ctx sdk.Context) error {
+				_, err := msgServer.StoreCode(sdk.WrapSDKContext(ctx), &types.MsgStoreCode{Sender: sender, WASMByteCode: hackatomWasm})
+				return err
+			},
+		},
+		"instantiate": {
+			op: types.OperationTypeInstantiate,
+			call: func(ctx sdk.Context) error {
+				_, err := msgServer.InstantiateContract(sdk.WrapSDKContext(ctx), &types.MsgInstantiateContract{
+					Sender: sender,
+					CodeID: example.CodeID,
+					Label:  "test",
+					Msg:    HackatomExampleInitMsg{Verifier: RandomAccountAddress(t), Beneficiary: RandomAccountAddress(t)}.GetBytes(t),
+				})
+				return err
+			},
+		},
+		"instantiate2": {
+			op: types.OperationTypeInstantiate,
+			call: func(ctx sdk.Context) error {
+				_, err := msgServer.InstantiateContract2(sdk.WrapSDKContext(ctx), &types.MsgInstantiateContract2{
+					Sender: sender,
+					CodeID: example.CodeID,
+					Label:  "test",
+					Msg:    HackatomExampleInitMsg{Verifier: RandomAccountAddress(t), Beneficiary: RandomAccountAddress(t)}.GetBytes(t),
+					Salt:   []byte("salt"),
+				})
+				return err
+			},
+		},
+		"execute": {
+			op: types.OperationTypeExecute,
+			call: func(ctx sdk.Context) error {
+				_, err := msgServer.ExecuteContract(sdk.WrapSDKContext(ctx), &types.MsgExecuteContract{
+					Sender:   example.VerifierAddr.String(),
+					Contract: example.Contract.String(),
+					Msg:      []byte(`{"release":{}}`),
+				})
+				return err
+			},
+		},
+		"migrate": {
+			op: types.OperationTypeMigrate,
+			call: func(ctx sdk.Context) error {
+				_, err := msgServer.MigrateContract(sdk.WrapSDKContext(ctx), &types.MsgMigrateContract{
+					Sender:   sender,
+					Contract: example.Contract.String(),
+					CodeID:   example.CodeID,
+					Msg:      []byte(`{"verifier":"` + RandomBech32AccountAddress(t) + `"}`),
+				})
+				return err
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			// when other operations are disabled
+			for _, op := range types.AllOperationTypes {
+				k.setOperationDisabled(ctx, op, op != spec.op)
+			}
+			// then
+			require.NoError(t, spec.call(ctx))
+
+			// when disabled
+			k.setOperationDisabled(ctx, spec.op, true)
+			// then
+			assert.ErrorIs(t, spec.call(ctx), types.ErrOperationDisabled)
+		})
+	}
+}
+
+func TestCircuitBreakerMessageHandler(t *testing.T) {
+	myContractAddr := RandomAccountAddress(t)
+	specs := map[string]struct {
+		msg      wasmvmtypes.CosmosMsg
+		disabled []types.OperationType
+		expOp    types.OperationType
+		expErr   bool
+	}{
+		"wasm execute": {
+			msg:      wasmvmtypes.CosmosMsg{Wasm: &wasmvmtypes.WasmMsg{Execute: &wasmvmtypes.ExecuteMsg{}}},
+			disabled: []types.OperationType{types.OperationTypeExecute},
+			expErr:   true,
+		},
+		"wasm instantiate": {
+			msg:      wasmvmtypes.CosmosMsg{Wasm: &wasmvmtypes.WasmMsg{Instantiate: &wasmvmtypes.InstantiateMsg{}}},
+			disabled: []types.OperationType{types.OperationTypeInstantiate},
+			expErr:   true,
+		},
+		"wasm instantiate2": {
+			msg:      wasmvmtypes.CosmosMsg{Wasm: &wasmvmtypes.WasmMsg{Instantiate2: &wasmvmtypes.Instantiate2Msg{}}},
+			disabled: []types.OperationType{types.OperationTypeInstantiate},
+			expErr:   true,
+		},
+		"wasm migrate": {
+			msg:      wasmvmtypes.CosmosMsg{Wasm: &wasmvmtypes.WasmMsg{Migrate: &wasmvmtypes.MigrateMsg{}}},
+			disabled: []types.OperationType{types.OperationTypeMigrate},
+			expErr:   true,
+		},
+		"ibc send packet": {
+			msg:      wasmvmtypes.CosmosMsg{IBC: &wasmvmtypes.IBCMsg{SendPacket: &wasmvmtypes.SendPacketMsg{}}},
+			disabled: []types.OperationType{types.OperationTypeIBCSend},
+			expErr:   true,
+		},
+		"ibc transfer": {
+			msg:      wasmvmtypes.CosmosMsg{IBC: &wasmvmtypes.IBCMsg{Transfer: &wasmvmtypes.TransferMsg{}}},
+			disabled: []types.OperationType{types.OperationTypeIBCSend},
+			expErr:   true,
+		},
+		"other operation disabled": {
+			msg:      wasmvmtypes.CosmosMsg{Wasm: &wasmvmtypes.WasmMsg{Execute: &wasmvmtypes.ExecuteMsg{}}},
+			disabled: []types.OperationType{types.OperationTypeMigrate, types.OperationTypeIBCSend},
+		},
+		"not guarded message": {
+			msg:      wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{}}},
+			disabled: types.AllOperationTypes,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			for _, op := range spec.disabled {
+				keepers.WasmKeeper.setOperationDisabled(ctx, op, true)
+			}
+			var nestedCalled bool
+			nested := &wasmtesting.MockMessageHandler{
+				DispatchMsgFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, err error) {
+					nestedCalled = true
+					return nil, nil, nil
+				},
+			}
+			h := NewCircuitBreakerMessageHandler(nested, keepers.WasmKeeper)
+			// when
+			_, _, gotErr := h.DispatchMsg(ctx, myContractAddr, "", spec.msg)
+			// then
+			if spec.expErr {
+				assert.ErrorIs(t, gotErr, types.ErrOperationDisabled)
+				assert.False(t, nestedCalled)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.True(t, nestedCalled)
+		})
+	}
+}
+
+func TestCircuitBreakerPreventsContractBypass(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	k.setOperationDisabled(ctx, types.OperationTypeIBCSend, true)
+
+	k.wasmVM = &wasmtesting.MockWasmer{ExecuteFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+		return &wasmvmtypes.Response{
+			Messages: []wasmvmtypes.SubMsg{
+				{Msg: wasmvmtypes.CosmosMsg{IBC: &wasmvmtypes.IBCMsg{SendPacket: &wasmvmtypes.SendPacketMsg{ChannelID: "channel-0"}}}, ReplyOn: wasmvmtypes.ReplyNever},
+			},
+		}, 0, nil
+	}}
+
+	// when
+	_, err := k.execute(ctx, example.Contract, example.CreatorAddr, nil, nil)
+
+	// then
+	assert.ErrorIs(t, err, types.ErrOperationDisabled)
+}
+
+func TestDisabledOperationsGenesisExportImport(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	expOps := []types.OperationType{types.OperationTypeStoreCode, types.OperationTypeIBCSend}
+	for _, op := range expOps {
+		k.setOperationDisabled(ctx, op, true)
+	}
+
+	// when
+	exported := ExportGenesis(ctx, k)
+	require.Equal(t, expOps, exported.DisabledOperations)
+
+	dstKeeper, dstCtx, _ := setupKeeper(t)
+	_, err := InitGenesis(dstCtx, dstKeeper, *exported)
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, expOps, dstKeeper.GetDisabledOperations(dstCtx))
+}
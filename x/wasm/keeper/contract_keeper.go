@@ -11,6 +11,7 @@ var _ types.ContractOpsKeeper = PermissionedKeeper{}
 // decoratedKeeper contains a subset of the wasm keeper that are already or can be guarded by an authorization policy in the future
 type decoratedKeeper interface {
 	create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *types.AccessConfig, authZ AuthorizationPolicy) (codeID uint64, checksum []byte, err error)
+	createOrReuse(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *types.AccessConfig, authZ AuthorizationPolicy) (codeID uint64, checksum []byte, err error)
 
 	instantiate(
 		ctx sdk.Context,
@@ -25,12 +26,21 @@ type decoratedKeeper interface {
 
 	migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte, authZ AuthorizationPolicy) ([]byte, error)
 	setContractAdmin(ctx sdk.Context, contractAddress, caller, newAdmin sdk.AccAddress, authZ AuthorizationPolicy) error
+	deleteContract(ctx sdk.Context, contractAddress, caller, recipient sdk.AccAddress, authZ AuthorizationPolicy) error
+	setContractFrozen(ctx sdk.Context, contractAddress, caller sdk.AccAddress, frozen bool, authZ AuthorizationPolicy) error
+	setOperationsDisabled(ctx sdk.Context, caller sdk.AccAddress, ops []types.OperationType, disabled bool, authZ AuthorizationPolicy) error
+	IsOperationDisabled(ctx sdk.Context, op types.OperationType) bool
+	registerCronJob(ctx sdk.Context, job types.CronJob) error
+	deregisterCronJob(ctx sdk.Context, contractAddress sdk.AccAddress) error
+	removeCode(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, authz AuthorizationPolicy) error
 	pinCode(ctx sdk.Context, codeID uint64) error
 	unpinCode(ctx sdk.Context, codeID uint64) error
 	execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error)
 	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
 	setContractInfoExtension(ctx sdk.Context, contract sdk.AccAddress, extra types.ContractInfoExtension) error
 	setAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, newConfig types.AccessConfig, autz AuthorizationPolicy) error
+	setCodeMetadata(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, source, builder string, authZ AuthorizationPolicy) error
+	initCodeMetadata(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, source, builder string, authZ AuthorizationPolicy) error
 	ClassicAddressGenerator() AddressGenerator
 }
 
@@ -55,6 +65,12 @@ func (p PermissionedKeeper) Create(ctx sdk.Context, creator sdk.AccAddress, wasm
 	return p.nested.create(ctx, creator, wasmCode, instantiateAccess, p.authZPolicy)
 }
 
+// CreateOrReuse returns the id of an existing code with the same checksum and instantiate permission or stores the
+// wasm code as a new code when none exists.
+func (p PermissionedKeeper) CreateOrReuse(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *types.AccessConfig) (codeID uint64, checksum []byte, err error) {
+	return p.nested.createOrReuse(ctx, creator, wasmCode, instantiateAccess, p.authZPolicy)
+}
+
 // Instantiate creates an instance of a WASM contract using the classic sequence based address generator
 func (p PermissionedKeeper) Instantiate(
 	ctx sdk.Context,
@@ -111,6 +127,51 @@ func (p PermissionedKeeper) ClearContractAdmin(ctx sdk.Context, contractAddress
 	return p.nested.setContractAdmin(ctx, contractAddress, caller, nil, p.authZPolicy)
 }
 
+// DeleteContract removes the contract with all its state and indexes and sends the remaining balance to the recipient.
+func (p PermissionedKeeper) DeleteContract(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, recipient sdk.AccAddress) error {
+	return p.nested.deleteContract(ctx, contractAddress, caller, recipient, p.authZPolicy)
+}
+
+// RemoveCode removes a code that is not used by any contract and not pinned.
+func (p PermissionedKeeper) RemoveCode(ctx sdk.Context, codeID uint64, caller sdk.AccAddress) error {
+	return p.nested.removeCode(ctx, codeID, caller, p.authZPolicy)
+}
+
+// FreezeContract pauses the contract so that it rejects executions, migrations and IBC packets.
+func (p PermissionedKeeper) FreezeContract(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error {
+	return p.nested.setContractFrozen(ctx, contractAddress, caller, true, p.authZPolicy)
+}
+
+// UnfreezeContract resumes a frozen contract.
+func (p PermissionedKeeper) UnfreezeContract(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error {
+	return p.nested.setContractFrozen(ctx, contractAddress, caller, false, p.authZPolicy)
+}
+
+// DisableOperations trips the circuit breaker so that the given operations are rejected module wide.
+func (p PermissionedKeeper) DisableOperations(ctx sdk.Context, caller sdk.AccAddress, ops []types.OperationType) error {
+	return p.nested.setOperationsDisabled(ctx, caller, ops, true, p.authZPolicy)
+}
+
+// EnableOperations resets the circuit breaker for the given operations.
+func (p PermissionedKeeper) EnableOperations(ctx sdk.Context, caller sdk.AccAddress, ops []types.OperationType) error {
+	return p.nested.setOperationsDisabled(ctx, caller, ops, false, p.authZPolicy)
+}
+
+// IsOperationDisabled returns true when the operation was disabled by the circuit breaker.
+func (p PermissionedKeeper) IsOperationDisabled(ctx sdk.Context, op types.OperationType) bool {
+	return p.nested.IsOperationDisabled(ctx, op)
+}
+
+// RegisterCronJob schedules periodic sudo calls to a contract from the end blocker.
+func (p PermissionedKeeper) RegisterCronJob(ctx sdk.Context, job types.CronJob) error {
+	return p.nested.registerCronJob(ctx, job)
+}
+
+// DeregisterCronJob removes the scheduled sudo calls of a contract.
+func (p PermissionedKeeper) DeregisterCronJob(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	return p.nested.deregisterCronJob(ctx, contractAddress)
+}
+
 func (p PermissionedKeeper) PinCode(ctx sdk.Context, codeID uint64) error {
 	return p.nested.pinCode(ctx, codeID)
 }
@@ -128,3 +189,13 @@ func (p PermissionedKeeper) SetContractInfoExtension(ctx sdk.Context, contract s
 func (p PermissionedKeeper) SetAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, newConfig types.AccessConfig) error {
 	return p.nested.setAccessConfig(ctx, codeID, caller, newConfig, p.authZPolicy)
 }
+
+// UpdateCodeMetadata sets the source and builder of a code id.
+func (p PermissionedKeeper) UpdateCodeMetadata(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, source, builder string) error {
+	return p.nested.setCodeMetadata(ctx, codeID, caller, source, builder, p.authZPolicy)
+}
+
+// InitCodeMetadata sets the source and builder of a code id that was stored by the caller and has no metadata, yet.
+func (p PermissionedKeeper) InitCodeMetadata(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, source, builder string) error {
+	return p.nested.initCodeMetadata(ctx, codeID, caller, source, builder, p.authZPolicy)
+}
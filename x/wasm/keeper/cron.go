@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// GetCronJob returns the cron job registered for the contract or nil when not registered
+func (k Keeper) GetCronJob(ctx sdk.Context, contractAddress sdk.AccAddress) *types.CronJob {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetCronJobKey(contractAddress))
+	if bz == nil {
+		return nil
+	}
+	var job types.CronJob
+	k.cdc.MustUnmarshal(bz, &job)
+	return &job
+}
+
+// IterateCronJobs iterates over all registered cron jobs until the callback returns true
+func (k Keeper) IterateCronJobs(ctx sdk.Context, cb func(types.CronJob) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.CronJobPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var job types.CronJob
+		k.cdc.MustUnmarshal(iter.Value(), &job)
+		if cb(job) {
+			break
+		}
+	}
+}
+
+// storeCronJob persists the job and indexes it by the next run height
+func (k Keeper) storeCronJob(ctx sdk.Context, contractAddress sdk.AccAddress, job types.CronJob) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCronJobKey(contractAddress), k.cdc.MustMarshal(&job))
+	store.Set(types.GetCronScheduleKey(job.NextRunHeight, contractAddress), []byte{1})
+}
+
+// removeCronJob deletes the job and its schedule index entry
+func (k Keeper) removeCronJob(ctx sdk.Context, contractAddress sdk.AccAddress, job types.CronJob) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCronJobKey(contractAddress))
+	store.Delete(types.GetCronScheduleKey(job.NextRunHeight, contractAddress))
+}
+
+// registerCronJob schedules a sudo call to the contract every interval blocks. The first call is made
+// interval blocks after the registration. Jobs with a gas limit above the cron block gas limit are rejected as
+// they could never be executed.
+func (k Keeper) registerCronJob(ctx sdk.Context, job types.CronJob) error {
+	if err := job.ValidateBasic(); err != nil {
+		return err
+	}
+	if blockGasLimit := k.getCronBlockGasLimit(ctx); blockGasLimit != 0 && job.GasLimit > blockGasLimit {
+		return sdkerrors.Wrapf(types.ErrInvalid, "gas limit %d exceeds cron block gas limit %d", job.GasLimit, blockGasLimit)
+	}
+	contractAddress, err := sdk.AccAddressFromBech32(job.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if !k.HasContractInfo(ctx, contractAddress) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if k.GetCronJob(ctx, contractAddress) != nil {
+		return sdkerrors.Wrap(types.ErrDuplicate, "cron job")
+	}
+	job.Failures = 0
+	job.NextRunHeight = ctx.BlockHeight() + int64(job.Interval)
+	k.storeCronJob(ctx, contractAddress, job)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRegisterCronJob,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, job.Contract),
+		sdk.NewAttribute(types.AttributeKeyInterval, strconv.FormatUint(job.Interval, 10)),
+	))
+	return nil
+}
+
+// deregisterCronJob removes the scheduled sudo calls of the contract
+func (k Keeper) deregisterCronJob(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	job := k.GetCronJob(ctx, contractAddress)
+	if job == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "cron job")
+	}
+	k.removeCronJob(ctx, contractAddress, *job)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDeregisterCronJob,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+	))
+	return nil
+}
+
+// importCronJob stores a cron job from genesis
+func (k Keeper) importCronJob(ctx sdk.Context, job types.CronJob) error {
+	contractAddress, err := sdk.AccAddressFromBech32(job.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if !k.HasContractInfo(ctx, contractAddress) {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if k.GetCronJob(ctx, contractAddress) != nil {
+		return sdkerrors.Wrap(types.ErrDuplicate, "cron job")
+	}
+	k.storeCronJob(ctx, contractAddress, job)
+	return nil
+}
+
+// ExecuteCronJobs calls the sudo entry point of all contracts with a cron job that is due at the current block height.
+// Each call runs in a cached context with the gas limit of the job so that a failure reverts all its state changes.
+// Jobs that do not fit into the remaining cron block gas limit stay due for the next block. Jobs with a gas limit above
+// the cron block gas limit can never fit and are deregistered. Frozen contracts are skipped until the next interval.
+func (k Keeper) ExecuteCronJobs(ctx sdk.Context) {
+	blockGasLimit := k.getCronBlockGasLimit(ctx)
+	if blockGasLimit == 0 {
+		return
+	}
+	var due []sdk.AccAddress
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.CronSchedulePrefix).
+		Iterator(nil, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()+1)))
+	for ; iter.Valid(); iter.Next() {
+		due = append(due, sdk.AccAddress(iter.Key()[8:]))
+	}
+	iter.Close()
+
+	var blockGasUsed uint64
+	for _, contractAddress := range due {
+		job := k.GetCronJob(ctx, contractAddress)
+		if job == nil {
+			continue
+		}
+		if job.GasLimit > blockGasLimit {
+			k.removeCronJob(ctx, contractAddress, *job)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeDeregisterCronJob,
+				sdk.NewAttribute(types.AttributeKeyContractAddr, job.Contract),
+			))
+			continue
+		}
+		if job.GasLimit > blockGasLimit-blockGasUsed {
+			continue
+		}
+		k.removeCronJob(ctx, contractAddress, *job)
+		job.NextRunHeight = ctx.BlockHeight() + int64(job.Interval)
+		if info := k.GetContractInfo(ctx, contractAddress); info != nil && info.IsFrozen {
+			k.storeCronJob(ctx, contractAddress, *job)
+			continue
+		}
+
+		gasUsed, err := k.sudoWithGasLimit(ctx, contractAddress, job.Msg, job.GasLimit)
+		blockGasUsed += gasUsed
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeCronJob,
+			sdk.NewAttribute(types.AttributeKeyContractAddr, job.Contract),
+			sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+		))
+		if err == nil {
+			job.Failures = 0
+			k.storeCronJob(ctx, contractAddress, *job)
+			continue
+		}
+
+		k.Logger(ctx).Debug("cron job", "contract", job.Contract, "error", err.Error())
+		job.Failures++
+		if job.MaxFailures != 0 && job.Failures >= job.MaxFailures {
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeDeregisterCronJob,
+				sdk.NewAttribute(types.AttributeKeyContractAddr, job.Contract),
+			))
+			continue
+		}
+		k.storeCronJob(ctx, contractAddress, *job)
+	}
+}
+
+// sudoWithGasLimit calls the sudo entry point of the contract in a cached context with its own gas meter. State
+// changes and events are committed on success only. Returns the gas consumed within the limit.
+// Any panic is recovered and returned as an error so that a contract can not halt the block processing.
+func (k Keeper) sudoWithGasLimit(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte, gasLimit uint64) (gasUsed uint64, err error) {
+	em := sdk.NewEventManager()
+	cacheCtx, commit := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(em).WithGasMeter(sdk.NewGasMeter(gasLimit))
+	defer func() {
+		gasUsed = cacheCtx.GasMeter().GasConsumedToLimit()
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "sudo")
+				return
+			}
+			k.Logger(ctx).Error("sudo panic recovered", "contract", contractAddress.String(), "panic", fmt.Sprintf("%v", r))
+			err = sdkerrors.Wrap(types.ErrExecuteFailed, "sudo panic")
+		}
+	}()
+	if _, err = k.Sudo(cacheCtx, contractAddress, msg); err != nil {
+		return
+	}
+	commit()
+	ctx.EventManager().EmitEvents(em.Events())
+	return
+}
@@ -0,0 +1,344 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	wasmvm "github.com/CosmWasm/wasmvm"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestRegisterCronJob(t *testing.T) {
+	var mock wasmtesting.MockWasmer
+	wasmtesting.MakeInstantiable(&mock)
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithWasmEngine(&mock))
+	k := keepers.WasmKeeper
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	parentCtx = parentCtx.WithBlockHeight(100)
+	params := types.DefaultParams()
+	params.CronBlockGasLimit = 1_000_000
+	k.SetParams(parentCtx, params)
+
+	specs := map[string]struct {
+		src    types.CronJob
+		setup  func(ctx sdk.Context)
+		expErr *sdkerrors.Error
+	}{
+		"all good": {
+			src: types.CronJobFixture(func(j *types.CronJob) {
+				j.Contract = example.Contract.String()
+			}),
+		},
+		"unknown contract": {
+			src: types.CronJobFixture(func(j *types.CronJob) {
+				j.Contract = RandomBech32AccountAddress(t)
+			}),
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		"invalid job": {
+			src: types.CronJobFixture(func(j *types.CronJob) {
+				j.Contract = example.Contract.String()
+				j.Interval = 0
+			}),
+			expErr: types.ErrEmpty,
+		},
+		"gas limit exceeds cron block gas limit": {
+			src: types.CronJobFixture(func(j *types.CronJob) {
+				j.Contract = example.Contract.String()
+				j.GasLimit = 1_000_001
+			}),
+			expErr: types.ErrInvalid,
+		},
+		"already registered": {
+			src: types.CronJobFixture(func(j *types.CronJob) {
+				j.Contract = example.Contract.String()
+			}),
+			setup: func(ctx sdk.Context) {
+				require.NoError(t, k.registerCronJob(ctx, types.CronJobFixture(func(j *types.CronJob) {
+					j.Contract = example.Contract.String()
+				})))
+			},
+			expErr: types.ErrDuplicate,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			if spec.setup != nil {
+				spec.setup(ctx)
+			}
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			// when
+			gotErr := k.registerCronJob(ctx, spec.src)
+			// then
+			require.True(t, spec.expErr.Is(gotErr), "expected %v but got %+v", spec.expErr, gotErr)
+			if spec.expErr != nil {
+				return
+			}
+			exp := spec.src
+			exp.Failures = 0
+			exp.NextRunHeight = 100 + int64(spec.src.Interval)
+			assert.Equal(t, &exp, k.GetCronJob(ctx, example.Contract))
+			assert.True(t, ctx.KVStore(k.storeKey).Has(types.GetCronScheduleKey(exp.NextRunHeight, example.Contract)))
+			assert.Equal(t, sdk.Events{sdk.NewEvent(
+				"register_cron_job",
+				sdk.NewAttribute("_contract_address", example.Contract.String()),
+				sdk.NewAttribute("interval", "10"),
+			)}, ctx.EventManager().Events())
+
+			// and when deregistered
+			require.NoError(t, k.deregisterCronJob(ctx, example.Contract))
+			// then
+			assert.Nil(t, k.GetCronJob(ctx, example.Contract))
+			assert.False(t, ctx.KVStore(k.storeKey).Has(types.GetCronScheduleKey(exp.NextRunHeight, example.Contract)))
+			assert.ErrorIs(t, k.deregisterCronJob(ctx, example.Contract), types.ErrNotFound)
+		})
+	}
+}
+
+func TestExecuteCronJobs(t *testing.T) {
+	const height = 100
+	specs := map[string]struct {
+		blockGasLimit  uint64
+		job            types.CronJob
+		frozen         bool
+		sudoErr        error
+		sudoPanic      interface{}
+		sudoGas        uint64
+		expCalled      bool
+		expJob         *types.CronJob
+		expSuccessAttr string
+	}{
+		"executed when due": {
+			blockGasLimit:  1_000_000,
+			job:            types.CronJob{Interval: 5, GasLimit: 500_000, NextRunHeight: height},
+			expCalled:      true,
+			expJob:         &types.CronJob{Interval: 5, GasLimit: 500_000, NextRunHeight: height + 5},
+			expSuccessAttr: "true",
+		},
+		"overdue job executed": {
+			blockGasLimit:  1_000_000,
+			job:            types.CronJob{Interval: 5, GasLimit: 500_000, NextRunHeight: height - 1},
+			expCalled:      true,
+			expJob:         &types.CronJob{Interval: 5, GasLimit: 500_000, NextRunHeight: height + 5},
+			expSuccessAttr: "true",
+		},
+		"failures reset on success": {
+			blockGasLimit:  1_000_000,
+			job:            types.CronJob{Interval: 5, GasLimit: 500_000, MaxFailures: 3, Failures: 2, NextRunHeight: height},
+			expCalled:      true,
+			expJob:         &types.CronJob{Interval: 5, GasLimit: 500_000, MaxFailures: 3, NextRunHeight: height + 5},
+			expSuccessAttr: "true",
+		},
+		"not due": {
+			blockGasLimit: 1_000_000,
+			job:           types.CronJob{Interval: 5, GasLimit: 500_000, NextRunHeight: height + 1},
+			expJob:        &types.CronJob{Interval: 5, GasLimit: 500_000, NextRunHeight: height + 1},
+		},
+		"cron disabled with zero block gas limit": {
+			job:    types.CronJob{Interval: 5, GasLimit: 500_000, NextRunHeight: height},
+			expJob: &types.CronJob{Interval: 5, GasLimit: 500_000, NextRunHeight: height},
+		},
+		"job exceeding block gas limit deregistered": {
+			blockGasLimit: 100_000,
+			job:           types.CronJob{Interval: 5, GasLimit: 500_000, NextRunHeight: height},
+		},
+		"frozen contract skipped": {
+			blockGasLimit: 1_000_000,
+			job:           types.CronJob{Interval: 5, GasLimit: 500_000, NextRunHeight: height},
+			frozen:        true,
+			expJob:        &types.CronJob{Interval: 5, GasLimit: 500_000, NextRunHeight: height + 5},
+		},
+		"failure skipped": {
+			blockGasLimit:  1_000_000,
+			job:            types.CronJob{Interval: 5, GasLimit: 500_000, NextRunHeight: height},
+			sudoErr:        errors.New("testing"),
+			expCalled:      true,
+			expJob:         &types.CronJob{Interval: 5, GasLimit: 500_000, Failures: 1, NextRunHeight: height + 5},
+			expSuccessAttr: "false",
+		},
+		"failure counted": {
+			blockGasLimit:  1_000_000,
+			job:            types.CronJob{Interval: 5, GasLimit: 500_000, MaxFailures: 2, NextRunHeight: height},
+			sudoErr:        errors.New("testing"),
+			expCalled:      true,
+			expJob:         &types.CronJob{Interval: 5, GasLimit: 500_000, MaxFailures: 2, Failures: 1, NextRunHeight: height + 5},
+			expSuccessAttr: "false",
+		},
+		"deregistered after max failures": {
+			blockGasLimit:  1_000_000,
+			job:            types.CronJob{Interval: 5, GasLimit: 500_000, MaxFailures: 2, Failures: 1, NextRunHeight: height},
+			sudoErr:        errors.New("testing"),
+			expCalled:      true,
+			expSuccessAttr: "false",
+		},
+		"out of gas counted as failure": {
+			blockGasLimit:  1_000_000,
+			job:            types.CronJob{Interval: 5, GasLimit: 500_000, NextRunHeight: height},
+			sudoGas:        DefaultGasMultiplier * 1_000_000,
+			expCalled:      true,
+			expJob:         &types.CronJob{Interval: 5, GasLimit: 500_000, Failures: 1, NextRunHeight: height + 5},
+			expSuccessAttr: "false",
+		},
+		"panic counted as failure": {
+			blockGasLimit:  1_000_000,
+			job:            types.CronJob{Interval: 5, GasLimit: 500_000, NextRunHeight: height},
+			sudoPanic:      "testing",
+			expCalled:      true,
+			expJob:         &types.CronJob{Interval: 5, GasLimit: 500_000, Failures: 1, NextRunHeight: height + 5},
+			expSuccessAttr: "false",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var mock wasmtesting.MockWasmer
+			wasmtesting.MakeInstantiable(&mock)
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithWasmEngine(&mock))
+			k := keepers.WasmKeeper
+			example := SeedNewContractInstance(t, ctx, keepers, &mock)
+			params := types.DefaultParams()
+			params.CronBlockGasLimit = spec.blockGasLimit
+			k.SetParams(ctx, params)
+			if spec.frozen {
+				info := k.GetContractInfo(ctx, example.Contract)
+				info.IsFrozen = true
+				k.storeContractInfo(ctx, example.Contract, info)
+			}
+			job := spec.job
+			job.Contract = example.Contract.String()
+			job.Msg = []byte(`{"tick":{}}`)
+			k.storeCronJob(ctx, example.Contract, job)
+
+			var called bool
+			mock.SudoFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+				called = true
+				assert.Equal(t, []byte(`{"tick":{}}`), sudoMsg)
+				store.Set([]byte("foo"), []byte("bar"))
+				if spec.sudoPanic != nil {
+					panic(spec.sudoPanic)
+				}
+				return &wasmvmtypes.Response{}, spec.sudoGas, spec.sudoErr
+			}
+			ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+
+			// when
+			k.ExecuteCronJobs(ctx)
+
+			// then
+			assert.Equal(t, spec.expCalled, called)
+			if spec.expJob != nil {
+				spec.expJob.Contract, spec.expJob.Msg = job.Contract, job.Msg
+			}
+			assert.Equal(t, spec.expJob, k.GetCronJob(ctx, example.Contract))
+			var scheduled []sdk.AccAddress
+			iter := ctx.KVStore(k.storeKey).Iterator(types.CronSchedulePrefix, sdk.PrefixEndBytes(types.CronSchedulePrefix))
+			for ; iter.Valid(); iter.Next() {
+				scheduled = append(scheduled, iter.Key()[9:])
+			}
+			iter.Close()
+			if spec.expJob != nil {
+				assert.Equal(t, []sdk.AccAddress{example.Contract}, scheduled)
+				assert.True(t, ctx.KVStore(k.storeKey).Has(types.GetCronScheduleKey(spec.expJob.NextRunHeight, example.Contract)))
+			} else {
+				assert.Empty(t, scheduled)
+			}
+			// state committed on success only
+			assert.Equal(t, spec.expSuccessAttr == "true", k.QueryRaw(ctx, example.Contract, []byte("foo")) != nil)
+			var gotSuccessAttr string
+			for _, e := range ctx.EventManager().Events() {
+				if e.Type != types.EventTypeCronJob {
+					continue
+				}
+				for _, a := range e.Attributes {
+					if string(a.Key) == types.AttributeKeySuccess {
+						gotSuccessAttr = string(a.Value)
+					}
+				}
+			}
+			assert.Equal(t, spec.expSuccessAttr, gotSuccessAttr)
+		})
+	}
+}
+
+func TestExecuteCronJobsBlockGasLimit(t *testing.T) {
+	var mock wasmtesting.MockWasmer
+	wasmtesting.MakeInstantiable(&mock)
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithWasmEngine(&mock))
+	k := keepers.WasmKeeper
+	params := types.DefaultParams()
+	params.CronBlockGasLimit = 500_000
+	k.SetParams(ctx, params)
+	first := SeedNewContractInstance(t, ctx, keepers, &mock)
+	second := SeedNewContractInstance(t, ctx, keepers, &mock)
+	for _, c := range []sdk.AccAddress{first.Contract, second.Contract} {
+		k.storeCronJob(ctx, c, types.CronJob{Contract: c.String(), Interval: 1, GasLimit: 500_000, Msg: []byte(`{}`), NextRunHeight: 1})
+	}
+	var calls []sdk.AccAddress
+	mock.SudoFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+		addr, err := sdk.AccAddressFromBech32(env.Contract.Address)
+		require.NoError(t, err)
+		calls = append(calls, addr)
+		return &wasmvmtypes.Response{}, 0, nil
+	}
+
+	// when
+	k.ExecuteCronJobs(ctx.WithBlockHeight(2))
+	// then only one job fits into the block gas limit
+	require.Len(t, calls, 1)
+	other := first.Contract
+	if calls[0].Equals(first.Contract) {
+		other = second.Contract
+	}
+	assert.Equal(t, int64(1), k.GetCronJob(ctx, other).NextRunHeight)
+
+	// and when executed in the next block
+	k.ExecuteCronJobs(ctx.WithBlockHeight(3))
+	// then the remaining job is executed first
+	require.Len(t, calls, 2)
+	assert.Equal(t, other, calls[1])
+}
+
+func TestDeleteContractRemovesCronJob(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	require.NoError(t, k.registerCronJob(ctx, types.CronJobFixture(func(j *types.CronJob) {
+		j.Contract = example.Contract.String()
+	})))
+
+	// when
+	require.NoError(t, keepers.ContractKeeper.DeleteContract(ctx, example.Contract, example.CreatorAddr, RandomAccountAddress(t)))
+
+	// then
+	assert.Nil(t, k.GetCronJob(ctx, example.Contract))
+	assert.False(t, ctx.KVStore(k.storeKey).Iterator(types.CronSchedulePrefix, sdk.PrefixEndBytes(types.CronSchedulePrefix)).Valid())
+}
+
+func TestCronJobsGenesisExportImport(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	require.NoError(t, k.registerCronJob(ctx, types.CronJobFixture(func(j *types.CronJob) {
+		j.Contract = example.Contract.String()
+	})))
+	expJob := k.GetCronJob(ctx, example.Contract)
+
+	// when
+	exported := ExportGenesis(ctx, k)
+	require.Equal(t, []types.CronJob{*expJob}, exported.CronJobs)
+
+	dstKeeper, dstCtx, _ := setupKeeper(t)
+	_, err := InitGenesis(dstCtx, dstKeeper, *exported)
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, expJob, dstKeeper.GetCronJob(dstCtx, example.Contract))
+	assert.True(t, dstCtx.KVStore(dstKeeper.storeKey).Has(types.GetCronScheduleKey(expJob.NextRunHeight, example.Contract)))
+}
@@ -0,0 +1,229 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// eventSubscriptionBufferSize is the number of events buffered for a subscriber. Subscribers that fall behind by more
+// events are dropped so that block processing is never blocked.
+const eventSubscriptionBufferSize = 1024
+
+var _ baseapp.StreamingService = &ContractEventBroker{}
+
+// ContractEventBroker collects the events emitted by contracts and publishes them to the subscribers when the block
+// is committed.
+type ContractEventBroker struct {
+	keeper           types.ViewKeeper
+	maxSubscriptions int
+
+	mtx           sync.Mutex
+	subscriptions map[*eventSubscription]struct{}
+	// height of the current block
+	height int64
+	// pending contract events of the current block
+	pending []*types.QuerySubscribeContractEventsResponse
+}
+
+// NewContractEventBroker constructor
+func NewContractEventBroker(keeper types.ViewKeeper, maxSubscriptions uint32) *ContractEventBroker {
+	return &ContractEventBroker{
+		keeper:           keeper,
+		maxSubscriptions: int(maxSubscriptions),
+		subscriptions:    make(map[*eventSubscription]struct{}),
+	}
+}
+
+type eventSubscription struct {
+	contracts map[string]struct{}
+	codeIDs   map[uint64]struct{}
+	attrs     []types.ContractEventAttribute
+	// events is closed by the broker when the subscriber was dropped
+	events chan *types.QuerySubscribeContractEventsResponse
+}
+
+// matches returns true when the event passes the subscription filter
+func (s eventSubscription) matches(evt *types.QuerySubscribeContractEventsResponse) bool {
+	if len(s.contracts) != 0 || len(s.codeIDs) != 0 {
+		_, contractOK := s.contracts[evt.ContractAddress]
+		_, codeOK := s.codeIDs[evt.CodeID]
+		if !contractOK && !codeOK {
+			return false
+		}
+	}
+	for _, filter := range s.attrs {
+		if !hasEventAttribute(evt.Attributes, filter) {
+			return false
+		}
+	}
+	return true
+}
+
+func hasEventAttribute(attrs []types.ContractEventAttribute, filter types.ContractEventAttribute) bool {
+	for _, a := range attrs {
+		if a.Key == filter.Key && (filter.Value == "" || a.Value == filter.Value) {
+			return true
+		}
+	}
+	return false
+}
+
+// subscribe registers a new subscription for the contract events that match the request
+func (b *ContractEventBroker) subscribe(req *types.QuerySubscribeContractEventsRequest) (*eventSubscription, error) {
+	sub := &eventSubscription{
+		contracts: make(map[string]struct{}, len(req.ContractAddresses)),
+		codeIDs:   make(map[uint64]struct{}, len(req.CodeIDs)),
+		attrs:     req.Attributes,
+		events:    make(chan *types.QuerySubscribeContractEventsResponse, eventSubscriptionBufferSize),
+	}
+	for _, a := range req.ContractAddresses {
+		addr, err := sdk.AccAddressFromBech32(a)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "contract address %q: %s", a, err)
+		}
+		sub.contracts[addr.String()] = struct{}{}
+	}
+	for _, id := range req.CodeIDs {
+		sub.codeIDs[id] = struct{}{}
+	}
+	for _, a := range req.Attributes {
+		if strings.TrimSpace(a.Key) == "" {
+			return nil, status.Error(codes.InvalidArgument, "empty attribute key")
+		}
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	if len(b.subscriptions) >= b.maxSubscriptions {
+		return nil, status.Error(codes.ResourceExhausted, "max contract event subscriptions reached")
+	}
+	b.subscriptions[sub] = struct{}{}
+	return sub, nil
+}
+
+// unsubscribe removes the subscription
+func (b *ContractEventBroker) unsubscribe(sub *eventSubscription) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	delete(b.subscriptions, sub)
+}
+
+func (b *ContractEventBroker) hasSubscriptions() bool {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	return len(b.subscriptions) != 0
+}
+
+// Listeners returns no store listeners as only the ABCI events are processed
+func (b *ContractEventBroker) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return nil
+}
+
+// ListenBeginBlock collects the contract events emitted in the begin blocker
+func (b *ContractEventBroker) ListenBeginBlock(goCtx context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	b.height = req.Header.Height
+	b.pending = nil
+	b.collect(sdk.UnwrapSDKContext(goCtx), nil, res.Events)
+	return nil
+}
+
+// ListenDeliverTx collects the contract events emitted by a successful tx
+func (b *ContractEventBroker) ListenDeliverTx(goCtx context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	if !res.IsOK() {
+		return nil
+	}
+	b.collect(sdk.UnwrapSDKContext(goCtx), tmhash.Sum(req.Tx), res.Events)
+	return nil
+}
+
+// ListenEndBlock collects the contract events emitted in the end blocker
+func (b *ContractEventBroker) ListenEndBlock(goCtx context.Context, _ abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	b.collect(sdk.UnwrapSDKContext(goCtx), nil, res.Events)
+	return nil
+}
+
+// ListenCommit publishes the contract events of the block to the subscribers
+func (b *ContractEventBroker) ListenCommit(context.Context, abci.ResponseCommit) error {
+	pending := b.pending
+	b.pending = nil
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	for sub := range b.subscriptions {
+		for _, evt := range pending {
+			if sub.matches(evt) && !b.publish(sub, evt) {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// publish sends the event to the subscriber without blocking. A subscriber that can not keep up is dropped and
+// false returned. Must be called with the lock held.
+func (b *ContractEventBroker) publish(sub *eventSubscription, evt *types.QuerySubscribeContractEventsResponse) bool {
+	select {
+	case sub.events <- evt:
+		return true
+	default:
+		delete(b.subscriptions, sub)
+		close(sub.events)
+		return false
+	}
+}
+
+// Stream is a noop as events are published synchronously on commit
+func (b *ContractEventBroker) Stream(*sync.WaitGroup) error {
+	return nil
+}
+
+// Close is a noop
+func (b *ContractEventBroker) Close() error {
+	return nil
+}
+
+// collect converts the contract events into the subscription response type
+func (b *ContractEventBroker) collect(ctx sdk.Context, txHash []byte, events []abci.Event) {
+	if !b.hasSubscriptions() {
+		return
+	}
+	codeIDs := make(map[string]uint64)
+	for _, e := range events {
+		if e.Type != types.WasmModuleEventType && !strings.HasPrefix(e.Type, types.CustomContractEventPrefix) {
+			continue
+		}
+		evt := &types.QuerySubscribeContractEventsResponse{
+			Height: b.height,
+			TxHash: txHash,
+			Type:   e.Type,
+		}
+		for _, a := range e.Attributes {
+			if string(a.Key) == types.AttributeKeyContractAddr {
+				evt.ContractAddress = string(a.Value)
+			}
+			evt.Attributes = append(evt.Attributes, types.ContractEventAttribute{Key: string(a.Key), Value: string(a.Value)})
+		}
+		codeID, ok := codeIDs[evt.ContractAddress]
+		if !ok {
+			if addr, err := sdk.AccAddressFromBech32(evt.ContractAddress); err == nil {
+				if info := b.keeper.GetContractInfo(ctx, addr); info != nil {
+					codeID = info.CodeID
+				}
+			}
+			codeIDs[evt.ContractAddress] = codeID
+		}
+		evt.CodeID = codeID
+		b.pending = append(b.pending, evt)
+	}
+}
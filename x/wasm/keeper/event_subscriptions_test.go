@@ -0,0 +1,249 @@
+package keeper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestSubscribeContractEvents(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	otherContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	broker := NewContractEventBroker(keepers.WasmKeeper, 1)
+	q := Querier(keepers.WasmKeeper)
+	q.eventBroker = broker
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	contractEvent := func(evtType string, contractAddr sdk.AccAddress, attrs ...string) abci.Event {
+		evt := sdk.NewEvent(evtType, sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()))
+		for i := 0; i < len(attrs); i += 2 {
+			evt = evt.AppendAttributes(sdk.NewAttribute(attrs[i], attrs[i+1]))
+		}
+		return abci.Event(evt)
+	}
+	tx := []byte("my tx")
+
+	// when subscribed
+	streamCtx, cancel := context.WithCancel(context.Background())
+	stream := &mockContractEventsStream{ctx: streamCtx, events: make(chan *types.QuerySubscribeContractEventsResponse, 10)}
+	done := make(chan error)
+	go func() {
+		done <- q.SubscribeContractEvents(&types.QuerySubscribeContractEventsRequest{
+			CodeIDs:    []uint64{example.CodeID},
+			Attributes: []types.ContractEventAttribute{{Key: "action", Value: "release"}},
+		}, stream)
+	}()
+	require.Eventually(t, broker.hasSubscriptions, time.Second, time.Millisecond)
+
+	// and a block with events committed
+	require.NoError(t, broker.ListenBeginBlock(goCtx, abci.RequestBeginBlock{Header: tmproto.Header{Height: 10}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, broker.ListenDeliverTx(goCtx, abci.RequestDeliverTx{Tx: tx}, abci.ResponseDeliverTx{Events: []abci.Event{
+		{Type: sdk.EventTypeMessage},
+		contractEvent(types.WasmModuleEventType, example.Contract, "action", "release"),
+		contractEvent(types.WasmModuleEventType, example.Contract, "action", "other"),
+		contractEvent(types.CustomContractEventPrefix+"custom", otherContract.Contract, "action", "release"),
+	}}))
+	failedTx := abci.ResponseDeliverTx{Code: sdkerrors.ErrInvalidRequest.ABCICode(), Events: []abci.Event{
+		contractEvent(types.WasmModuleEventType, example.Contract, "action", "release"),
+	}}
+	require.NoError(t, broker.ListenDeliverTx(goCtx, abci.RequestDeliverTx{Tx: []byte("failed")}, failedTx))
+	require.NoError(t, broker.ListenEndBlock(goCtx, abci.RequestEndBlock{}, abci.ResponseEndBlock{Events: []abci.Event{
+		contractEvent(types.CustomContractEventPrefix+"cron", example.Contract, "action", "release"),
+	}}))
+	require.Empty(t, stream.events)
+	require.NoError(t, broker.ListenCommit(goCtx, abci.ResponseCommit{}))
+
+	// then
+	exp := []*types.QuerySubscribeContractEventsResponse{
+		{
+			Height:          10,
+			TxHash:          tmhash.Sum(tx),
+			ContractAddress: example.Contract.String(),
+			CodeID:          example.CodeID,
+			Type:            types.WasmModuleEventType,
+			Attributes: []types.ContractEventAttribute{
+				{Key: types.AttributeKeyContractAddr, Value: example.Contract.String()},
+				{Key: "action", Value: "release"},
+			},
+		},
+		{
+			Height:          10,
+			ContractAddress: example.Contract.String(),
+			CodeID:          example.CodeID,
+			Type:            types.CustomContractEventPrefix + "cron",
+			Attributes: []types.ContractEventAttribute{
+				{Key: types.AttributeKeyContractAddr, Value: example.Contract.String()},
+				{Key: "action", Value: "release"},
+			},
+		},
+	}
+	for _, e := range exp {
+		select {
+		case got := <-stream.events:
+			assert.Equal(t, e, got)
+		case <-time.After(time.Second):
+			t.Fatal("timeout")
+		}
+	}
+	assert.Empty(t, stream.events)
+
+	// and max subscriptions reached
+	err := q.SubscribeContractEvents(&types.QuerySubscribeContractEventsRequest{}, stream)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// when client disconnects
+	cancel()
+	assert.Equal(t, codes.Canceled, status.Code(<-done))
+	assert.False(t, broker.hasSubscriptions())
+}
+
+func TestSubscribeContractEventsDropsSlowSubscriber(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	broker := NewContractEventBroker(keepers.WasmKeeper, 1)
+	sub, err := broker.subscribe(&types.QuerySubscribeContractEventsRequest{})
+	require.NoError(t, err)
+
+	goCtx := sdk.WrapSDKContext(ctx)
+	events := make([]abci.Event, eventSubscriptionBufferSize+1)
+	for i := range events {
+		events[i] = abci.Event{Type: types.WasmModuleEventType}
+	}
+	require.NoError(t, broker.ListenDeliverTx(goCtx, abci.RequestDeliverTx{}, abci.ResponseDeliverTx{Events: events}))
+	require.NoError(t, broker.ListenCommit(goCtx, abci.ResponseCommit{}))
+
+	assert.False(t, broker.hasSubscriptions())
+	for i := 0; i < eventSubscriptionBufferSize; i++ {
+		<-sub.events
+	}
+	_, open := <-sub.events
+	assert.False(t, open)
+}
+
+func TestSubscribeContractEventsValidation(t *testing.T) {
+	_, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	stream := &mockContractEventsStream{ctx: context.Background()}
+	specs := map[string]struct {
+		req     *types.QuerySubscribeContractEventsRequest
+		broker  *ContractEventBroker
+		expCode codes.Code
+	}{
+		"nil request": {
+			broker:  NewContractEventBroker(keepers.WasmKeeper, 1),
+			expCode: codes.InvalidArgument,
+		},
+		"invalid contract address": {
+			req:     &types.QuerySubscribeContractEventsRequest{ContractAddresses: []string{"invalid"}},
+			broker:  NewContractEventBroker(keepers.WasmKeeper, 1),
+			expCode: codes.InvalidArgument,
+		},
+		"empty attribute key": {
+			req:     &types.QuerySubscribeContractEventsRequest{Attributes: []types.ContractEventAttribute{{Value: "foo"}}},
+			broker:  NewContractEventBroker(keepers.WasmKeeper, 1),
+			expCode: codes.InvalidArgument,
+		},
+		"subscriptions disabled": {
+			req:     &types.QuerySubscribeContractEventsRequest{},
+			expCode: codes.Unimplemented,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			q := Querier(keepers.WasmKeeper)
+			q.eventBroker = spec.broker
+			err := q.SubscribeContractEvents(spec.req, stream)
+			assert.Equal(t, spec.expCode, status.Code(err))
+		})
+	}
+}
+
+func TestEventSubscriptionMatches(t *testing.T) {
+	myContract, otherContract := RandomBech32AccountAddress(t), RandomBech32AccountAddress(t)
+	evt := &types.QuerySubscribeContractEventsResponse{
+		ContractAddress: myContract,
+		CodeID:          1,
+		Type:            types.WasmModuleEventType,
+		Attributes: []types.ContractEventAttribute{
+			{Key: types.AttributeKeyContractAddr, Value: myContract},
+			{Key: "action", Value: "release"},
+		},
+	}
+	specs := map[string]struct {
+		req *types.QuerySubscribeContractEventsRequest
+		exp bool
+	}{
+		"no filter": {
+			req: &types.QuerySubscribeContractEventsRequest{},
+			exp: true,
+		},
+		"contract address": {
+			req: &types.QuerySubscribeContractEventsRequest{ContractAddresses: []string{otherContract, myContract}},
+			exp: true,
+		},
+		"other contract address": {
+			req: &types.QuerySubscribeContractEventsRequest{ContractAddresses: []string{otherContract}},
+		},
+		"code id": {
+			req: &types.QuerySubscribeContractEventsRequest{CodeIDs: []uint64{1}},
+			exp: true,
+		},
+		"other code id": {
+			req: &types.QuerySubscribeContractEventsRequest{CodeIDs: []uint64{2}},
+		},
+		"other contract address or code id": {
+			req: &types.QuerySubscribeContractEventsRequest{ContractAddresses: []string{otherContract}, CodeIDs: []uint64{1}},
+			exp: true,
+		},
+		"attribute key and value": {
+			req: &types.QuerySubscribeContractEventsRequest{Attributes: []types.ContractEventAttribute{{Key: "action", Value: "release"}}},
+			exp: true,
+		},
+		"attribute key with any value": {
+			req: &types.QuerySubscribeContractEventsRequest{Attributes: []types.ContractEventAttribute{{Key: "action"}}},
+			exp: true,
+		},
+		"other attribute value": {
+			req: &types.QuerySubscribeContractEventsRequest{Attributes: []types.ContractEventAttribute{{Key: "action", Value: "other"}}},
+		},
+		"all attributes must match": {
+			req: &types.QuerySubscribeContractEventsRequest{Attributes: []types.ContractEventAttribute{{Key: "action"}, {Key: "other"}}},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			sub, err := NewContractEventBroker(nil, 1).subscribe(spec.req)
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp, sub.matches(evt))
+		})
+	}
+}
+
+var _ types.Query_SubscribeContractEventsServer = &mockContractEventsStream{}
+
+type mockContractEventsStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *types.QuerySubscribeContractEventsResponse
+}
+
+func (m *mockContractEventsStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockContractEventsStream) Send(evt *types.QuerySubscribeContractEventsResponse) error {
+	m.events <- evt
+	return nil
+}
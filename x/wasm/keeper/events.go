@@ -7,6 +7,7 @@ import (
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
 
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
@@ -65,3 +66,11 @@ func contractSDKEventAttributes(customAttributes []wasmvmtypes.EventAttribute, c
 	}
 	return attrs, nil
 }
+
+// emitTypedEvent emits the proto typed event when enabled in the wasm config
+func (k Keeper) emitTypedEvent(ctx sdk.Context, evt proto.Message) error {
+	if !k.emitTypedEvents {
+		return nil
+	}
+	return ctx.EventManager().EmitTypedEvent(evt)
+}
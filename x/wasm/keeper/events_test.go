@@ -2,11 +2,14 @@ package keeper
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
@@ -288,3 +291,115 @@ func hasWasmModuleEvent(ctx sdk.Context, contractAddr sdk.AccAddress) bool {
 	}
 	return false
 }
+
+func TestTypedEvents(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k, contractKeeper := keepers.WasmKeeper, keepers.ContractKeeper
+
+	creator := keepers.Faucet.NewFundedRandomAccount(parentCtx, sdk.NewInt64Coin("denom", 100000))
+	newAdmin := RandomAccountAddress(t)
+	initMsg := HackatomExampleInitMsg{Verifier: RandomAccountAddress(t), Beneficiary: RandomAccountAddress(t)}.GetBytes(t)
+
+	// lastTypedEvent returns the last typed event emitted or nil
+	lastTypedEvent := func(t *testing.T, em *sdk.EventManager) proto.Message {
+		t.Helper()
+		var result proto.Message
+		for _, e := range em.ABCIEvents() {
+			if !strings.HasPrefix(e.Type, "cosmwasm.wasm.v1.") {
+				continue
+			}
+			msg, err := sdk.ParseTypedEvent(e)
+			require.NoError(t, err)
+			result = msg
+		}
+		return result
+	}
+	assertTypedEvent := func(t *testing.T, exp proto.Message, em *sdk.EventManager) {
+		t.Helper()
+		got := lastTypedEvent(t, em)
+		assert.True(t, proto.Equal(exp, got), "exp %s but got %s", exp, got)
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		k.emitTypedEvents = false
+		em := sdk.NewEventManager()
+		_, _, err := contractKeeper.Create(parentCtx.WithEventManager(em), creator, hackatomWasm, nil)
+		require.NoError(t, err)
+		assert.Nil(t, lastTypedEvent(t, em))
+		assert.NotEmpty(t, em.Events())
+	})
+
+	k.emitTypedEvents = true
+	defer func() { k.emitTypedEvents = false }()
+
+	em := sdk.NewEventManager()
+	ctx := parentCtx.WithEventManager(em)
+	codeID, checksum, err := contractKeeper.Create(ctx, creator, hackatomWasm, nil)
+	require.NoError(t, err)
+	assertTypedEvent(t, &types.EventCodeStored{
+		CodeID:                codeID,
+		Creator:               creator.String(),
+		Checksum:              checksum,
+		InstantiatePermission: types.AllowEverybody,
+	}, em)
+	// legacy events are still emitted
+	assert.Equal(t, types.EventTypeStoreCode, em.Events()[0].Type)
+
+	em = sdk.NewEventManager()
+	ctx = parentCtx.WithEventManager(em)
+	contractAddr, _, err := contractKeeper.Instantiate(ctx, codeID, creator, creator, initMsg, "my label", nil)
+	require.NoError(t, err)
+	assertTypedEvent(t, &types.EventContractInstantiated{
+		ContractAddress: contractAddr.String(),
+		CodeID:          codeID,
+		Creator:         creator.String(),
+		Admin:           creator.String(),
+		Label:           "my label",
+	}, em)
+
+	em = sdk.NewEventManager()
+	ctx = parentCtx.WithEventManager(em)
+	newCodeID, _, err := contractKeeper.Create(ctx, creator, hackatomWasm, nil)
+	require.NoError(t, err)
+	_, err = contractKeeper.Migrate(ctx, contractAddr, creator, newCodeID, []byte(`{"verifier":"`+creator.String()+`"}`))
+	require.NoError(t, err)
+	assertTypedEvent(t, &types.EventContractMigrated{
+		ContractAddress: contractAddr.String(),
+		OldCodeID:       codeID,
+		NewCodeID:       newCodeID,
+		Sender:          creator.String(),
+	}, em)
+
+	em = sdk.NewEventManager()
+	ctx = parentCtx.WithEventManager(em)
+	require.NoError(t, contractKeeper.UpdateContractAdmin(ctx, contractAddr, creator, newAdmin))
+	assertTypedEvent(t, &types.EventContractAdminSet{
+		ContractAddress: contractAddr.String(),
+		OldAdmin:        creator.String(),
+		NewAdmin:        newAdmin.String(),
+	}, em)
+
+	em = sdk.NewEventManager()
+	ctx = parentCtx.WithEventManager(em)
+	require.NoError(t, contractKeeper.ClearContractAdmin(ctx, contractAddr, newAdmin))
+	assertTypedEvent(t, &types.EventContractAdminSet{
+		ContractAddress: contractAddr.String(),
+		OldAdmin:        newAdmin.String(),
+	}, em)
+
+	em = sdk.NewEventManager()
+	ctx = parentCtx.WithEventManager(em)
+	require.NoError(t, contractKeeper.PinCode(ctx, codeID))
+	assertTypedEvent(t, &types.EventCodePinned{CodeID: codeID}, em)
+
+	em = sdk.NewEventManager()
+	ctx = parentCtx.WithEventManager(em)
+	require.NoError(t, contractKeeper.UnpinCode(ctx, codeID))
+	assertTypedEvent(t, &types.EventCodeUnpinned{CodeID: codeID}, em)
+
+	em = sdk.NewEventManager()
+	ctx = parentCtx.WithEventManager(em)
+	newConfig := types.AccessTypeOnlyAddress.With(creator)
+	require.NoError(t, contractKeeper.SetAccessConfig(ctx, codeID, creator, newConfig))
+	assertTypedEvent(t, &types.EventCodeAccessConfigUpdated{CodeID: codeID, InstantiatePermission: newConfig}, em)
+}
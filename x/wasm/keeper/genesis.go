@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/CosmWasm/wasmd/x/wasm/types"
@@ -41,7 +42,7 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState) ([]ab
 		if err != nil {
 			return nil, sdkerrors.Wrapf(err, "address in contract number %d", i)
 		}
-		err = keeper.importContract(ctx, contractAddr, &contract.ContractInfo, contract.ContractState, contract.ContractCodeHistory)
+		err = keeper.importContract(ctx, contractAddr, &contract.ContractInfo, contract.ContractState, contract.ContractCodeHistory, contract.StorageDeposit)
 		if err != nil {
 			return nil, sdkerrors.Wrapf(err, "contract number %d", i)
 		}
@@ -55,6 +56,40 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState) ([]ab
 		}
 	}
 
+	for _, op := range data.DisabledOperations {
+		keeper.setOperationDisabled(ctx, op, true)
+	}
+
+	for i, job := range data.CronJobs {
+		if err := keeper.importCronJob(ctx, job); err != nil {
+			return nil, sdkerrors.Wrapf(err, "cron job number %d", i)
+		}
+	}
+
+	var maxCallbackID uint64
+	for i, callback := range data.Callbacks {
+		if err := keeper.importCallback(ctx, callback); err != nil {
+			return nil, sdkerrors.Wrapf(err, "callback number %d", i)
+		}
+		if callback.ID > maxCallbackID {
+			maxCallbackID = callback.ID
+		}
+	}
+
+	for i, callback := range data.IBCTransferCallbacks {
+		if err := keeper.importIBCTransferCallback(ctx, callback); err != nil {
+			return nil, sdkerrors.Wrapf(err, "ibc transfer callback number %d", i)
+		}
+	}
+
+	for i, packet := range data.AsyncAckPackets {
+		if err := keeper.importAsyncAckPacket(ctx, packet); err != nil {
+			return nil, sdkerrors.Wrapf(err, "async ack packet number %d", i)
+		}
+	}
+
+	keeper.SyncContractLabelIndex(ctx)
+
 	// sanity check seq values
 	seqVal := keeper.PeekAutoIncrementID(ctx, types.KeyLastCodeID)
 	if seqVal <= maxCodeID {
@@ -64,6 +99,10 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState) ([]ab
 	if seqVal <= uint64(maxContractID) {
 		return nil, sdkerrors.Wrapf(types.ErrInvalid, "seq %s with value: %d must be greater than: %d ", string(types.KeyLastInstanceID), seqVal, maxContractID)
 	}
+	seqVal = keeper.PeekAutoIncrementID(ctx, types.KeyLastCallbackID)
+	if seqVal <= maxCallbackID {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "seq %s with value: %d must be greater than: %d ", string(types.KeyLastCallbackID), seqVal, maxCallbackID)
+	}
 	return nil, nil
 }
 
@@ -101,16 +140,34 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 			ContractInfo:        contract,
 			ContractState:       state,
 			ContractCodeHistory: contractCodeHistory,
+			StorageDeposit:      keeper.GetContractStorageStats(ctx, addr).Deposit,
 		})
 		return false
 	})
 
-	for _, k := range [][]byte{types.KeyLastCodeID, types.KeyLastInstanceID} {
+	for _, k := range [][]byte{types.KeyLastCodeID, types.KeyLastInstanceID, types.KeyLastCallbackID} {
 		genState.Sequences = append(genState.Sequences, types.Sequence{
 			IDKey: k,
 			Value: keeper.PeekAutoIncrementID(ctx, k),
 		})
 	}
+	genState.DisabledOperations = keeper.GetDisabledOperations(ctx)
+	keeper.IterateCronJobs(ctx, func(job types.CronJob) bool {
+		genState.CronJobs = append(genState.CronJobs, job)
+		return false
+	})
+	keeper.IterateCallbacks(ctx, func(callback types.Callback) bool {
+		genState.Callbacks = append(genState.Callbacks, callback)
+		return false
+	})
+	keeper.IterateIBCTransferCallbacks(ctx, func(callback types.IBCTransferCallback) bool {
+		genState.IBCTransferCallbacks = append(genState.IBCTransferCallbacks, callback)
+		return false
+	})
+	keeper.IterateAsyncAckPackets(ctx, func(packet channeltypes.Packet) bool {
+		genState.AsyncAckPackets = append(genState.AsyncAckPackets, packet)
+		return false
+	})
 
 	return &genState
 }
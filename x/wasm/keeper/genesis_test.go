@@ -18,6 +18,7 @@ import (
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	fuzz "github.com/google/gofuzz"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -77,9 +78,22 @@ func TestGenesisExportImport(t *testing.T) {
 
 		contract.CodeID = codeID
 		contractAddr := wasmKeeper.ClassicAddressGenerator()(srcCtx, codeID, nil)
+		if i == 0 {
+			contract.IBCPortID = PortIDForContract(contractAddr)
+		}
 		wasmKeeper.storeContractInfo(srcCtx, contractAddr, &contract)
 		wasmKeeper.appendToContractHistory(srcCtx, contractAddr, history...)
 		wasmKeeper.importContractState(srcCtx, contractAddr, stateModels)
+		if i == 0 {
+			wasmKeeper.storeCallback(srcCtx, contractAddr, types.CallbackFixture(func(c *types.Callback) {
+				c.ID = wasmKeeper.autoIncrementID(srcCtx, types.KeyLastCallbackID)
+				c.Contract = contractAddr.String()
+			}))
+			wasmKeeper.setIBCTransferCallback(srcCtx, "channel-1", 7, contractAddr)
+			wasmKeeper.setAsyncAckPacket(srcCtx, types.AsyncAckPacketFixture(func(p *channeltypes.Packet) {
+				p.DestinationPort = contract.IBCPortID
+			}))
+		}
 	}
 	var wasmParams types.Params
 	f.NilChance(0).Fuzz(&wasmParams)
@@ -103,21 +117,32 @@ func TestGenesisExportImport(t *testing.T) {
 	// setup new instances
 	dstKeeper, dstCtx, dstStoreKeys := setupKeeper(t)
 
-	// reset contract code index in source DB for comparison with dest DB
+	// reset contract code, creator and admin index and storage stats in source DB for comparison with dest DB
 	wasmKeeper.IterateContractInfo(srcCtx, func(address sdk.AccAddress, info wasmTypes.ContractInfo) bool {
 		creatorAddress := sdk.MustAccAddressFromBech32(info.Creator)
 		history := wasmKeeper.GetContractHistory(srcCtx, address)
 
-		wasmKeeper.addToContractCodeSecondaryIndex(srcCtx, address, history[len(history)-1])
+		lastCodeEntry := history[0]
+		for _, e := range history {
+			if e.Operation.IsCodeUpdate() {
+				lastCodeEntry = e
+			}
+		}
+		wasmKeeper.addToContractCodeSecondaryIndex(srcCtx, address, lastCodeEntry)
 		wasmKeeper.addToContractCreatorSecondaryIndex(srcCtx, creatorAddress, history[0].Updated, address)
+		wasmKeeper.addToContractAdminSecondaryIndex(srcCtx, info.AdminAddr(), address)
+		wasmKeeper.setContractStorageStats(srcCtx, address, wasmKeeper.calculateContractStorageStats(srcCtx, address))
 		return false
 	})
 
+	wasmKeeper.SyncContractLabelIndex(srcCtx)
+
 	// re-import
 	var importState wasmTypes.GenesisState
 	err = dstKeeper.cdc.UnmarshalJSON(exportedGenesis, &importState)
 	require.NoError(t, err)
-	InitGenesis(dstCtx, dstKeeper, importState)
+	_, err = InitGenesis(dstCtx, dstKeeper, importState)
+	require.NoError(t, err)
 
 	// compare whole DB
 	for j := range srcStoreKeys {
@@ -570,6 +595,7 @@ func TestImportContractWithCodeHistoryPreserved(t *testing.T) {
 			Permission: types.AccessTypeOnlyAddress,
 			Address:    codeCreatorAddr,
 		},
+		WasmSize: uint64(len(wasmCode)),
 	}
 	assert.Equal(t, expCodeInfo, *gotCodeInfo)
 
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -191,6 +192,30 @@ func (h IBCRawPacketHandler) DispatchMsg(ctx sdk.Context, _ sdk.AccAddress, cont
 	return nil, nil, h.channelKeeper.SendPacket(ctx, channelCap, packet)
 }
 
+// circuitBreaker provides the state of operations that can be disabled module wide
+type circuitBreaker interface {
+	IsOperationDisabled(ctx sdk.Context, op types.OperationType) bool
+}
+
+// CircuitBreakerMessageHandler rejects messages dispatched by contracts for operations that were disabled by the
+// circuit breaker before they are passed to the nested handler.
+type CircuitBreakerMessageHandler struct {
+	nested  Messenger
+	breaker circuitBreaker
+}
+
+func NewCircuitBreakerMessageHandler(nested Messenger, breaker circuitBreaker) CircuitBreakerMessageHandler {
+	return CircuitBreakerMessageHandler{nested: nested, breaker: breaker}
+}
+
+// DispatchMsg fails with ErrOperationDisabled for disabled operations or delegates to the nested handler.
+func (h CircuitBreakerMessageHandler) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, err error) {
+	if op, ok := OperationTypeOf(msg); ok && h.breaker.IsOperationDisabled(ctx, op) {
+		return nil, nil, sdkerrors.Wrap(types.ErrOperationDisabled, op.String())
+	}
+	return h.nested.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+}
+
 var _ Messenger = MessageHandlerFunc(nil)
 
 // MessageHandlerFunc is a helper to construct a function based message handler.
@@ -224,3 +249,65 @@ func NewBurnCoinMessageHandler(burner types.Burner) MessageHandlerFunc {
 		return nil, nil, types.ErrUnknownMsg
 	}
 }
+
+// callbackScheduler is the subset of the keeper to manage the callbacks of a contract
+type callbackScheduler interface {
+	scheduleCallback(ctx sdk.Context, contractAddress sdk.AccAddress, height int64, gasLimit uint64, msg types.RawContractMessage) (uint64, error)
+	cancelCallback(ctx sdk.Context, contractAddress sdk.AccAddress, callbackID uint64) error
+}
+
+// callbackMsg is the custom message a contract sends to schedule or cancel a one-shot callback to itself
+type callbackMsg struct {
+	ScheduleCallback *struct {
+		// Height is the block height at which the callback is executed
+		Height int64 `json:"height"`
+		// GasLimit is the max gas that can be consumed by the execution
+		GasLimit uint64 `json:"gas_limit"`
+		// Msg is the payload passed to the sudo entry point of the contract
+		Msg types.RawContractMessage `json:"msg"`
+	} `json:"schedule_callback,omitempty"`
+	CancelCallback *struct {
+		// CallbackID is the id returned when the callback was scheduled
+		CallbackID uint64 `json:"callback_id"`
+	} `json:"cancel_callback,omitempty"`
+}
+
+// scheduleCallbackResponse is returned as message data when a callback was scheduled
+type scheduleCallbackResponse struct {
+	CallbackID uint64 `json:"callback_id"`
+}
+
+// NewCallbackMessageHandler handles the custom messages to schedule and cancel callbacks. Any other message,
+// including custom messages of a different shape, is passed on.
+func NewCallbackMessageHandler(k callbackScheduler) MessageHandlerFunc {
+	return func(ctx sdk.Context, contractAddr sdk.AccAddress, _ string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, err error) {
+		if msg.Custom == nil {
+			return nil, nil, types.ErrUnknownMsg
+		}
+		var cMsg callbackMsg
+		if err := json.Unmarshal(msg.Custom, &cMsg); err != nil {
+			return nil, nil, types.ErrUnknownMsg
+		}
+		em := sdk.NewEventManager()
+		ctx = ctx.WithEventManager(em)
+		switch {
+		case cMsg.ScheduleCallback != nil:
+			id, err := k.scheduleCallback(ctx, contractAddr, cMsg.ScheduleCallback.Height, cMsg.ScheduleCallback.GasLimit, cMsg.ScheduleCallback.Msg)
+			if err != nil {
+				return nil, nil, err
+			}
+			bz, err := json.Marshal(scheduleCallbackResponse{CallbackID: id})
+			if err != nil {
+				return nil, nil, sdkerrors.Wrap(err, "schedule callback response")
+			}
+			return em.Events(), [][]byte{bz}, nil
+		case cMsg.CancelCallback != nil:
+			if err := k.cancelCallback(ctx, contractAddr, cMsg.CancelCallback.CallbackID); err != nil {
+				return nil, nil, err
+			}
+			return em.Events(), nil, nil
+		default:
+			return nil, nil, types.ErrUnknownMsg
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
 	ibcclienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
@@ -27,8 +28,45 @@ type (
 	StargateEncoder     func(sender sdk.AccAddress, msg *wasmvmtypes.StargateMsg) ([]sdk.Msg, error)
 	WasmEncoder         func(sender sdk.AccAddress, msg *wasmvmtypes.WasmMsg) ([]sdk.Msg, error)
 	IBCEncoder          func(ctx sdk.Context, sender sdk.AccAddress, contractIBCPortID string, msg *wasmvmtypes.IBCMsg) ([]sdk.Msg, error)
+	IBCFeeEncoder       func(sender sdk.AccAddress, contractIBCPortID string, msg *IBCFeeMsg) ([]sdk.Msg, error)
 )
 
+// IBCFeeMsg is the custom message a contract sends to pay ICS-29 relayer fees for its packets. It is wrapped in an
+// `ibc_fee` object:
+//
+//	{"ibc_fee": {"pay_packet_fee": {...}}}
+//
+// The `ibc_fee` key is only reserved when the chain enables the encoder with Option `WithIBCFeeMessages`. Otherwise
+// the message is passed to the custom encoder of the chain.
+type IBCFeeMsg struct {
+	// PayPacketFee escrows the fee for the next packet sent on the channel
+	PayPacketFee *PayPacketFeeMsg `json:"pay_packet_fee,omitempty"`
+	// PayPacketFeeAsync escrows the fee for a packet that was sent already
+	PayPacketFeeAsync *PayPacketFeeAsyncMsg `json:"pay_packet_fee_async,omitempty"`
+}
+
+type PayPacketFeeMsg struct {
+	// PortID is the source port of the packet. The IBC port of the contract is used when empty
+	PortID    string `json:"port_id,omitempty"`
+	ChannelID string `json:"channel_id"`
+	Fee       IBCFee `json:"fee"`
+}
+
+type PayPacketFeeAsyncMsg struct {
+	// PortID is the source port of the packet. The IBC port of the contract is used when empty
+	PortID    string `json:"port_id,omitempty"`
+	ChannelID string `json:"channel_id"`
+	Sequence  uint64 `json:"sequence"`
+	Fee       IBCFee `json:"fee"`
+}
+
+// IBCFee are the ICS-29 fees paid to the relayers
+type IBCFee struct {
+	RecvFee    wasmvmtypes.Coins `json:"recv_fee"`
+	AckFee     wasmvmtypes.Coins `json:"ack_fee"`
+	TimeoutFee wasmvmtypes.Coins `json:"timeout_fee"`
+}
+
 type MessageEncoders struct {
 	Bank         func(sender sdk.AccAddress, msg *wasmvmtypes.BankMsg) ([]sdk.Msg, error)
 	Custom       func(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error)
@@ -38,6 +76,7 @@ type MessageEncoders struct {
 	Stargate     func(sender sdk.AccAddress, msg *wasmvmtypes.StargateMsg) ([]sdk.Msg, error)
 	Wasm         func(sender sdk.AccAddress, msg *wasmvmtypes.WasmMsg) ([]sdk.Msg, error)
 	Gov          func(sender sdk.AccAddress, msg *wasmvmtypes.GovMsg) ([]sdk.Msg, error)
+	IBCFee       func(sender sdk.AccAddress, contractIBCPortID string, msg *IBCFeeMsg) ([]sdk.Msg, error)
 }
 
 func DefaultEncoders(unpacker codectypes.AnyUnpacker, portSource types.ICS20TransferPortSource) MessageEncoders {
@@ -81,6 +120,9 @@ func (e MessageEncoders) Merge(o *MessageEncoders) MessageEncoders {
 	if o.Gov != nil {
 		e.Gov = o.Gov
 	}
+	if o.IBCFee != nil {
+		e.IBCFee = o.IBCFee
+	}
 	return e
 }
 
@@ -89,6 +131,11 @@ func (e MessageEncoders) Encode(ctx sdk.Context, contractAddr sdk.AccAddress, co
 	case msg.Bank != nil:
 		return e.Bank(contractAddr, msg.Bank)
 	case msg.Custom != nil:
+		if e.IBCFee != nil {
+			if feeMsg := parseIBCFeeMsg(msg.Custom); feeMsg != nil {
+				return e.IBCFee(contractAddr, contractIBCPortID, feeMsg)
+			}
+		}
 		return e.Custom(contractAddr, msg.Custom)
 	case msg.Distribution != nil:
 		return e.Distribution(contractAddr, msg.Distribution)
@@ -281,6 +328,23 @@ func EncodeWasmMsg(sender sdk.AccAddress, msg *wasmvmtypes.WasmMsg) ([]sdk.Msg,
 	}
 }
 
+// OperationTypeOf returns the circuit breaker operation type for a message dispatched by a contract.
+// Returns false when the message is not guarded by the circuit breaker.
+func OperationTypeOf(msg wasmvmtypes.CosmosMsg) (types.OperationType, bool) {
+	switch {
+	case msg.Wasm != nil && msg.Wasm.Execute != nil:
+		return types.OperationTypeExecute, true
+	case msg.Wasm != nil && (msg.Wasm.Instantiate != nil || msg.Wasm.Instantiate2 != nil):
+		return types.OperationTypeInstantiate, true
+	case msg.Wasm != nil && msg.Wasm.Migrate != nil:
+		return types.OperationTypeMigrate, true
+	case msg.IBC != nil && (msg.IBC.SendPacket != nil || msg.IBC.Transfer != nil):
+		return types.OperationTypeIBCSend, true
+	default:
+		return types.OperationTypeUnspecified, false
+	}
+}
+
 func EncodeIBCMsg(portSource types.ICS20TransferPortSource) func(ctx sdk.Context, sender sdk.AccAddress, contractIBCPortID string, msg *wasmvmtypes.IBCMsg) ([]sdk.Msg, error) {
 	return func(ctx sdk.Context, sender sdk.AccAddress, contractIBCPortID string, msg *wasmvmtypes.IBCMsg) ([]sdk.Msg, error) {
 		switch {
@@ -311,6 +375,60 @@ func EncodeIBCMsg(portSource types.ICS20TransferPortSource) func(ctx sdk.Context
 	}
 }
 
+// parseIBCFeeMsg returns the ICS-29 fee message of a custom message or nil when it has no `ibc_fee` object
+func parseIBCFeeMsg(msg json.RawMessage) *IBCFeeMsg {
+	var wrapper struct {
+		IBCFee *IBCFeeMsg `json:"ibc_fee"`
+	}
+	if err := json.Unmarshal(msg, &wrapper); err != nil {
+		return nil
+	}
+	return wrapper.IBCFee
+}
+
+// EncodeIBCFeeMsg encodes the ICS-29 fee messages with the contract as payer
+func EncodeIBCFeeMsg(sender sdk.AccAddress, contractIBCPortID string, msg *IBCFeeMsg) ([]sdk.Msg, error) {
+	portOrDefault := func(portID string) string {
+		if portID == "" {
+			return contractIBCPortID
+		}
+		return portID
+	}
+	switch {
+	case msg.PayPacketFee != nil:
+		fee, err := convertIBCFee(msg.PayPacketFee.Fee)
+		if err != nil {
+			return nil, err
+		}
+		return []sdk.Msg{ibcfeetypes.NewMsgPayPacketFee(fee, portOrDefault(msg.PayPacketFee.PortID), msg.PayPacketFee.ChannelID, sender.String(), nil)}, nil
+	case msg.PayPacketFeeAsync != nil:
+		fee, err := convertIBCFee(msg.PayPacketFeeAsync.Fee)
+		if err != nil {
+			return nil, err
+		}
+		packetID := channeltypes.NewPacketId(portOrDefault(msg.PayPacketFeeAsync.PortID), msg.PayPacketFeeAsync.ChannelID, msg.PayPacketFeeAsync.Sequence)
+		return []sdk.Msg{ibcfeetypes.NewMsgPayPacketFeeAsync(packetID, ibcfeetypes.NewPacketFee(fee, sender.String(), nil))}, nil
+	default:
+		return nil, sdkerrors.Wrap(types.ErrUnknownMsg, "unknown variant of IBC fee")
+	}
+}
+
+func convertIBCFee(fee IBCFee) (ibcfeetypes.Fee, error) {
+	recvFee, err := ConvertWasmCoinsToSdkCoins(fee.RecvFee)
+	if err != nil {
+		return ibcfeetypes.Fee{}, sdkerrors.Wrap(err, "recv fee")
+	}
+	ackFee, err := ConvertWasmCoinsToSdkCoins(fee.AckFee)
+	if err != nil {
+		return ibcfeetypes.Fee{}, sdkerrors.Wrap(err, "ack fee")
+	}
+	timeoutFee, err := ConvertWasmCoinsToSdkCoins(fee.TimeoutFee)
+	if err != nil {
+		return ibcfeetypes.Fee{}, sdkerrors.Wrap(err, "timeout fee")
+	}
+	return ibcfeetypes.NewFee(recvFee, ackFee, timeoutFee), nil
+}
+
 func EncodeGovMsg(sender sdk.AccAddress, msg *wasmvmtypes.GovMsg) ([]sdk.Msg, error) {
 	switch {
 	case msg.Vote != nil:
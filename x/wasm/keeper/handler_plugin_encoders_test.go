@@ -5,6 +5,7 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
@@ -559,6 +560,89 @@ func TestEncoding(t *testing.T) {
 	}
 }
 
+func TestEncodeIBCFeeMsg(t *testing.T) {
+	addr1 := RandomAccountAddress(t)
+	cases := map[string]struct {
+		sender             sdk.AccAddress
+		srcMsg             wasmvmtypes.CosmosMsg
+		srcContractIBCPort string
+		disabled           bool
+		// set if valid
+		output []sdk.Msg
+		// set if expect mapping fails
+		expError bool
+	}{
+		"IBC fee pay packet fee": {
+			sender:             addr1,
+			srcContractIBCPort: "myIBCPort",
+			srcMsg: wasmvmtypes.CosmosMsg{
+				Custom: []byte(`{"ibc_fee":{"pay_packet_fee":{"channel_id":"channel-1","fee":{"recv_fee":[{"denom":"ALX","amount":"1"}],"ack_fee":[{"denom":"ALX","amount":"2"}],"timeout_fee":[]}}}}`),
+			},
+			output: []sdk.Msg{
+				ibcfeetypes.NewMsgPayPacketFee(
+					ibcfeetypes.NewFee(sdk.NewCoins(sdk.NewInt64Coin("ALX", 1)), sdk.NewCoins(sdk.NewInt64Coin("ALX", 2)), nil),
+					"myIBCPort", "channel-1", addr1.String(), nil,
+				),
+			},
+		},
+		"IBC fee pay packet fee async": {
+			sender:             addr1,
+			srcContractIBCPort: "myIBCPort",
+			srcMsg: wasmvmtypes.CosmosMsg{
+				Custom: []byte(`{"ibc_fee":{"pay_packet_fee_async":{"port_id":"transfer","channel_id":"channel-1","sequence":7,"fee":{"recv_fee":[],"ack_fee":[],"timeout_fee":[{"denom":"ALX","amount":"3"}]}}}}`),
+			},
+			output: []sdk.Msg{
+				ibcfeetypes.NewMsgPayPacketFeeAsync(
+					channeltypes.NewPacketId("transfer", "channel-1", 7),
+					ibcfeetypes.NewPacketFee(ibcfeetypes.NewFee(nil, nil, sdk.NewCoins(sdk.NewInt64Coin("ALX", 3))), addr1.String(), nil),
+				),
+			},
+		},
+		"IBC fee invalid coins": {
+			sender: addr1,
+			srcMsg: wasmvmtypes.CosmosMsg{
+				Custom: []byte(`{"ibc_fee":{"pay_packet_fee":{"channel_id":"channel-1","fee":{"recv_fee":[{"denom":"ALX","amount":"x"}],"ack_fee":[],"timeout_fee":[]}}}}`),
+			},
+			expError: true,
+		},
+		"IBC fee without variant": {
+			sender: addr1,
+			srcMsg: wasmvmtypes.CosmosMsg{
+				Custom: []byte(`{"ibc_fee":{}}`),
+			},
+			expError: true,
+		},
+		"not enabled": {
+			sender:   addr1,
+			disabled: true,
+			srcMsg: wasmvmtypes.CosmosMsg{
+				Custom: []byte(`{"ibc_fee":{"pay_packet_fee":{"channel_id":"channel-1","fee":{"recv_fee":[{"denom":"ALX","amount":"1"}],"ack_fee":[],"timeout_fee":[]}}}}`),
+			},
+			expError: true,
+		},
+	}
+	encodingConfig := MakeEncodingConfig(t)
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var ctx sdk.Context
+			encoder := DefaultEncoders(encodingConfig.Marshaler, nil)
+			if !tc.disabled {
+				encoder = encoder.Merge(&MessageEncoders{IBCFee: EncodeIBCFeeMsg})
+			}
+			res, err := encoder.Encode(ctx, tc.sender, tc.srcContractIBCPort, tc.srcMsg)
+			if tc.expError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.output, res)
+			for _, v := range res {
+				assert.NoError(t, v.ValidateBasic())
+			}
+		})
+	}
+}
+
 func TestEncodeGovMsg(t *testing.T) {
 	myAddr := RandomAccountAddress(t)
 
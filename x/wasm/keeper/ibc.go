@@ -6,6 +6,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
 	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 
 	"github.com/CosmWasm/wasmd/x/wasm/types"
@@ -13,8 +16,11 @@ import (
 
 // bindIbcPort will reserve the port.
 // returns a string name of the port or error if we cannot bind it.
-// this will fail if call twice.
+// this will fail if call twice or when the port was bound for a deleted contract with the same address.
 func (k Keeper) bindIbcPort(ctx sdk.Context, portID string) error {
+	if k.portKeeper.IsBound(ctx, portID) {
+		return sdkerrors.Wrap(porttypes.ErrPortExists, portID)
+	}
 	cap := k.portKeeper.BindPort(ctx, portID)
 	return k.ClaimCapability(ctx, cap, host.PortPath(portID))
 }
@@ -31,6 +37,59 @@ func (k Keeper) ensureIbcPort(ctx sdk.Context, contractAddr sdk.AccAddress) (str
 	return portID, k.bindIbcPort(ctx, portID)
 }
 
+// releaseContractIbcPorts releases the capabilities held for the IBC port and the interchain account controller port
+// of the contract, and for their channels. Fails when any of these channels is not closed.
+// The port ids stay bound in the IBC port keeper as ibc-go can not unbind a port.
+func (k Keeper) releaseContractIbcPorts(ctx sdk.Context, contractAddr sdk.AccAddress, ibcPortID string) error {
+	var icaPortID string
+	if k.icaControllerKeeper != nil {
+		icaPortID = icatypes.PortPrefix + contractAddr.String()
+	}
+	if ibcPortID == "" && icaPortID == "" {
+		return nil
+	}
+	var (
+		channels []channeltypes.IdentifiedChannel
+		err      error
+	)
+	k.channelKeeper.IterateChannels(ctx, func(ch channeltypes.IdentifiedChannel) bool {
+		if ch.PortId == "" || (ch.PortId != ibcPortID && ch.PortId != icaPortID) {
+			return false
+		}
+		if ch.State != channeltypes.CLOSED {
+			err = sdkerrors.Wrapf(types.ErrInvalid, "channel %s of port %s is not closed", ch.ChannelId, ch.PortId)
+			return true
+		}
+		channels = append(channels, ch)
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	for _, ch := range channels {
+		capKeeper := k.capabilityKeeper
+		if ch.PortId == icaPortID {
+			capKeeper = k.icaCapabilityKeeper
+		}
+		if err := releaseCapability(ctx, capKeeper, host.ChannelCapabilityPath(ch.PortId, ch.ChannelId)); err != nil {
+			return err
+		}
+	}
+	if ibcPortID == "" {
+		return nil
+	}
+	return releaseCapability(ctx, k.capabilityKeeper, host.PortPath(ibcPortID))
+}
+
+// releaseCapability releases the capability with the given name, if any.
+func releaseCapability(ctx sdk.Context, capKeeper types.CapabilityKeeper, name string) error {
+	cap, ok := capKeeper.GetCapability(ctx, name)
+	if !ok {
+		return nil
+	}
+	return capKeeper.ReleaseCapability(ctx, cap)
+}
+
 const portIDPrefix = "wasm."
 
 func PortIDForContract(addr sdk.AccAddress) string {
@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// writeAcknowledgementMsg is the custom message a contract sends to acknowledge a received packet that it did not
+// acknowledge in `ibc_packet_receive`
+type writeAcknowledgementMsg struct {
+	WriteAcknowledgement *struct {
+		// ChannelID is the channel of the contract port that the packet was received on
+		ChannelID string `json:"channel_id"`
+		// PacketSequence is the sequence of the received packet
+		PacketSequence uint64 `json:"packet_sequence"`
+		// Ack is the acknowledgement data
+		Ack wasmvmtypes.IBCAcknowledgement `json:"ack"`
+	} `json:"write_acknowledgement,omitempty"`
+}
+
+var _ ibcexported.Acknowledgement = contractAck{}
+
+// contractAck is the acknowledgement data written by a contract. As for the synchronous acknowledgement, the
+// contract fully owns the data and state is always committed.
+type contractAck []byte
+
+func (a contractAck) Success() bool {
+	return true
+}
+
+func (a contractAck) Acknowledgement() []byte {
+	return a
+}
+
+// asyncAckWriter is the subset of the keeper to write the acknowledgements of received packets
+type asyncAckWriter interface {
+	writeAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64, ack []byte) error
+}
+
+// NewIBCAsyncAckMessageHandler handles the custom message to write the acknowledgement of a received packet. Any
+// other message, including custom messages of a different shape, is passed on.
+func NewIBCAsyncAckMessageHandler(k asyncAckWriter) MessageHandlerFunc {
+	return func(ctx sdk.Context, _ sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, err error) {
+		if msg.Custom == nil {
+			return nil, nil, types.ErrUnknownMsg
+		}
+		var cMsg writeAcknowledgementMsg
+		if err := json.Unmarshal(msg.Custom, &cMsg); err != nil || cMsg.WriteAcknowledgement == nil {
+			return nil, nil, types.ErrUnknownMsg
+		}
+		if contractIBCPortID == "" {
+			return nil, nil, sdkerrors.Wrapf(types.ErrUnsupportedForContract, "ibc not supported")
+		}
+		m := cMsg.WriteAcknowledgement
+		if m.ChannelID == "" {
+			return nil, nil, sdkerrors.Wrap(types.ErrEmpty, "ibc channel")
+		}
+		if len(m.Ack.Data) == 0 {
+			return nil, nil, sdkerrors.Wrap(types.ErrEmpty, "ack")
+		}
+		em := sdk.NewEventManager()
+		if err := k.writeAcknowledgement(ctx.WithEventManager(em), contractIBCPortID, m.ChannelID, m.PacketSequence, m.Ack.Data); err != nil {
+			return nil, nil, err
+		}
+		return em.Events(), nil, nil
+	}
+}
+
+// StoreAsyncAckPacket keeps the received packet until the contract writes the acknowledgement. Fails when
+// asynchronous acknowledgements are not enabled.
+func (k Keeper) StoreAsyncAckPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	if !k.ibcAsyncAcks {
+		return sdkerrors.Wrap(types.ErrInvalid, "empty acknowledgement: async acknowledgements not enabled")
+	}
+	k.setAsyncAckPacket(ctx, packet)
+	return nil
+}
+
+func (k Keeper) setAsyncAckPacket(ctx sdk.Context, packet channeltypes.Packet) {
+	key := types.GetAsyncAckPacketKey(packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&packet))
+}
+
+// GetAsyncAckPacket returns the received packet that was not acknowledged by the contract, yet
+func (k Keeper) GetAsyncAckPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (channeltypes.Packet, bool) {
+	var packet channeltypes.Packet
+	bz := ctx.KVStore(k.storeKey).Get(types.GetAsyncAckPacketKey(portID, channelID, sequence))
+	if bz == nil {
+		return packet, false
+	}
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+// IterateAsyncAckPackets iterates over all received packets that were not acknowledged by the contracts, yet
+func (k Keeper) IterateAsyncAckPackets(ctx sdk.Context, cb func(channeltypes.Packet) bool) {
+	k.iterateAsyncAckPackets(ctx, types.AsyncAckPacketPrefix, cb)
+}
+
+func (k Keeper) iterateAsyncAckPackets(ctx sdk.Context, keyPrefix []byte, cb func(channeltypes.Packet) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var packet channeltypes.Packet
+		k.cdc.MustUnmarshal(iter.Value(), &packet)
+		if cb(packet) {
+			return
+		}
+	}
+}
+
+// importAsyncAckPacket stores a received packet that was not acknowledged by the contract from genesis
+func (k Keeper) importAsyncAckPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	contractAddress, err := ContractFromPortID(packet.DestinationPort)
+	if err != nil {
+		return sdkerrors.Wrap(err, "destination port")
+	}
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if contractInfo.IBCPortID != packet.DestinationPort {
+		return sdkerrors.Wrap(types.ErrInvalid, "destination port not owned by contract")
+	}
+	if _, exists := k.GetAsyncAckPacket(ctx, packet.DestinationPort, packet.DestinationChannel, packet.Sequence); exists {
+		return sdkerrors.Wrap(types.ErrDuplicate, "async ack packet")
+	}
+	k.setAsyncAckPacket(ctx, packet)
+	return nil
+}
+
+// abortAsyncAckPackets writes an error acknowledgement for all received packets on the port that were not
+// acknowledged, yet. A packet that can not be acknowledged anymore, for example because the channel was closed,
+// is dropped.
+func (k Keeper) abortAsyncAckPackets(ctx sdk.Context, portID string) {
+	var pending []channeltypes.Packet
+	k.iterateAsyncAckPackets(ctx, types.GetAsyncAckPacketsPrefix(portID), func(packet channeltypes.Packet) bool {
+		pending = append(pending, packet)
+		return false
+	})
+	ack := channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(types.ErrNotFound, "contract deleted"))
+	for _, p := range pending {
+		em := sdk.NewEventManager()
+		cacheCtx, commit := ctx.CacheContext()
+		if err := k.writeAsyncAcknowledgement(cacheCtx.WithEventManager(em), p.DestinationPort, p.DestinationChannel, p.Sequence, ack); err != nil {
+			k.Logger(ctx).Info("drop async ack packet", "port", p.DestinationPort, "channel", p.DestinationChannel, "sequence", p.Sequence, "error", err.Error())
+			ctx.KVStore(k.storeKey).Delete(types.GetAsyncAckPacketKey(p.DestinationPort, p.DestinationChannel, p.Sequence))
+			continue
+		}
+		commit()
+		ctx.EventManager().EmitEvents(em.Events())
+	}
+}
+
+// writeAcknowledgement writes the acknowledgement data of the contract for the received packet
+func (k Keeper) writeAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64, ack []byte) error {
+	return k.writeAsyncAcknowledgement(ctx, portID, channelID, sequence, contractAck(ack))
+}
+
+// writeAsyncAcknowledgement writes the acknowledgement for the received packet with the channel capability of the
+// contract and removes the packet from the store
+func (k Keeper) writeAsyncAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64, ack ibcexported.Acknowledgement) error {
+	packet, ok := k.GetAsyncAckPacket(ctx, portID, channelID, sequence)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrNotFound, "pending packet %d on %s/%s", sequence, portID, channelID)
+	}
+	chanCap, ok := k.capabilityKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
+	if !ok {
+		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+	if err := k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack); err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Delete(types.GetAsyncAckPacketKey(portID, channelID, sequence))
+	return nil
+}
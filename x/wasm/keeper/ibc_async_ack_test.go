@@ -0,0 +1,304 @@
+package keeper
+
+import (
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestIBCAsyncAckMessageHandler(t *testing.T) {
+	myContractAddr := RandomAccountAddress(t)
+	specs := map[string]struct {
+		msg        wasmvmtypes.CosmosMsg
+		srcIBCPort string
+		writerErr  error
+		expErr     *sdkerrors.Error
+		expWritten *writtenAck
+	}{
+		"write ack": {
+			msg:        wasmvmtypes.CosmosMsg{Custom: []byte(`{"write_acknowledgement":{"channel_id":"channel-1","packet_sequence":7,"ack":{"data":"AQ=="}}}`)},
+			srcIBCPort: "myIBCPort",
+			expWritten: &writtenAck{portID: "myIBCPort", channelID: "channel-1", sequence: 7, ack: []byte{1}},
+		},
+		"contract without ibc port": {
+			msg:    wasmvmtypes.CosmosMsg{Custom: []byte(`{"write_acknowledgement":{"channel_id":"channel-1","packet_sequence":7,"ack":{"data":"AQ=="}}}`)},
+			expErr: types.ErrUnsupportedForContract,
+		},
+		"empty channel": {
+			msg:        wasmvmtypes.CosmosMsg{Custom: []byte(`{"write_acknowledgement":{"packet_sequence":7,"ack":{"data":"AQ=="}}}`)},
+			srcIBCPort: "myIBCPort",
+			expErr:     types.ErrEmpty,
+		},
+		"empty ack": {
+			msg:        wasmvmtypes.CosmosMsg{Custom: []byte(`{"write_acknowledgement":{"channel_id":"channel-1","packet_sequence":7,"ack":{"data":""}}}`)},
+			srcIBCPort: "myIBCPort",
+			expErr:     types.ErrEmpty,
+		},
+		"writer fails": {
+			msg:        wasmvmtypes.CosmosMsg{Custom: []byte(`{"write_acknowledgement":{"channel_id":"channel-1","packet_sequence":7,"ack":{"data":"AQ=="}}}`)},
+			srcIBCPort: "myIBCPort",
+			writerErr:  types.ErrNotFound,
+			expErr:     types.ErrNotFound,
+		},
+		"other custom msg": {
+			msg:        wasmvmtypes.CosmosMsg{Custom: []byte(`{"foo":{}}`)},
+			srcIBCPort: "myIBCPort",
+			expErr:     types.ErrUnknownMsg,
+		},
+		"non custom msg": {
+			msg:        wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{}},
+			srcIBCPort: "myIBCPort",
+			expErr:     types.ErrUnknownMsg,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			mock := &mockAsyncAckWriter{err: spec.writerErr}
+			ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
+			// when
+			_, _, gotErr := NewIBCAsyncAckMessageHandler(mock).DispatchMsg(ctx, myContractAddr, spec.srcIBCPort, spec.msg)
+			// then
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(gotErr), "expected %v but got %+v", spec.expErr, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expWritten, mock.written)
+		})
+	}
+}
+
+func TestWriteAcknowledgement(t *testing.T) {
+	var capturedAck ibcexported.Acknowledgement
+	var capturedPacket ibcexported.PacketI
+	ics4Wrapper := &wasmtesting.MockChannelKeeper{
+		WriteAcknowledgementFn: func(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
+			capturedPacket, capturedAck = packet, ack
+			return nil
+		},
+	}
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithICS4Wrapper(ics4Wrapper), WithIBCAsyncAcks())
+	k := keepers.WasmKeeper
+
+	packet := channeltypes.NewPacket([]byte("data"), 7, "otherPort", "channel-0", "myIBCPort", "channel-1", clienttypes.NewHeight(1, 100), 0)
+	_, err := keepers.ScopedWasmKeeper.NewCapability(parentCtx, host.ChannelCapabilityPath("myIBCPort", "channel-1"))
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		storePacket bool
+		portID      string
+		channelID   string
+		expErr      bool
+	}{
+		"pending packet": {
+			storePacket: true,
+			portID:      "myIBCPort",
+			channelID:   "channel-1",
+		},
+		"no pending packet": {
+			portID:    "myIBCPort",
+			channelID: "channel-1",
+			expErr:    true,
+		},
+		"channel not owned": {
+			storePacket: true,
+			portID:      "otherPort",
+			channelID:   "channel-0",
+			expErr:      true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			capturedAck, capturedPacket = nil, nil
+			ctx, _ := parentCtx.CacheContext()
+			if spec.storePacket {
+				p := packet
+				p.DestinationPort, p.DestinationChannel = spec.portID, spec.channelID
+				require.NoError(t, k.StoreAsyncAckPacket(ctx, p))
+			}
+			// when
+			gotErr := k.writeAcknowledgement(ctx, spec.portID, spec.channelID, 7, []byte{1})
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.Nil(t, capturedAck)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, contractAck([]byte{1}), capturedAck)
+			assert.Equal(t, packet, capturedPacket)
+			_, found := k.GetAsyncAckPacket(ctx, spec.portID, spec.channelID, 7)
+			assert.False(t, found)
+		})
+	}
+}
+
+func TestStoreAsyncAckPacket(t *testing.T) {
+	packet := channeltypes.NewPacket([]byte("data"), 7, "otherPort", "channel-0", "myIBCPort", "channel-1", clienttypes.NewHeight(1, 100), 0)
+	specs := map[string]struct {
+		opts   []Option
+		expErr bool
+	}{
+		"enabled": {
+			opts: []Option{WithIBCAsyncAcks()},
+		},
+		"not enabled": {
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, spec.opts...)
+			k := keepers.WasmKeeper
+			// when
+			gotErr := k.StoreAsyncAckPacket(ctx, packet)
+			// then
+			_, found := k.GetAsyncAckPacket(ctx, "myIBCPort", "channel-1", 7)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.False(t, found)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.True(t, found)
+		})
+	}
+}
+
+func TestImportAsyncAckPacket(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	contractInfo := k.GetContractInfo(ctx, example.Contract)
+	contractInfo.IBCPortID = PortIDForContract(example.Contract)
+	k.storeContractInfo(ctx, example.Contract, contractInfo)
+	otherContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	specs := map[string]struct {
+		src    channeltypes.Packet
+		expErr bool
+	}{
+		"contract port": {
+			src: types.AsyncAckPacketFixture(func(p *channeltypes.Packet) { p.DestinationPort = PortIDForContract(example.Contract) }),
+		},
+		"unknown contract": {
+			src:    types.AsyncAckPacketFixture(func(p *channeltypes.Packet) { p.DestinationPort = PortIDForContract(RandomAccountAddress(t)) }),
+			expErr: true,
+		},
+		"port not owned by contract": {
+			src:    types.AsyncAckPacketFixture(func(p *channeltypes.Packet) { p.DestinationPort = PortIDForContract(otherContract.Contract) }),
+			expErr: true,
+		},
+		"non contract port": {
+			src:    types.AsyncAckPacketFixture(func(p *channeltypes.Packet) { p.DestinationPort = "transfer" }),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			// when
+			gotErr := k.importAsyncAckPacket(ctx, spec.src)
+			// then
+			_, found := k.GetAsyncAckPacket(ctx, spec.src.DestinationPort, spec.src.DestinationChannel, spec.src.Sequence)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.False(t, found)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.True(t, found)
+			// and duplicates are rejected
+			require.Error(t, k.importAsyncAckPacket(ctx, spec.src))
+		})
+	}
+}
+
+func TestAbortAsyncAckPackets(t *testing.T) {
+	var capturedAcks []ibcexported.Acknowledgement
+	var writerErr error
+	ics4Wrapper := &wasmtesting.MockChannelKeeper{
+		WriteAcknowledgementFn: func(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
+			if writerErr != nil {
+				return writerErr
+			}
+			capturedAcks = append(capturedAcks, ack)
+			return nil
+		},
+	}
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithICS4Wrapper(ics4Wrapper))
+	k := keepers.WasmKeeper
+	_, err := keepers.ScopedWasmKeeper.NewCapability(parentCtx, host.ChannelCapabilityPath("myIBCPort", "channel-1"))
+	require.NoError(t, err)
+	packets := []channeltypes.Packet{
+		channeltypes.NewPacket([]byte("data"), 7, "otherPort", "channel-0", "myIBCPort", "channel-1", clienttypes.NewHeight(1, 100), 0),
+		channeltypes.NewPacket([]byte("data"), 8, "otherPort", "channel-0", "myIBCPort", "channel-1", clienttypes.NewHeight(1, 100), 0),
+	}
+	otherPortPacket := channeltypes.NewPacket([]byte("data"), 7, "otherPort", "channel-0", "otherIBCPort", "channel-1", clienttypes.NewHeight(1, 100), 0)
+
+	specs := map[string]struct {
+		writerErr error
+		expAcks   int
+	}{
+		"error acks written": {
+			expAcks: 2,
+		},
+		"packets dropped when ack can not be written": {
+			writerErr: channeltypes.ErrInvalidChannelState,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			capturedAcks, writerErr = nil, spec.writerErr
+			ctx, _ := parentCtx.CacheContext()
+			for _, p := range append(packets, otherPortPacket) {
+				k.setAsyncAckPacket(ctx, p)
+			}
+			// when
+			k.abortAsyncAckPackets(ctx, "myIBCPort")
+			// then
+			require.Len(t, capturedAcks, spec.expAcks)
+			for _, ack := range capturedAcks {
+				assert.False(t, ack.Success())
+			}
+			for _, p := range packets {
+				_, found := k.GetAsyncAckPacket(ctx, p.DestinationPort, p.DestinationChannel, p.Sequence)
+				assert.False(t, found)
+			}
+			// and packets of other ports are kept
+			_, found := k.GetAsyncAckPacket(ctx, otherPortPacket.DestinationPort, otherPortPacket.DestinationChannel, otherPortPacket.Sequence)
+			assert.True(t, found)
+		})
+	}
+}
+
+type writtenAck struct {
+	portID, channelID string
+	sequence          uint64
+	ack               []byte
+}
+
+type mockAsyncAckWriter struct {
+	err     error
+	written *writtenAck
+}
+
+func (m *mockAsyncAckWriter) writeAcknowledgement(_ sdk.Context, portID, channelID string, sequence uint64, ack []byte) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.written = &writtenAck{portID: portID, channelID: channelID, sequence: sequence, ack: ack}
+	return nil
+}
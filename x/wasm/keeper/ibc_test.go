@@ -5,9 +5,16 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v4/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
 
 func TestDontBindPortNonIBCContract(t *testing.T) {
@@ -80,3 +87,106 @@ func TestContractFromPortID(t *testing.T) {
 		})
 	}
 }
+
+func TestReleasePortOnContractDelete(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := InstantiateIBCReflectContract(t, ctx, keepers)
+	portID := keepers.WasmKeeper.GetContractInfo(ctx, example.Contract).IBCPortID
+	require.NotEmpty(t, portID)
+
+	// when
+	err := keepers.ContractKeeper.DeleteContract(ctx, example.Contract, example.Admin, RandomAccountAddress(t))
+	// then
+	require.NoError(t, err)
+	_, found := keepers.ScopedWasmKeeper.GetCapability(ctx, host.PortPath(portID))
+	assert.False(t, found)
+	// and the port can not be bound again for a new contract with the same address
+	_, err = keepers.WasmKeeper.ensureIbcPort(ctx, example.Contract)
+	assert.ErrorIs(t, err, porttypes.ErrPortExists)
+}
+
+func TestDeleteContractWithChannels(t *testing.T) {
+	specs := map[string]struct {
+		ibcPortState   channeltypes.State
+		icaPortState   channeltypes.State
+		otherPortState channeltypes.State
+		expErr         bool
+	}{
+		"all closed": {
+			ibcPortState:   channeltypes.CLOSED,
+			icaPortState:   channeltypes.CLOSED,
+			otherPortState: channeltypes.OPEN,
+		},
+		"open on ibc port": {
+			ibcPortState: channeltypes.OPEN,
+			icaPortState: channeltypes.CLOSED,
+			expErr:       true,
+		},
+		"handshake on ibc port": {
+			ibcPortState: channeltypes.INIT,
+			icaPortState: channeltypes.CLOSED,
+			expErr:       true,
+		},
+		"open on ica controller port": {
+			ibcPortState: channeltypes.CLOSED,
+			icaPortState: channeltypes.OPEN,
+			expErr:       true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			k := keepers.WasmKeeper
+			k.icaControllerKeeper = struct{ types.ICAControllerKeeper }{}
+			k.icaCapabilityKeeper = keepers.ScopedICAKeeper
+			example := InstantiateHackatomExampleContract(t, ctx, keepers)
+			ibcPortID, err := k.ensureIbcPort(ctx, example.Contract)
+			require.NoError(t, err)
+			contractInfo := k.GetContractInfo(ctx, example.Contract)
+			contractInfo.IBCPortID = ibcPortID
+			k.storeContractInfo(ctx, example.Contract, contractInfo)
+			icaPortID := icatypes.PortPrefix + example.Contract.String()
+
+			// given a channel on each port
+			var capPaths []string
+			for i, c := range []struct {
+				portID    string
+				state     channeltypes.State
+				capKeeper capabilitykeeper.ScopedKeeper
+			}{
+				{ibcPortID, spec.ibcPortState, keepers.ScopedWasmKeeper},
+				{icaPortID, spec.icaPortState, keepers.ScopedICAKeeper},
+				{"other", spec.otherPortState, keepers.ScopedWasmKeeper},
+			} {
+				if c.state == channeltypes.UNINITIALIZED {
+					continue
+				}
+				channelID := channeltypes.FormatChannelIdentifier(uint64(i))
+				keepers.IBCKeeper.ChannelKeeper.SetChannel(ctx, c.portID, channelID, channeltypes.Channel{State: c.state, Ordering: channeltypes.UNORDERED})
+				_, err := c.capKeeper.NewCapability(ctx, host.ChannelCapabilityPath(c.portID, channelID))
+				require.NoError(t, err)
+				capPaths = append(capPaths, host.ChannelCapabilityPath(c.portID, channelID))
+			}
+
+			// when
+			err = keepers.ContractKeeper.DeleteContract(ctx, example.Contract, example.CreatorAddr, RandomAccountAddress(t))
+
+			// then
+			if spec.expErr {
+				require.ErrorIs(t, err, types.ErrInvalid)
+				assert.True(t, k.HasContractInfo(ctx, example.Contract))
+				return
+			}
+			require.NoError(t, err)
+			assert.False(t, k.HasContractInfo(ctx, example.Contract))
+			_, ok := keepers.ScopedWasmKeeper.GetCapability(ctx, host.PortPath(ibcPortID))
+			assert.False(t, ok, "port capability")
+			_, ok = keepers.ScopedWasmKeeper.GetCapability(ctx, capPaths[0])
+			assert.False(t, ok, "ibc channel capability")
+			_, ok = keepers.ScopedICAKeeper.GetCapability(ctx, capPaths[1])
+			assert.False(t, ok, "ica channel capability")
+			_, ok = keepers.ScopedWasmKeeper.GetCapability(ctx, capPaths[2])
+			assert.True(t, ok, "other port channel capability")
+		})
+	}
+}
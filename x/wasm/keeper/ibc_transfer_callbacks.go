@@ -0,0 +1,206 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// ibcLifecycleCompleteMsg is the sudo message sent to a contract when an ICS-20 transfer that it sent was
+// acknowledged or timed out
+type ibcLifecycleCompleteMsg struct {
+	IBCLifecycleComplete ibcLifecycleComplete `json:"ibc_lifecycle_complete"`
+}
+
+type ibcLifecycleComplete struct {
+	IBCAck     *ibcLifecycleAck     `json:"ibc_ack,omitempty"`
+	IBCTimeout *ibcLifecycleTimeout `json:"ibc_timeout,omitempty"`
+}
+
+type ibcLifecycleAck struct {
+	Channel  string `json:"channel"`
+	Sequence uint64 `json:"sequence"`
+	// Ack is the raw acknowledgement of the packet
+	Ack []byte `json:"ack"`
+	// Success is true when the receiving chain acknowledged the transfer with a result
+	Success bool `json:"success"`
+}
+
+type ibcLifecycleTimeout struct {
+	Channel  string `json:"channel"`
+	Sequence uint64 `json:"sequence"`
+}
+
+// GetIBCTransferCallback returns the contract that sent the ICS-20 packet or nil when none was registered
+func (k Keeper) GetIBCTransferCallback(ctx sdk.Context, channelID string, sequence uint64) sdk.AccAddress {
+	return ctx.KVStore(k.storeKey).Get(types.GetIBCTransferCallbackKey(channelID, sequence))
+}
+
+// setIBCTransferCallback registers the contract that sent the ICS-20 packet and indexes it by contract
+func (k Keeper) setIBCTransferCallback(ctx sdk.Context, channelID string, sequence uint64, contractAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetIBCTransferCallbackKey(channelID, sequence), contractAddr)
+	store.Set(types.GetContractIBCTransferCallbackKey(contractAddr, channelID, sequence), []byte{})
+}
+
+// removeIBCTransferCallback deletes the registered contract for the ICS-20 packet and the contract index
+func (k Keeper) removeIBCTransferCallback(ctx sdk.Context, channelID string, sequence uint64, contractAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetIBCTransferCallbackKey(channelID, sequence))
+	store.Delete(types.GetContractIBCTransferCallbackKey(contractAddr, channelID, sequence))
+}
+
+// IterateIBCTransferCallbacks iterates over all contracts registered for pending ICS-20 packets in order of channel
+// and sequence
+func (k Keeper) IterateIBCTransferCallbacks(ctx sdk.Context, cb func(types.IBCTransferCallback) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.IBCTransferCallbackPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		channelID, sequence, err := types.ParseIBCTransferCallbackKey(iter.Key())
+		if err != nil { // should never happen
+			panic(err.Error())
+		}
+		if cb(types.IBCTransferCallback{ChannelID: channelID, Sequence: sequence, Contract: sdk.AccAddress(iter.Value()).String()}) {
+			return
+		}
+	}
+}
+
+// removeContractIBCTransferCallbacks deletes all registrations of the contract for pending ICS-20 packets so that it
+// is not called back anymore
+func (k Keeper) removeContractIBCTransferCallbacks(ctx sdk.Context, contractAddr sdk.AccAddress) {
+	var pending []types.IBCTransferCallback
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractIBCTransferCallbacksPrefix(contractAddr)).Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		channelID, sequence, err := types.ParseIBCTransferCallbackKey(iter.Key())
+		if err != nil { // should never happen
+			panic(err.Error())
+		}
+		pending = append(pending, types.IBCTransferCallback{ChannelID: channelID, Sequence: sequence})
+	}
+	iter.Close()
+	for _, p := range pending {
+		k.removeIBCTransferCallback(ctx, p.ChannelID, p.Sequence, contractAddr)
+	}
+}
+
+// importIBCTransferCallback registers the contract for a pending ICS-20 packet from genesis
+func (k Keeper) importIBCTransferCallback(ctx sdk.Context, callback types.IBCTransferCallback) error {
+	contractAddress, err := sdk.AccAddressFromBech32(callback.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if !k.HasContractInfo(ctx, contractAddress) {
+		return sdkerrors.Wrap(types.ErrNotFound, "contract")
+	}
+	if k.GetIBCTransferCallback(ctx, callback.ChannelID, callback.Sequence) != nil {
+		return sdkerrors.Wrap(types.ErrDuplicate, "ibc transfer callback")
+	}
+	k.setIBCTransferCallback(ctx, callback.ChannelID, callback.Sequence, contractAddress)
+	return nil
+}
+
+// OnIBCTransferAcknowledgement calls the sudo entry point of the contract that sent the ICS-20 packet with the
+// acknowledgement. It is a noop when no contract was registered for the packet.
+func (k Keeper) OnIBCTransferAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) {
+	var ack channeltypes.Acknowledgement
+	success := ibctransfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack) == nil && ack.Success()
+	k.ibcTransferLifecycleComplete(ctx, packet, ibcLifecycleComplete{IBCAck: &ibcLifecycleAck{
+		Channel:  packet.SourceChannel,
+		Sequence: packet.Sequence,
+		Ack:      acknowledgement,
+		Success:  success,
+	}})
+}
+
+// OnIBCTransferTimeout calls the sudo entry point of the contract that sent the ICS-20 packet with the timeout.
+// It is a noop when no contract was registered for the packet.
+func (k Keeper) OnIBCTransferTimeout(ctx sdk.Context, packet channeltypes.Packet) {
+	k.ibcTransferLifecycleComplete(ctx, packet, ibcLifecycleComplete{IBCTimeout: &ibcLifecycleTimeout{
+		Channel:  packet.SourceChannel,
+		Sequence: packet.Sequence,
+	}})
+}
+
+// ibcTransferLifecycleComplete removes the registered contract for the packet and calls it with the gas limit for
+// ibc transfer callbacks. Failures of the contract are ignored so that they do not block the packet lifecycle.
+func (k Keeper) ibcTransferLifecycleComplete(ctx sdk.Context, packet channeltypes.Packet, msg ibcLifecycleComplete) {
+	contractAddr := k.GetIBCTransferCallback(ctx, packet.SourceChannel, packet.Sequence)
+	if contractAddr == nil {
+		return
+	}
+	k.removeIBCTransferCallback(ctx, packet.SourceChannel, packet.Sequence, contractAddr)
+
+	bz, err := json.Marshal(ibcLifecycleCompleteMsg{IBCLifecycleComplete: msg})
+	if err != nil {
+		panic(err)
+	}
+	gasUsed, err := k.sudoWithGasLimit(ctx, contractAddr, bz, k.ibcTransferCallbackGasLimit)
+	ctx.GasMeter().ConsumeGas(gasUsed, "ibc transfer callback")
+	if err != nil {
+		k.Logger(ctx).Debug("ibc transfer callback", "contract", contractAddr.String(), "error", err.Error())
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeIBCLifecycleComplete,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyChannelID, packet.SourceChannel),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+	))
+}
+
+// ibcTransferCallbackRegistry is the subset of the keeper to register the contracts that sent ICS-20 packets
+type ibcTransferCallbackRegistry interface {
+	setIBCTransferCallback(ctx sdk.Context, channelID string, sequence uint64, contractAddr sdk.AccAddress)
+}
+
+// ibcTransferWithCallbackMsg is the custom message for an ICS-20 transfer that the sending contract is called back for
+type ibcTransferWithCallbackMsg struct {
+	IBCTransferWithCallback *wasmvmtypes.TransferMsg `json:"ibc_transfer_with_callback,omitempty"`
+}
+
+// IBCTransferCallbackMessageHandler handles the `ibc_transfer_with_callback` custom message. The transfer is
+// dispatched as `IBCMsg::Transfer` by the nested handler and the sending contract is registered for the packet so
+// that it can be called back on acknowledgement or timeout. Any other message is passed to the nested handler.
+type IBCTransferCallbackMessageHandler struct {
+	nested   Messenger
+	registry ibcTransferCallbackRegistry
+}
+
+func NewIBCTransferCallbackMessageHandler(nested Messenger, registry ibcTransferCallbackRegistry) IBCTransferCallbackMessageHandler {
+	return IBCTransferCallbackMessageHandler{nested: nested, registry: registry}
+}
+
+// DispatchMsg delegates to the nested handler and registers the contract for transfers with callback.
+func (h IBCTransferCallbackMessageHandler) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, err error) {
+	if msg.Custom == nil {
+		return h.nested.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	}
+	var cMsg ibcTransferWithCallbackMsg
+	if err := json.Unmarshal(msg.Custom, &cMsg); err != nil || cMsg.IBCTransferWithCallback == nil {
+		return h.nested.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+	}
+	transfer := cMsg.IBCTransferWithCallback
+	events, data, err = h.nested.DispatchMsg(ctx, contractAddr, contractIBCPortID, wasmvmtypes.CosmosMsg{IBC: &wasmvmtypes.IBCMsg{Transfer: transfer}})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(data) != 1 {
+		return nil, nil, sdkerrors.Wrap(types.ErrInvalid, "transfer response")
+	}
+	var res ibctransfertypes.MsgTransferResponse
+	if err := res.Unmarshal(data[0]); err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "transfer response")
+	}
+	h.registry.setIBCTransferCallback(ctx, transfer.ChannelID, res.Sequence, contractAddr)
+	return events, data, nil
+}
@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	wasmvm "github.com/CosmWasm/wasmvm"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestIBCTransferCallbackMessageHandler(t *testing.T) {
+	transferResponse, err := (&ibctransfertypes.MsgTransferResponse{Sequence: 7}).Marshal()
+	require.NoError(t, err)
+	transferMsg := wasmvmtypes.CosmosMsg{IBC: &wasmvmtypes.IBCMsg{Transfer: &wasmvmtypes.TransferMsg{ChannelID: "channel-1", ToAddress: "foo", Amount: wasmvmtypes.NewCoin(1, "stake")}}}
+	transferWithCallbackMsg := wasmvmtypes.CosmosMsg{Custom: []byte(`{"ibc_transfer_with_callback":{"channel_id":"channel-1","to_address":"foo","amount":{"denom":"stake","amount":"1"},"timeout":{}}}`)}
+
+	specs := map[string]struct {
+		msg         wasmvmtypes.CosmosMsg
+		nestedData  [][]byte
+		nestedErr   error
+		expNested   wasmvmtypes.CosmosMsg
+		expErr      bool
+		expRegister bool
+	}{
+		"transfer with callback registered": {
+			msg:         transferWithCallbackMsg,
+			nestedData:  [][]byte{transferResponse},
+			expNested:   transferMsg,
+			expRegister: true,
+		},
+		"transfer without callback passed through": {
+			msg:        transferMsg,
+			nestedData: [][]byte{transferResponse},
+			expNested:  transferMsg,
+		},
+		"other custom message passed through": {
+			msg:        wasmvmtypes.CosmosMsg{Custom: []byte(`{"foo":{}}`)},
+			nestedData: [][]byte{{1}},
+			expNested:  wasmvmtypes.CosmosMsg{Custom: []byte(`{"foo":{}}`)},
+		},
+		"other message passed through": {
+			msg:        wasmvmtypes.CosmosMsg{IBC: &wasmvmtypes.IBCMsg{CloseChannel: &wasmvmtypes.CloseChannelMsg{ChannelID: "channel-1"}}},
+			nestedData: [][]byte{{1}},
+			expNested:  wasmvmtypes.CosmosMsg{IBC: &wasmvmtypes.IBCMsg{CloseChannel: &wasmvmtypes.CloseChannelMsg{ChannelID: "channel-1"}}},
+		},
+		"nested error": {
+			msg:       transferWithCallbackMsg,
+			nestedErr: errors.New("testing"),
+			expNested: transferMsg,
+			expErr:    true,
+		},
+		"invalid transfer response": {
+			msg:        transferWithCallbackMsg,
+			nestedData: [][]byte{{0xff}},
+			expNested:  transferMsg,
+			expErr:     true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			k := keepers.WasmKeeper
+			contractAddr := RandomAccountAddress(t)
+			var gotNested wasmvmtypes.CosmosMsg
+			nested := &wasmtesting.MockMessageHandler{
+				DispatchMsgFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, err error) {
+					gotNested = msg
+					return nil, spec.nestedData, spec.nestedErr
+				},
+			}
+			// when
+			_, gotData, gotErr := NewIBCTransferCallbackMessageHandler(nested, k).DispatchMsg(ctx, contractAddr, "", spec.msg)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+			} else {
+				require.NoError(t, gotErr)
+				assert.Equal(t, spec.nestedData, gotData)
+			}
+			assert.Equal(t, spec.expNested.IBC, gotNested.IBC)
+			assert.Equal(t, spec.expNested.Custom, gotNested.Custom)
+			var expContract sdk.AccAddress
+			if spec.expRegister {
+				expContract = contractAddr
+			}
+			assert.Equal(t, expContract, k.GetIBCTransferCallback(ctx, "channel-1", 7))
+		})
+	}
+}
+
+func TestIBCTransferLifecycleComplete(t *testing.T) {
+	packet := channeltypes.Packet{SourcePort: ibctransfertypes.PortID, SourceChannel: "channel-1", Sequence: 7}
+	successAck := channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement()
+	errorAck := channeltypes.NewErrorAcknowledgement(errors.New("testing")).Acknowledgement()
+
+	specs := map[string]struct {
+		notRegistered  bool
+		handle         func(ctx sdk.Context, k Keeper)
+		sudoErr        error
+		expMsg         string
+		expSuccessAttr string
+	}{
+		"success ack": {
+			handle:         func(ctx sdk.Context, k Keeper) { k.OnIBCTransferAcknowledgement(ctx, packet, successAck) },
+			expMsg:         `{"ibc_lifecycle_complete":{"ibc_ack":{"channel":"channel-1","sequence":7,"ack":"eyJyZXN1bHQiOiJBUT09In0=","success":true}}}`,
+			expSuccessAttr: "true",
+		},
+		"error ack": {
+			handle:         func(ctx sdk.Context, k Keeper) { k.OnIBCTransferAcknowledgement(ctx, packet, errorAck) },
+			expMsg:         `{"ibc_lifecycle_complete":{"ibc_ack":{"channel":"channel-1","sequence":7,"ack":"eyJlcnJvciI6IkFCQ0kgY29kZTogMTogZXJyb3IgaGFuZGxpbmcgcGFja2V0OiBzZWUgZXZlbnRzIGZvciBkZXRhaWxzIn0=","success":false}}}`,
+			expSuccessAttr: "true",
+		},
+		"timeout": {
+			handle:         func(ctx sdk.Context, k Keeper) { k.OnIBCTransferTimeout(ctx, packet) },
+			expMsg:         `{"ibc_lifecycle_complete":{"ibc_timeout":{"channel":"channel-1","sequence":7}}}`,
+			expSuccessAttr: "true",
+		},
+		"contract fails": {
+			handle:         func(ctx sdk.Context, k Keeper) { k.OnIBCTransferTimeout(ctx, packet) },
+			sudoErr:        errors.New("testing"),
+			expMsg:         `{"ibc_lifecycle_complete":{"ibc_timeout":{"channel":"channel-1","sequence":7}}}`,
+			expSuccessAttr: "false",
+		},
+		"not registered": {
+			notRegistered: true,
+			handle:        func(ctx sdk.Context, k Keeper) { k.OnIBCTransferTimeout(ctx, packet) },
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var mock wasmtesting.MockWasmer
+			wasmtesting.MakeInstantiable(&mock)
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithWasmEngine(&mock), WithIBCTransferCallbacks(100_000))
+			k := keepers.WasmKeeper
+			example := SeedNewContractInstance(t, ctx, keepers, &mock)
+			if !spec.notRegistered {
+				k.setIBCTransferCallback(ctx, packet.SourceChannel, packet.Sequence, example.Contract)
+			}
+			var gotMsg string
+			mock.SudoFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+				gotMsg = string(sudoMsg)
+				return &wasmvmtypes.Response{}, 0, spec.sudoErr
+			}
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			// when
+			spec.handle(ctx, *k)
+
+			// then
+			assert.Equal(t, spec.expMsg, gotMsg)
+			assert.Nil(t, k.GetIBCTransferCallback(ctx, packet.SourceChannel, packet.Sequence))
+			assert.False(t, ctx.KVStore(k.storeKey).Has(types.GetContractIBCTransferCallbackKey(example.Contract, packet.SourceChannel, packet.Sequence)))
+			var gotSuccessAttr string
+			for _, e := range ctx.EventManager().Events() {
+				if e.Type != types.EventTypeIBCLifecycleComplete {
+					continue
+				}
+				for _, a := range e.Attributes {
+					if string(a.Key) == types.AttributeKeySuccess {
+						gotSuccessAttr = string(a.Value)
+					}
+				}
+			}
+			assert.Equal(t, spec.expSuccessAttr, gotSuccessAttr)
+		})
+	}
+}
+
+func TestIBCTransferWithCallbackCircuitBreaker(t *testing.T) {
+	var mock wasmtesting.MockWasmer
+	wasmtesting.MakeInstantiable(&mock)
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithWasmEngine(&mock), WithIBCTransferCallbacks(100_000))
+	k := keepers.WasmKeeper
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	mock.ExecuteFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+		return &wasmvmtypes.Response{Messages: []wasmvmtypes.SubMsg{{
+			Msg:     wasmvmtypes.CosmosMsg{Custom: []byte(`{"ibc_transfer_with_callback":{"channel_id":"channel-1","to_address":"foo","amount":{"denom":"stake","amount":"1"},"timeout":{}}}`)},
+			ReplyOn: wasmvmtypes.ReplyNever,
+		}}}, 0, nil
+	}
+	k.setOperationDisabled(ctx, types.OperationTypeIBCSend, true)
+
+	// when
+	_, err := keepers.ContractKeeper.Execute(ctx, example.Contract, example.CreatorAddr, []byte(`{}`), nil)
+
+	// then
+	assert.ErrorIs(t, err, types.ErrOperationDisabled)
+}
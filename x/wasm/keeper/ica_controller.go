@@ -0,0 +1,243 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// icaControllerMsg is the custom message a contract sends to register or control its interchain accounts
+type icaControllerMsg struct {
+	RegisterInterchainAccount *struct {
+		// ConnectionID is the connection to the host chain
+		ConnectionID string `json:"connection_id"`
+		// Version is the ICS-27 metadata. The default metadata for the connection is used when empty
+		Version string `json:"version,omitempty"`
+	} `json:"register_interchain_account,omitempty"`
+	SubmitInterchainTx *struct {
+		// ConnectionID is the connection to the host chain
+		ConnectionID string `json:"connection_id"`
+		// Msgs are the proto encoded messages executed by the interchain account on the host chain
+		Msgs []wasmvmtypes.StargateMsg `json:"msgs"`
+		// Memo is passed with the packet data
+		Memo string `json:"memo,omitempty"`
+		// TimeoutSeconds is the packet timeout relative to the current block time
+		TimeoutSeconds uint64 `json:"timeout_seconds"`
+	} `json:"submit_interchain_tx,omitempty"`
+}
+
+// submitInterchainTxResponse is returned as message data when an interchain tx was sent
+type submitInterchainTxResponse struct {
+	ChannelID string `json:"channel_id"`
+	Sequence  uint64 `json:"sequence"`
+}
+
+// icaRegisteredMsg is the sudo message sent to a contract when the channel for its interchain account was opened
+type icaRegisteredMsg struct {
+	ICARegistered icaRegistered `json:"ica_registered"`
+}
+
+type icaRegistered struct {
+	ConnectionID string `json:"connection_id"`
+	PortID       string `json:"port_id"`
+	ChannelID    string `json:"channel_id"`
+	// Address is the interchain account address on the host chain
+	Address string `json:"address"`
+}
+
+// icaTxCompleteMsg is the sudo message sent to a contract when an interchain tx that it sent was acknowledged or
+// timed out
+type icaTxCompleteMsg struct {
+	ICATxComplete ibcLifecycleComplete `json:"ica_tx_complete"`
+}
+
+// icaController is the subset of the keeper to register and control the interchain accounts of contracts
+type icaController interface {
+	registerInterchainAccount(ctx sdk.Context, contractAddr sdk.AccAddress, connectionID, version string) error
+	submitInterchainTx(ctx sdk.Context, contractAddr sdk.AccAddress, connectionID string, msgs []*codectypes.Any, memo string, timeout time.Duration) (string, uint64, error)
+}
+
+// NewICAControllerMessageHandler handles the custom messages to register and control interchain accounts. Any other
+// message, including custom messages of a different shape, is passed on.
+func NewICAControllerMessageHandler(k icaController) MessageHandlerFunc {
+	return func(ctx sdk.Context, contractAddr sdk.AccAddress, _ string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, err error) {
+		if msg.Custom == nil {
+			return nil, nil, types.ErrUnknownMsg
+		}
+		var cMsg icaControllerMsg
+		if err := json.Unmarshal(msg.Custom, &cMsg); err != nil {
+			return nil, nil, types.ErrUnknownMsg
+		}
+		em := sdk.NewEventManager()
+		ctx = ctx.WithEventManager(em)
+		switch {
+		case cMsg.RegisterInterchainAccount != nil:
+			if err := k.registerInterchainAccount(ctx, contractAddr, cMsg.RegisterInterchainAccount.ConnectionID, cMsg.RegisterInterchainAccount.Version); err != nil {
+				return nil, nil, err
+			}
+			return em.Events(), nil, nil
+		case cMsg.SubmitInterchainTx != nil:
+			m := cMsg.SubmitInterchainTx
+			if len(m.Msgs) == 0 {
+				return nil, nil, sdkerrors.Wrap(types.ErrEmpty, "msgs")
+			}
+			if m.TimeoutSeconds == 0 {
+				return nil, nil, sdkerrors.Wrap(types.ErrEmpty, "timeout")
+			}
+			anys := make([]*codectypes.Any, len(m.Msgs))
+			for i, v := range m.Msgs {
+				anys[i] = &codectypes.Any{TypeUrl: v.TypeURL, Value: v.Value}
+			}
+			channelID, sequence, err := k.submitInterchainTx(ctx, contractAddr, m.ConnectionID, anys, m.Memo, time.Duration(m.TimeoutSeconds)*time.Second)
+			if err != nil {
+				return nil, nil, err
+			}
+			bz, err := json.Marshal(submitInterchainTxResponse{ChannelID: channelID, Sequence: sequence})
+			if err != nil {
+				return nil, nil, sdkerrors.Wrap(err, "submit interchain tx response")
+			}
+			return em.Events(), [][]byte{bz}, nil
+		default:
+			return nil, nil, types.ErrUnknownMsg
+		}
+	}
+}
+
+// registerInterchainAccount binds the ICS-27 controller port of the contract and starts the channel handshake to
+// the host chain. The contract is called back when the channel is open.
+func (k Keeper) registerInterchainAccount(ctx sdk.Context, contractAddr sdk.AccAddress, connectionID, version string) error {
+	return k.icaControllerKeeper.RegisterInterchainAccount(ctx, connectionID, contractAddr.String(), version)
+}
+
+// submitInterchainTx sends the messages to be executed by the interchain account of the contract on the host chain.
+// Returns the channel id and packet sequence that the contract is called back with on acknowledgement or timeout.
+func (k Keeper) submitInterchainTx(ctx sdk.Context, contractAddr sdk.AccAddress, connectionID string, msgs []*codectypes.Any, memo string, timeout time.Duration) (string, uint64, error) {
+	portID, err := icatypes.NewControllerPortID(contractAddr.String())
+	if err != nil {
+		return "", 0, err
+	}
+	channelID, ok := k.icaControllerKeeper.GetOpenActiveChannel(ctx, connectionID, portID)
+	if !ok {
+		return "", 0, sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "connection %s", connectionID)
+	}
+	chanCap, ok := k.icaCapabilityKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
+	if !ok {
+		return "", 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "not owned by contract")
+	}
+	bz, err := k.cdc.Marshal(&icatypes.CosmosTx{Messages: msgs})
+	if err != nil {
+		return "", 0, sdkerrors.Wrap(err, "cosmos tx")
+	}
+	packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: bz, Memo: memo}
+	sequence, err := k.icaControllerKeeper.SendTx(ctx, chanCap, connectionID, portID, packetData, uint64(ctx.BlockTime().Add(timeout).UnixNano()))
+	if err != nil {
+		return "", 0, err
+	}
+	return channelID, sequence, nil
+}
+
+// IsContractICAControllerPort returns true when the ICS-27 controller port was registered by a contract
+func (k Keeper) IsContractICAControllerPort(ctx sdk.Context, portID string) bool {
+	_, ok := k.icaControllerContract(ctx, portID)
+	return ok
+}
+
+// icaControllerContract returns the contract that owns the ICS-27 controller port
+func (k Keeper) icaControllerContract(ctx sdk.Context, portID string) (sdk.AccAddress, bool) {
+	if k.icaControllerKeeper == nil || !strings.HasPrefix(portID, icatypes.PortPrefix) {
+		return nil, false
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(strings.TrimPrefix(portID, icatypes.PortPrefix))
+	if err != nil {
+		return nil, false
+	}
+	return contractAddr, k.HasContractInfo(ctx, contractAddr)
+}
+
+// OnICAChannelOpenInit claims the capability of the interchain account channel for the contract
+func (k Keeper) OnICAChannelOpenInit(ctx sdk.Context, portID, channelID string, chanCap *capabilitytypes.Capability) error {
+	if err := k.icaCapabilityKeeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return sdkerrors.Wrap(err, "claim channel capability")
+	}
+	return nil
+}
+
+// OnICAChannelOpenAck calls the sudo entry point of the contract with the address of the registered interchain
+// account
+func (k Keeper) OnICAChannelOpenAck(ctx sdk.Context, portID, channelID, connectionID, icaAddress string) {
+	contractAddr, ok := k.icaControllerContract(ctx, portID)
+	if !ok {
+		return
+	}
+	k.icaCallback(ctx, contractAddr, portID, channelID, 0, icaRegisteredMsg{ICARegistered: icaRegistered{
+		ConnectionID: connectionID,
+		PortID:       portID,
+		ChannelID:    channelID,
+		Address:      icaAddress,
+	}})
+}
+
+// OnICAAcknowledgement calls the sudo entry point of the contract that sent the interchain tx with the
+// acknowledgement
+func (k Keeper) OnICAAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) {
+	contractAddr, ok := k.icaControllerContract(ctx, packet.SourcePort)
+	if !ok {
+		return
+	}
+	var ack channeltypes.Acknowledgement
+	success := icatypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack) == nil && ack.Success()
+	k.icaCallback(ctx, contractAddr, packet.SourcePort, packet.SourceChannel, packet.Sequence, icaTxCompleteMsg{ICATxComplete: ibcLifecycleComplete{IBCAck: &ibcLifecycleAck{
+		Channel:  packet.SourceChannel,
+		Sequence: packet.Sequence,
+		Ack:      acknowledgement,
+		Success:  success,
+	}}})
+}
+
+// OnICATimeout calls the sudo entry point of the contract that sent the interchain tx with the timeout. The ordered
+// channel is closed on timeout so that the contract has to register the interchain account again.
+func (k Keeper) OnICATimeout(ctx sdk.Context, packet channeltypes.Packet) {
+	contractAddr, ok := k.icaControllerContract(ctx, packet.SourcePort)
+	if !ok {
+		return
+	}
+	k.icaCallback(ctx, contractAddr, packet.SourcePort, packet.SourceChannel, packet.Sequence, icaTxCompleteMsg{ICATxComplete: ibcLifecycleComplete{IBCTimeout: &ibcLifecycleTimeout{
+		Channel:  packet.SourceChannel,
+		Sequence: packet.Sequence,
+	}}})
+}
+
+// icaCallback calls the contract with the gas limit for interchain account callbacks. Failures of the contract are
+// ignored so that they do not block the channel or packet lifecycle.
+func (k Keeper) icaCallback(ctx sdk.Context, contractAddr sdk.AccAddress, portID, channelID string, sequence uint64, msg interface{}) {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	gasUsed, err := k.sudoWithGasLimit(ctx, contractAddr, bz, k.icaCallbackGasLimit)
+	ctx.GasMeter().ConsumeGas(gasUsed, "ica callback")
+	if err != nil {
+		k.Logger(ctx).Debug("ica callback", "contract", contractAddr.String(), "error", err.Error())
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeICACallback,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyPortID, portID),
+		sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+	))
+}
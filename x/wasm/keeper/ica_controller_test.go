@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestICAControllerMessageHandler(t *testing.T) {
+	myContractAddr := RandomAccountAddress(t)
+	specs := map[string]struct {
+		msg         wasmvmtypes.CosmosMsg
+		keeperErr   error
+		expErr      *sdkerrors.Error
+		expRegister string
+		expSubmit   []*codectypes.Any
+		expTimeout  time.Duration
+		expData     [][]byte
+	}{
+		"register": {
+			msg:         wasmvmtypes.CosmosMsg{Custom: []byte(`{"register_interchain_account":{"connection_id":"connection-0"}}`)},
+			expRegister: "connection-0",
+		},
+		"submit": {
+			msg:        wasmvmtypes.CosmosMsg{Custom: []byte(`{"submit_interchain_tx":{"connection_id":"connection-0","msgs":[{"type_url":"/foo","value":"AQ=="}],"timeout_seconds":60}}`)},
+			expSubmit:  []*codectypes.Any{{TypeUrl: "/foo", Value: []byte{1}}},
+			expTimeout: time.Minute,
+			expData:    [][]byte{[]byte(`{"channel_id":"channel-1","sequence":7}`)},
+		},
+		"submit without msgs": {
+			msg:    wasmvmtypes.CosmosMsg{Custom: []byte(`{"submit_interchain_tx":{"connection_id":"connection-0","msgs":[],"timeout_seconds":60}}`)},
+			expErr: types.ErrEmpty,
+		},
+		"submit without timeout": {
+			msg:    wasmvmtypes.CosmosMsg{Custom: []byte(`{"submit_interchain_tx":{"connection_id":"connection-0","msgs":[{"type_url":"/foo","value":"AQ=="}]}}`)},
+			expErr: types.ErrEmpty,
+		},
+		"keeper fails": {
+			msg:       wasmvmtypes.CosmosMsg{Custom: []byte(`{"register_interchain_account":{"connection_id":"connection-0"}}`)},
+			keeperErr: types.ErrInvalid,
+			expErr:    types.ErrInvalid,
+		},
+		"other custom msg": {
+			msg:    wasmvmtypes.CosmosMsg{Custom: []byte(`{"foo":{}}`)},
+			expErr: types.ErrUnknownMsg,
+		},
+		"non custom msg": {
+			msg:    wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{}},
+			expErr: types.ErrUnknownMsg,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			mock := &mockICAController{err: spec.keeperErr}
+			ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
+			// when
+			_, gotData, gotErr := NewICAControllerMessageHandler(mock).DispatchMsg(ctx, myContractAddr, "", spec.msg)
+			// then
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(gotErr), "expected %v but got %+v", spec.expErr, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expData, gotData)
+			assert.Equal(t, spec.expRegister, mock.registered)
+			assert.Equal(t, spec.expSubmit, mock.submitted)
+			assert.Equal(t, spec.expTimeout, mock.timeout)
+		})
+	}
+}
+
+type mockICAController struct {
+	err        error
+	registered string
+	submitted  []*codectypes.Any
+	timeout    time.Duration
+}
+
+func (m *mockICAController) registerInterchainAccount(_ sdk.Context, _ sdk.AccAddress, connectionID, _ string) error {
+	m.registered = connectionID
+	return m.err
+}
+
+func (m *mockICAController) submitInterchainTx(_ sdk.Context, _ sdk.AccAddress, _ string, msgs []*codectypes.Any, _ string, timeout time.Duration) (string, uint64, error) {
+	if m.err != nil {
+		return "", 0, m.err
+	}
+	m.submitted, m.timeout = msgs, timeout
+	return "channel-1", 7, nil
+}
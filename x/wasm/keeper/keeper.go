@@ -3,8 +3,10 @@ package keeper
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"math"
 	"reflect"
@@ -21,6 +23,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -37,6 +40,10 @@ type contextKey int
 const (
 	// private type creates an interface key for Context that cannot be accessed by any other package
 	contextKeyQueryStackSize contextKey = iota
+	// contextKeyDispatchRecorder references a *dispatchRecorder that collects all contract messages
+	contextKeyDispatchRecorder
+	// contextKeyCallTracer references a *callTracer that records the contract call tree
+	contextKeyCallTracer
 )
 
 // Option is an extension point to instantiate keeper with non default values
@@ -87,20 +94,47 @@ type Keeper struct {
 	storeKey              sdk.StoreKey
 	cdc                   codec.Codec
 	accountKeeper         types.AccountKeeper
+	bankKeeper            types.BankKeeper
 	bank                  CoinTransferrer
 	portKeeper            types.PortKeeper
+	channelKeeper         types.ChannelKeeper
 	capabilityKeeper      types.CapabilityKeeper
 	wasmVM                types.WasmerEngine
 	wasmVMQueryHandler    WasmVMQueryHandler
 	wasmVMResponseHandler WasmVMResponseHandler
 	messenger             Messenger
 	// queryGasLimit is the max wasmvm gas that can be spent on executing a query with a contract
-	queryGasLimit        uint64
+	queryGasLimit uint64
+	// simulationGasLimit is the max gas that can be spent on a contract simulation query. 0 when not set
+	simulationGasLimit   uint64
 	paramSpace           paramtypes.Subspace
 	gasRegister          GasRegister
 	maxQueryStackSize    uint32
 	acceptedAccountTypes map[reflect.Type]struct{}
 	accountPruner        AccountPruner
+	// debugMode records and logs the contract call trees
+	debugMode bool
+	// emitTypedEvents emits the proto typed events in addition to the legacy events
+	emitTypedEvents bool
+	// contractEvents publishes the contract events to gRPC subscribers. Nil when disabled
+	contractEvents *ContractEventBroker
+	// ibcTransferCallbackGasLimit is the max gas a contract can spend on an ICS-20 lifecycle callback. 0 when disabled
+	ibcTransferCallbackGasLimit uint64
+	// icaControllerKeeper registers and controls the interchain accounts of contracts. Nil when disabled
+	icaControllerKeeper types.ICAControllerKeeper
+	// icaCapabilityKeeper is scoped to the interchain account channels owned by contracts
+	icaCapabilityKeeper types.CapabilityKeeper
+	// icaCallbackGasLimit is the max gas a contract can spend on an interchain account callback
+	icaCallbackGasLimit uint64
+	// stateChangeJournalKey is the transient store to journal the contract state changes for streaming. Nil when
+	// disabled
+	stateChangeJournalKey sdk.StoreKey
+	// ibcAsyncAcks lets contracts acknowledge received packets asynchronously
+	ibcAsyncAcks bool
+	// scheduledCallbacks lets contracts schedule and cancel callbacks with custom messages
+	scheduledCallbacks bool
+	// ics4Wrapper writes the acknowledgements of received packets that contracts acknowledge asynchronously
+	ics4Wrapper types.ICS4Wrapper
 }
 
 func (k Keeper) getUploadAccessConfig(ctx sdk.Context) types.AccessConfig {
@@ -115,6 +149,31 @@ func (k Keeper) getInstantiateAccessConfig(ctx sdk.Context) types.AccessType {
 	return a
 }
 
+func (k Keeper) getMaxContractStorageBytes(ctx sdk.Context) uint64 {
+	var a uint64
+	k.paramSpace.Get(ctx, types.ParamStoreKeyMaxContractStorage, &a)
+	return a
+}
+
+func (k Keeper) getCronBlockGasLimit(ctx sdk.Context) uint64 {
+	var a uint64
+	k.paramSpace.Get(ctx, types.ParamStoreKeyCronBlockGasLimit, &a)
+	return a
+}
+
+func (k Keeper) getEmergencyAuthority(ctx sdk.Context) sdk.AccAddress {
+	var a string
+	k.paramSpace.Get(ctx, types.ParamStoreKeyEmergencyAuthority, &a)
+	if a == "" {
+		return nil
+	}
+	addr, err := sdk.AccAddressFromBech32(a)
+	if err != nil { // should never happen as the param is validated
+		return nil
+	}
+	return addr
+}
+
 // GetParams returns the total set of wasm parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	var params types.Params
@@ -126,7 +185,35 @@ func (k Keeper) SetParams(ctx sdk.Context, ps types.Params) {
 	k.paramSpace.SetParamSet(ctx, &ps)
 }
 
+func (k Keeper) getEnforceCodeReuse(ctx sdk.Context) bool {
+	var a bool
+	k.paramSpace.Get(ctx, types.ParamStoreKeyEnforceCodeReuse, &a)
+	return a
+}
+
+func (k Keeper) getEnforceUniqueLabels(ctx sdk.Context) bool {
+	var a bool
+	k.paramSpace.Get(ctx, types.ParamStoreKeyEnforceUniqueLabels, &a)
+	return a
+}
+
+func (k Keeper) getLabelIndexScopeParam(ctx sdk.Context) types.LabelIndexScope {
+	var a types.LabelIndexScope
+	k.paramSpace.Get(ctx, types.ParamStoreKeyLabelIndexScope, &a)
+	return a
+}
+
 func (k Keeper) create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *types.AccessConfig, authZ AuthorizationPolicy) (codeID uint64, checksum []byte, err error) {
+	return k.createCode(ctx, creator, wasmCode, instantiateAccess, k.getEnforceCodeReuse(ctx), authZ)
+}
+
+// createOrReuse returns the id of an existing code with the same checksum and instantiate permission or stores the
+// wasm code as a new code when none exists.
+func (k Keeper) createOrReuse(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *types.AccessConfig, authZ AuthorizationPolicy) (codeID uint64, checksum []byte, err error) {
+	return k.createCode(ctx, creator, wasmCode, instantiateAccess, true, authZ)
+}
+
+func (k Keeper) createCode(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *types.AccessConfig, reuseExisting bool, authZ AuthorizationPolicy) (codeID uint64, checksum []byte, err error) {
 	if creator == nil {
 		return 0, checksum, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "cannot be nil")
 	}
@@ -153,6 +240,23 @@ func (k Keeper) create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte,
 		}
 	}
 
+	if reuseExisting {
+		hash := sha256.Sum256(wasmCode)
+		if existingID, found := k.findReusableCode(ctx, hash[:], *instantiateAccess); found {
+			k.Logger(ctx).Debug("reusing existing code", "code_id", existingID)
+			report, err := k.wasmVM.AnalyzeCode(hash[:])
+			if err != nil {
+				return 0, checksum, sdkerrors.Wrap(types.ErrCreateFailed, err.Error())
+			}
+			codeInfo := k.GetCodeInfo(ctx, existingID)
+			// emit the same events as for a new code so that clients find the code id
+			if err := k.emitStoreCodeEvents(ctx, existingID, hash[:], codeInfo.Creator, codeInfo.InstantiateConfig, report.RequiredCapabilities); err != nil {
+				return 0, checksum, err
+			}
+			return existingID, hash[:], nil
+		}
+	}
+
 	ctx.GasMeter().ConsumeGas(k.gasRegister.CompileCosts(len(wasmCode)), "Compiling wasm bytecode")
 	checksum, err = k.wasmVM.Create(wasmCode)
 	if err != nil {
@@ -165,19 +269,38 @@ func (k Keeper) create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte,
 	codeID = k.autoIncrementID(ctx, types.KeyLastCodeID)
 	k.Logger(ctx).Debug("storing new contract", "capabilities", report.RequiredCapabilities, "code_id", codeID)
 	codeInfo := types.NewCodeInfo(checksum, creator, *instantiateAccess)
+	codeInfo.Created = types.NewAbsoluteTxPosition(ctx)
+	codeInfo.WasmSize = uint64(len(wasmCode))
 	k.storeCodeInfo(ctx, codeID, codeInfo)
+	k.addToCodeIDsByChecksumIndex(ctx, checksum, codeID)
+	if err := k.emitStoreCodeEvents(ctx, codeID, checksum, creator.String(), *instantiateAccess, report.RequiredCapabilities); err != nil {
+		return 0, checksum, err
+	}
+	return codeID, checksum, nil
+}
 
+// emitStoreCodeEvents emits the legacy and typed events for a stored code
+func (k Keeper) emitStoreCodeEvents(ctx sdk.Context, codeID uint64, checksum []byte, creator string, instantiateAccess types.AccessConfig, requiredCapabilities string) error {
 	evt := sdk.NewEvent(
 		types.EventTypeStoreCode,
 		sdk.NewAttribute(types.AttributeKeyChecksum, hex.EncodeToString(checksum)),
 		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)), // last element to be compatible with scripts
 	)
-	for _, f := range strings.Split(report.RequiredCapabilities, ",") {
+	var capabilities []string
+	for _, f := range strings.Split(requiredCapabilities, ",") {
 		evt.AppendAttributes(sdk.NewAttribute(types.AttributeKeyRequiredCapability, strings.TrimSpace(f)))
+		if c := strings.TrimSpace(f); c != "" {
+			capabilities = append(capabilities, c)
+		}
 	}
 	ctx.EventManager().EmitEvent(evt)
-
-	return codeID, checksum, nil
+	return k.emitTypedEvent(ctx, &types.EventCodeStored{
+		CodeID:                codeID,
+		Creator:               creator,
+		Checksum:              checksum,
+		InstantiatePermission: instantiateAccess,
+		RequiredCapabilities:  capabilities,
+	})
 }
 
 func (k Keeper) storeCodeInfo(ctx sdk.Context, codeID uint64, codeInfo types.CodeInfo) {
@@ -201,6 +324,9 @@ func (k Keeper) importCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeIn
 	if !bytes.Equal(codeInfo.CodeHash, newCodeHash) {
 		return sdkerrors.Wrap(types.ErrInvalid, "code hashes not same")
 	}
+	if codeInfo.WasmSize == 0 {
+		codeInfo.WasmSize = uint64(len(wasmCode))
+	}
 
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetCodeKey(codeID)
@@ -209,9 +335,42 @@ func (k Keeper) importCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeIn
 	}
 	// 0x01 | codeID (uint64) -> ContractInfo
 	store.Set(key, k.cdc.MustMarshal(&codeInfo))
+	k.addToCodeIDsByChecksumIndex(ctx, codeInfo.CodeHash, codeID)
 	return nil
 }
 
+// addToCodeIDsByChecksumIndex adds the code id to the secondary index of codes with the same checksum
+func (k Keeper) addToCodeIDsByChecksumIndex(ctx sdk.Context, checksum []byte, codeID uint64) {
+	ctx.KVStore(k.storeKey).Set(types.GetCodeIDByChecksumKey(checksum, codeID), []byte{})
+}
+
+// IterateCodeIDsByChecksum iterates over all code ids stored with the given checksum in ascending order until the
+// callback returns true
+func (k Keeper) IterateCodeIDsByChecksum(ctx sdk.Context, checksum []byte, cb func(codeID uint64) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCodeIDsByChecksumPrefix(checksum)).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(binary.BigEndian.Uint64(iter.Key())) {
+			return
+		}
+	}
+}
+
+// findReusableCode returns the lowest code id that was stored with the given checksum and instantiate permission
+func (k Keeper) findReusableCode(ctx sdk.Context, checksum []byte, instantiateAccess types.AccessConfig) (uint64, bool) {
+	var (
+		codeID uint64
+		found  bool
+	)
+	k.IterateCodeIDsByChecksum(ctx, checksum, func(id uint64) bool {
+		info := k.GetCodeInfo(ctx, id)
+		found = info != nil && info.InstantiateConfig.Equal(instantiateAccess)
+		codeID = id
+		return found
+	})
+	return codeID, found
+}
+
 func (k Keeper) instantiate(
 	ctx sdk.Context,
 	codeID uint64,
@@ -221,8 +380,10 @@ func (k Keeper) instantiate(
 	deposit sdk.Coins,
 	addressGenerator AddressGenerator,
 	authPolicy AuthorizationPolicy,
-) (sdk.AccAddress, []byte, error) {
+) (_ sdk.AccAddress, _ []byte, err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "instantiate")
+	ctx, trace := k.startCallTrace(ctx, "instantiate", nil)
+	defer func() { trace.end(ctx, err, recover()) }()
 
 	if creator == nil {
 		return nil, nil, types.ErrEmpty.Wrap("creator")
@@ -238,7 +399,12 @@ func (k Keeper) instantiate(
 		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not instantiate")
 	}
 
+	if k.getEnforceUniqueLabels(ctx) && k.hasContractWithLabel(ctx, creator, label) {
+		return nil, nil, types.ErrDuplicate.Wrap("label already used by a contract of this creator")
+	}
+
 	contractAddress := addressGenerator(ctx, codeID, codeInfo.CodeHash)
+	trace.setContract(contractAddress)
 	if k.HasContractInfo(ctx, contractAddress) {
 		return nil, nil, types.ErrDuplicate.Wrap("instance with this code id, sender and label exists: try a different label")
 	}
@@ -288,8 +454,7 @@ func (k Keeper) instantiate(
 
 	// create prefixed data store
 	// 0x03 | BuildContractAddressClassic (sdk.AccAddress)
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
+	prefixStore := k.contractStore(ctx, contractAddress)
 
 	// prepare querier
 	querier := k.newQueryHandler(ctx, contractAddress)
@@ -301,6 +466,9 @@ func (k Keeper) instantiate(
 	if err != nil {
 		return nil, nil, sdkerrors.Wrap(types.ErrInstantiateFailed, err.Error())
 	}
+	if err := k.commitStorageStats(ctx, contractAddress, creator, prefixStore); err != nil {
+		return nil, nil, err
+	}
 
 	// persist instance first
 	createdAt := types.NewAbsoluteTxPosition(ctx)
@@ -322,8 +490,11 @@ func (k Keeper) instantiate(
 
 	// store contract before dispatch so that contract could be called back
 	historyEntry := contractInfo.InitialHistory(initMsg)
+	historyEntry.Sender = historySender(creator, authPolicy)
 	k.addToContractCodeSecondaryIndex(ctx, contractAddress, historyEntry)
 	k.addToContractCreatorSecondaryIndex(ctx, creator, historyEntry.Updated, contractAddress)
+	k.addToContractAdminSecondaryIndex(ctx, admin, contractAddress)
+	k.addToContractLabelSecondaryIndex(ctx, creator, label, contractAddress)
 	k.appendToContractHistory(ctx, contractAddress, historyEntry)
 	k.storeContractInfo(ctx, contractAddress, &contractInfo)
 
@@ -332,6 +503,15 @@ func (k Keeper) instantiate(
 		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
 		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
 	))
+	if err := k.emitTypedEvent(ctx, &types.EventContractInstantiated{
+		ContractAddress: contractAddress.String(),
+		CodeID:          codeID,
+		Creator:         creator.String(),
+		Admin:           admin.String(),
+		Label:           label,
+	}); err != nil {
+		return nil, nil, err
+	}
 
 	data, err := k.handleContractResponse(ctx, contractAddress, contractInfo.IBCPortID, res.Messages, res.Attributes, res.Data, res.Events)
 	if err != nil {
@@ -342,12 +522,17 @@ func (k Keeper) instantiate(
 }
 
 // Execute executes the contract instance
-func (k Keeper) execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error) {
+func (k Keeper) execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) (_ []byte, err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "execute")
+	ctx, trace := k.startCallTrace(ctx, "execute", contractAddress)
+	defer func() { trace.end(ctx, err, recover()) }()
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
+	if contractInfo.IsFrozen {
+		return nil, sdkerrors.Wrap(types.ErrContractFrozen, "can not execute")
+	}
 
 	executeCosts := k.gasRegister.InstantiateContractCosts(k.IsPinnedCode(ctx, contractInfo.CodeID), len(msg))
 	ctx.GasMeter().ConsumeGas(executeCosts, "Loading CosmWasm module: execute")
@@ -370,6 +555,9 @@ func (k Keeper) execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	if execErr != nil {
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := k.commitStorageStats(ctx, contractAddress, caller, prefixStore); err != nil {
+		return nil, err
+	}
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeExecute,
@@ -384,8 +572,10 @@ func (k Keeper) execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	return data, nil
 }
 
-func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte, authZ AuthorizationPolicy) ([]byte, error) {
+func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte, authZ AuthorizationPolicy) (_ []byte, err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "migrate")
+	ctx, trace := k.startCallTrace(ctx, "migrate", contractAddress)
+	defer func() { trace.end(ctx, err, recover()) }()
 	migrateSetupCosts := k.gasRegister.InstantiateContractCosts(k.IsPinnedCode(ctx, newCodeID), len(msg))
 	ctx.GasMeter().ConsumeGas(migrateSetupCosts, "Loading CosmWasm module: migrate")
 
@@ -396,6 +586,9 @@ func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not migrate")
 	}
+	if contractInfo.IsFrozen && !authZ.CanMigrateFrozenContract() {
+		return nil, sdkerrors.Wrap(types.ErrContractFrozen, "can not migrate")
+	}
 
 	newCodeInfo := k.GetCodeInfo(ctx, newCodeID)
 	if newCodeInfo == nil {
@@ -427,18 +620,22 @@ func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	// prepare querier
 	querier := k.newQueryHandler(ctx, contractAddress)
 
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
+	prefixStore := k.contractStore(ctx, contractAddress)
 	gas := k.runtimeGasForContract(ctx)
-	res, gasUsed, err := k.wasmVM.Migrate(newCodeInfo.CodeHash, env, msg, &prefixStore, cosmwasmAPI, &querier, k.gasMeter(ctx), gas, costJSONDeserialization)
+	res, gasUsed, err := k.wasmVM.Migrate(newCodeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, &querier, k.gasMeter(ctx), gas, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrMigrationFailed, err.Error())
 	}
+	if err := k.commitStorageStats(ctx, contractAddress, caller, prefixStore); err != nil {
+		return nil, err
+	}
 	// delete old secondary index entry
-	k.removeFromContractCodeSecondaryIndex(ctx, contractAddress, k.getLastContractHistoryEntry(ctx, contractAddress))
+	k.removeFromContractCodeSecondaryIndex(ctx, contractAddress, k.getLastContractCodeHistoryEntry(ctx, contractAddress))
 	// persist migration updates
+	oldCodeID := contractInfo.CodeID
 	historyEntry := contractInfo.AddMigration(ctx, newCodeID, msg)
+	historyEntry.Sender = historySender(caller, authZ)
 	k.appendToContractHistory(ctx, contractAddress, historyEntry)
 	k.addToContractCodeSecondaryIndex(ctx, contractAddress, historyEntry)
 	k.storeContractInfo(ctx, contractAddress, contractInfo)
@@ -448,6 +645,14 @@ func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(newCodeID, 10)),
 		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
 	))
+	if err := k.emitTypedEvent(ctx, &types.EventContractMigrated{
+		ContractAddress: contractAddress.String(),
+		OldCodeID:       oldCodeID,
+		NewCodeID:       newCodeID,
+		Sender:          historyEntry.Sender,
+	}); err != nil {
+		return nil, err
+	}
 
 	data, err := k.handleContractResponse(ctx, contractAddress, contractInfo.IBCPortID, res.Messages, res.Attributes, res.Data, res.Events)
 	if err != nil {
@@ -460,8 +665,10 @@ func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 // Sudo allows priviledged access to a contract. This can never be called by an external tx, but only by
 // another native Go module directly, or on-chain governance (if sudo proposals are enabled). Thus, the keeper doesn't
 // place any access controls on it, that is the responsibility or the app developer (who passes the wasm.Keeper in app.go)
-func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) (_ []byte, err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "sudo")
+	ctx, trace := k.startCallTrace(ctx, "sudo", contractAddress)
+	defer func() { trace.end(ctx, err, recover()) }()
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
@@ -480,6 +687,9 @@ func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte
 	if execErr != nil {
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := k.commitStorageStats(ctx, contractAddress, nil, prefixStore); err != nil {
+		return nil, err
+	}
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeSudo,
@@ -495,7 +705,9 @@ func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte
 }
 
 // reply is only called from keeper internal functions (dispatchSubmessages) after processing the submessage
-func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
+func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) (_ []byte, err error) {
+	ctx, trace := k.startCallTrace(ctx, "reply", contractAddress)
+	defer func() { trace.end(ctx, err, recover()) }()
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
@@ -516,6 +728,9 @@ func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply was
 	if execErr != nil {
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := k.commitStorageStats(ctx, contractAddress, nil, prefixStore); err != nil {
+		return nil, err
+	}
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeReply,
@@ -547,6 +762,134 @@ func (k Keeper) addToContractCreatorSecondaryIndex(ctx sdk.Context, creatorAddre
 	store.Set(types.GetContractByCreatorSecondaryIndexKey(creatorAddress, position.Bytes(), contractAddress), []byte{})
 }
 
+// removeFromContractCreatorSecondaryIndex removes element from the index for contracts-by-creator queries
+func (k Keeper) removeFromContractCreatorSecondaryIndex(ctx sdk.Context, creatorAddress sdk.AccAddress, position *types.AbsoluteTxPosition, contractAddress sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.GetContractByCreatorSecondaryIndexKey(creatorAddress, position.Bytes(), contractAddress))
+}
+
+// addToContractAdminSecondaryIndex adds element to the index for contracts-by-admin queries. Contracts without admin
+// are not indexed.
+func (k Keeper) addToContractAdminSecondaryIndex(ctx sdk.Context, adminAddress, contractAddress sdk.AccAddress) {
+	if adminAddress.Empty() {
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetContractByAdminSecondaryIndexKey(adminAddress, contractAddress), []byte{})
+}
+
+// removeFromContractAdminSecondaryIndex removes element from the index for contracts-by-admin queries
+func (k Keeper) removeFromContractAdminSecondaryIndex(ctx sdk.Context, adminAddress, contractAddress sdk.AccAddress) {
+	if adminAddress.Empty() {
+		return
+	}
+	ctx.KVStore(k.storeKey).Delete(types.GetContractByAdminSecondaryIndexKey(adminAddress, contractAddress))
+}
+
+// addToContractLabelSecondaryIndex adds element to the indexes for contracts-by-label queries within the scope of the
+// current label index
+func (k Keeper) addToContractLabelSecondaryIndex(ctx sdk.Context, creatorAddress sdk.AccAddress, label string, contractAddress sdk.AccAddress) {
+	scope := k.GetLabelIndexScope(ctx)
+	if scope == types.LabelIndexScopeNone {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetContractByCreatorLabelSecondaryIndexKey(creatorAddress, label, contractAddress), []byte{})
+	if scope == types.LabelIndexScopeGlobal {
+		store.Set(types.GetContractByLabelSecondaryIndexKey(label, contractAddress), []byte{})
+	}
+}
+
+// removeFromContractLabelSecondaryIndex removes element from the indexes for contracts-by-label queries
+func (k Keeper) removeFromContractLabelSecondaryIndex(ctx sdk.Context, creatorAddress sdk.AccAddress, label string, contractAddress sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetContractByLabelSecondaryIndexKey(label, contractAddress))
+	store.Delete(types.GetContractByCreatorLabelSecondaryIndexKey(creatorAddress, label, contractAddress))
+}
+
+// GetLabelIndexScope returns the scope of the current index of contracts by label. It differs from the scope set in
+// the params until the index is rebuilt by SyncContractLabelIndex.
+func (k Keeper) GetLabelIndexScope(ctx sdk.Context) types.LabelIndexScope {
+	bz := ctx.KVStore(k.storeKey).Get(types.LabelIndexScopeKey)
+	if bz == nil {
+		return types.LabelIndexScopeNone
+	}
+	return types.LabelIndexScope(sdk.BigEndianToUint64(bz))
+}
+
+// SyncContractLabelIndex rebuilds the index of contracts by label when its scope differs from the effective scope in
+// the params. The index is dropped when contracts are not indexed anymore.
+func (k Keeper) SyncContractLabelIndex(ctx sdk.Context) {
+	scope := types.Params{
+		EnforceUniqueLabels: k.getEnforceUniqueLabels(ctx),
+		LabelIndexScope:     k.getLabelIndexScopeParam(ctx),
+	}.EffectiveLabelIndexScope()
+	if scope == k.GetLabelIndexScope(ctx) {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	for _, keyPrefix := range [][]byte{types.ContractsByLabelPrefix, types.ContractsByCreatorLabelPrefix} {
+		var keys [][]byte
+		iter := prefix.NewStore(store, keyPrefix).Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, append(keyPrefix, iter.Key()...))
+		}
+		iter.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+	if scope == types.LabelIndexScopeNone {
+		store.Delete(types.LabelIndexScopeKey)
+		return
+	}
+	store.Set(types.LabelIndexScopeKey, sdk.Uint64ToBigEndian(uint64(scope)))
+	k.IterateContractInfo(ctx, func(contractAddr sdk.AccAddress, contractInfo types.ContractInfo) bool {
+		k.addToContractLabelSecondaryIndex(ctx, sdk.MustAccAddressFromBech32(contractInfo.Creator), contractInfo.Label, contractAddr)
+		return false
+	})
+	k.Logger(ctx).Info("rebuilt contract label index", "scope", scope.String())
+}
+
+// hasContractWithLabel returns true when the creator has instantiated a contract with exactly the given label
+func (k Keeper) hasContractWithLabel(ctx sdk.Context, creator sdk.AccAddress, label string) bool {
+	var found bool
+	k.IterateContractsByLabel(ctx, creator, label, func(l string, _ sdk.AccAddress) bool {
+		found = l == label
+		return found
+	})
+	return found
+}
+
+// IterateContractsByLabel iterates over all contracts with a label that starts with the given label prefix in order of
+// label and contract address asc. The search is scoped to the contracts of the creator when not empty.
+func (k Keeper) IterateContractsByLabel(ctx sdk.Context, creator sdk.AccAddress, labelPrefix string, cb func(label string, address sdk.AccAddress) bool) {
+	keyPrefix := types.GetContractsByLabelPrefix(labelPrefix)
+	if !creator.Empty() {
+		keyPrefix = types.GetContractsByCreatorLabelPrefix(creator, labelPrefix)
+	}
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		labelSuffix, contractAddr, err := types.ParseLabelIndexKey(iter.Key())
+		if err != nil { // should never happen
+			panic(err.Error())
+		}
+		if cb(labelPrefix+labelSuffix, contractAddr) {
+			return
+		}
+	}
+}
+
+// IterateContractsByAdmin iterates over all contracts with given admin address in order of contract address asc.
+func (k Keeper) IterateContractsByAdmin(ctx sdk.Context, admin sdk.AccAddress, cb func(address sdk.AccAddress) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractsByAdminPrefix(admin)).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(iter.Key()) {
+			return
+		}
+	}
+}
+
 // IterateContractsByCreator iterates over all contracts with given creator address in order of creation time asc.
 func (k Keeper) IterateContractsByCreator(ctx sdk.Context, creator sdk.AccAddress, cb func(address sdk.AccAddress) bool) {
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractsByCreatorPrefix(creator))
@@ -581,7 +924,12 @@ func (k Keeper) setContractAdmin(ctx sdk.Context, contractAddress, caller, newAd
 		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
 	}
 	newAdminStr := newAdmin.String()
-	contractInfo.Admin = newAdminStr
+	oldAdminStr := contractInfo.Admin
+	k.removeFromContractAdminSecondaryIndex(ctx, contractInfo.AdminAddr(), contractAddress)
+	historyEntry := contractInfo.SetAdmin(ctx, newAdmin)
+	historyEntry.Sender = historySender(caller, authZ)
+	k.appendToContractHistory(ctx, contractAddress, historyEntry)
+	k.addToContractAdminSecondaryIndex(ctx, newAdmin, contractAddress)
 	k.storeContractInfo(ctx, contractAddress, contractInfo)
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeUpdateContractAdmin,
@@ -589,9 +937,157 @@ func (k Keeper) setContractAdmin(ctx sdk.Context, contractAddress, caller, newAd
 		sdk.NewAttribute(types.AttributeKeyNewAdmin, newAdminStr),
 	))
 
+	return k.emitTypedEvent(ctx, &types.EventContractAdminSet{
+		ContractAddress: contractAddress.String(),
+		OldAdmin:        oldAdminStr,
+		NewAdmin:        newAdminStr,
+	})
+}
+
+// setContractFrozen pauses or resumes a contract. A frozen contract rejects executions, migrations other than by
+// governance and IBC packets while queries keep working.
+func (k Keeper) setContractFrozen(ctx sdk.Context, contractAddress, caller sdk.AccAddress, frozen bool, authZ AuthorizationPolicy) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !authZ.CanFreezeContract(k.getEmergencyAuthority(ctx), caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not freeze or unfreeze contract")
+	}
+	if contractInfo.IsFrozen == frozen {
+		return sdkerrors.Wrapf(types.ErrInvalid, "contract frozen: %t", frozen)
+	}
+	contractInfo.IsFrozen = frozen
+	k.storeContractInfo(ctx, contractAddress, contractInfo)
+
+	eventType := types.EventTypeFreezeContract
+	if !frozen {
+		eventType = types.EventTypeUnfreezeContract
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+	))
 	return nil
 }
 
+// deleteContractSudoMsg is passed to the optional sudo entry point of a contract before it is deleted
+type deleteContractSudoMsg struct {
+	DeleteContract struct {
+		Recipient string `json:"recipient"`
+	} `json:"delete_contract"`
+}
+
+// deleteContract removes the contract info, state, history and secondary indexes. Any storage deposit is released and the
+// remaining contract balance is sent to the recipient and the IBC ports are released. A contract with a channel that
+// is not closed can not be deleted. Received packets that the contract did not acknowledge, yet, get an error
+// acknowledgement.
+// Before deletion, the contract's sudo entry point is called with a `delete_contract` message. This hook is optional
+// so that a failure is logged and all its state changes are reverted without aborting the deletion.
+func (k Keeper) deleteContract(ctx sdk.Context, contractAddress, caller, recipient sdk.AccAddress, authZ AuthorizationPolicy) error {
+	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "delete")
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not delete contract")
+	}
+
+	var hookMsg deleteContractSudoMsg
+	hookMsg.DeleteContract.Recipient = recipient.String()
+	hookMsgBz, err := json.Marshal(hookMsg)
+	if err != nil {
+		return sdkerrors.Wrap(err, "delete contract sudo msg")
+	}
+	em := sdk.NewEventManager()
+	cacheCtx, commit := ctx.CacheContext()
+	if _, err := k.Sudo(cacheCtx.WithEventManager(em), contractAddress, hookMsgBz); err != nil {
+		k.Logger(ctx).Debug("delete contract hook", "contract", contractAddress.String(), "error", err.Error())
+	} else {
+		commit()
+		ctx.EventManager().EmitEvents(em.Events())
+	}
+
+	if err := k.cancelContractCallbacks(ctx, contractAddress); err != nil {
+		return err
+	}
+	if err := k.releaseStorageDeposit(ctx, contractAddress); err != nil {
+		return err
+	}
+	if balance := k.bankKeeper.GetAllBalances(ctx, contractAddress); !balance.IsZero() {
+		if err := k.bank.TransferCoins(ctx, contractAddress, recipient, balance); err != nil {
+			return sdkerrors.Wrap(err, "transfer remaining balance")
+		}
+	}
+
+	if contractInfo.IBCPortID != "" {
+		k.abortAsyncAckPackets(ctx, contractInfo.IBCPortID)
+	}
+	if err := k.releaseContractIbcPorts(ctx, contractAddress, contractInfo.IBCPortID); err != nil {
+		return sdkerrors.Wrap(err, "release ibc ports")
+	}
+
+	// remove secondary indexes before the history is gone
+	k.removeFromContractCodeSecondaryIndex(ctx, contractAddress, k.getLastContractCodeHistoryEntry(ctx, contractAddress))
+	creator, err := sdk.AccAddressFromBech32(contractInfo.Creator)
+	if err != nil {
+		return sdkerrors.Wrap(err, "creator")
+	}
+	k.removeFromContractCreatorSecondaryIndex(ctx, creator, contractInfo.Created, contractAddress)
+	k.removeFromContractAdminSecondaryIndex(ctx, contractInfo.AdminAddr(), contractAddress)
+	k.removeFromContractLabelSecondaryIndex(ctx, creator, contractInfo.Label, contractAddress)
+
+	if k.stateChangeJournalKey != nil {
+		k.IterateContractState(ctx, contractAddress, func(key, _ []byte) bool {
+			k.journalStateChange(ctx, types.ContractStateChange{
+				ContractAddress: contractAddress.String(),
+				Type:            types.ContractStateChangeTypeStorage,
+				Key:             append([]byte{}, key...),
+				Delete:          true,
+			})
+			return false
+		})
+	}
+	store := ctx.KVStore(k.storeKey)
+	for _, p := range [][]byte{types.GetContractStorePrefix(contractAddress), types.GetContractCodeHistoryElementPrefix(contractAddress)} {
+		deleteAllWithPrefix(store, p)
+	}
+	store.Delete(types.GetContractStorageStatsKey(contractAddress))
+	store.Delete(types.GetContractAddressKey(contractAddress))
+	k.journalStateChange(ctx, types.ContractStateChange{
+		ContractAddress: contractAddress.String(),
+		Type:            types.ContractStateChangeTypeInfo,
+		Delete:          true,
+	})
+	if job := k.GetCronJob(ctx, contractAddress); job != nil {
+		k.removeCronJob(ctx, contractAddress, *job)
+	}
+	k.removeContractIBCTransferCallbacks(ctx, contractAddress)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDeleteContract,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+	))
+	return nil
+}
+
+// deleteAllWithPrefix removes all entries of the given prefix from the store
+func deleteAllWithPrefix(store sdk.KVStore, keyPrefix []byte) {
+	prefixStore := prefix.NewStore(store, keyPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	// delete after iterating to not modify the store while the iterator is open
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
+
 func (k Keeper) appendToContractHistory(ctx sdk.Context, contractAddr sdk.AccAddress, newEntries ...types.ContractCodeHistoryEntry) {
 	store := ctx.KVStore(k.storeKey)
 	// find last element position
@@ -625,27 +1121,41 @@ func (k Keeper) GetContractHistory(ctx sdk.Context, contractAddr sdk.AccAddress)
 	return r
 }
 
-// getLastContractHistoryEntry returns the last element from history. To be used internally only as it panics when none exists
-func (k Keeper) getLastContractHistoryEntry(ctx sdk.Context, contractAddr sdk.AccAddress) types.ContractCodeHistoryEntry {
+// getLastContractCodeHistoryEntry returns the last element from history that set the contract code, skipping admin
+// changes. To be used internally only as it panics when none exists
+func (k Keeper) getLastContractCodeHistoryEntry(ctx sdk.Context, contractAddr sdk.AccAddress) types.ContractCodeHistoryEntry {
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractCodeHistoryElementPrefix(contractAddr))
 	iter := prefixStore.ReverseIterator(nil, nil)
 	defer iter.Close()
 
-	var r types.ContractCodeHistoryEntry
-	if !iter.Valid() {
-		// all contracts have a history
-		panic(fmt.Sprintf("no history for %s", contractAddr.String()))
+	for ; iter.Valid(); iter.Next() {
+		var r types.ContractCodeHistoryEntry
+		k.cdc.MustUnmarshal(iter.Value(), &r)
+		if r.Operation.IsCodeUpdate() {
+			return r
+		}
 	}
-	k.cdc.MustUnmarshal(iter.Value(), &r)
-	return r
+	// all contracts have a history
+	panic(fmt.Sprintf("no history for %s", contractAddr.String()))
+}
+
+// historySender returns the address to record as sender of a history entry. Governance operations are recorded with
+// the gov module account.
+func historySender(caller sdk.AccAddress, authZ AuthorizationPolicy) string {
+	if _, ok := authZ.(GovAuthorizationPolicy); ok || caller.Empty() {
+		return authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	}
+	return caller.String()
 }
 
 // QuerySmart queries the smart contract itself.
-func (k Keeper) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+func (k Keeper) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) (_ []byte, err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "query-smart")
+	ctx, trace := k.startCallTrace(ctx, "query", contractAddr)
+	defer func() { trace.end(ctx, err, recover()) }()
 
 	// checks and increase query stack size
-	ctx, err := checkAndIncreaseQueryStackSize(ctx, k.maxQueryStackSize)
+	ctx, err = checkAndIncreaseQueryStackSize(ctx, k.maxQueryStackSize)
 	if err != nil {
 		return nil, err
 	}
@@ -705,25 +1215,41 @@ func (k Keeper) QueryRaw(ctx sdk.Context, contractAddress sdk.AccAddress, key []
 	return prefixStore.Get(key)
 }
 
-func (k Keeper) contractInstance(ctx sdk.Context, contractAddress sdk.AccAddress) (types.ContractInfo, types.CodeInfo, prefix.Store, error) {
+func (k Keeper) contractInstance(ctx sdk.Context, contractAddress sdk.AccAddress) (types.ContractInfo, types.CodeInfo, *accountingStore, error) {
 	store := ctx.KVStore(k.storeKey)
 
 	contractBz := store.Get(types.GetContractAddressKey(contractAddress))
 	if contractBz == nil {
-		return types.ContractInfo{}, types.CodeInfo{}, prefix.Store{}, sdkerrors.Wrap(types.ErrNotFound, "contract")
+		return types.ContractInfo{}, types.CodeInfo{}, nil, sdkerrors.Wrap(types.ErrNotFound, "contract")
 	}
 	var contractInfo types.ContractInfo
 	k.cdc.MustUnmarshal(contractBz, &contractInfo)
 
 	codeInfoBz := store.Get(types.GetCodeKey(contractInfo.CodeID))
 	if codeInfoBz == nil {
-		return contractInfo, types.CodeInfo{}, prefix.Store{}, sdkerrors.Wrap(types.ErrNotFound, "code info")
+		return contractInfo, types.CodeInfo{}, nil, sdkerrors.Wrap(types.ErrNotFound, "code info")
 	}
 	var codeInfo types.CodeInfo
 	k.cdc.MustUnmarshal(codeInfoBz, &codeInfo)
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
-	return contractInfo, codeInfo, prefixStore, nil
+	return contractInfo, codeInfo, k.contractStore(ctx, contractAddress), nil
+}
+
+// contractStore returns the accounting store of the contract state that journals the writes when state streaming is
+// enabled
+func (k Keeper) contractStore(ctx sdk.Context, contractAddress sdk.AccAddress) *accountingStore {
+	s := newAccountingStore(ctx.KVStore(k.storeKey), ctx.MultiStore().GetKVStore(k.storeKey), contractAddress)
+	if k.stateChangeJournalKey != nil {
+		s.onWrite = func(key, value []byte, delete bool) {
+			k.journalStateChange(ctx, types.ContractStateChange{
+				ContractAddress: contractAddress.String(),
+				Type:            types.ContractStateChangeTypeStorage,
+				Key:             key,
+				Value:           value,
+				Delete:          delete,
+			})
+		}
+	}
+	return s
 }
 
 func (k Keeper) GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *types.ContractInfo {
@@ -745,7 +1271,13 @@ func (k Keeper) HasContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress)
 // storeContractInfo persists the ContractInfo. No secondary index updated here.
 func (k Keeper) storeContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress, contract *types.ContractInfo) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.GetContractAddressKey(contractAddress), k.cdc.MustMarshal(contract))
+	bz := k.cdc.MustMarshal(contract)
+	store.Set(types.GetContractAddressKey(contractAddress), bz)
+	k.journalStateChange(ctx, types.ContractStateChange{
+		ContractAddress: contractAddress.String(),
+		Type:            types.ContractStateChangeTypeInfo,
+		Value:           bz,
+	})
 }
 
 func (k Keeper) IterateContractInfo(ctx sdk.Context, cb func(sdk.AccAddress, types.ContractInfo) bool) {
@@ -789,7 +1321,14 @@ func (k Keeper) importContractState(ctx sdk.Context, contractAddress sdk.AccAddr
 			return sdkerrors.Wrapf(types.ErrDuplicate, "duplicate key: %x", model.Key)
 		}
 		prefixStore.Set(model.Key, model.Value)
-	}
+		k.journalStateChange(ctx, types.ContractStateChange{
+			ContractAddress: contractAddress.String(),
+			Type:            types.ContractStateChangeTypeStorage,
+			Key:             model.Key,
+			Value:           model.Value,
+		})
+	}
+	k.setContractStorageStats(ctx, contractAddress, k.calculateContractStorageStats(ctx, contractAddress))
 	return nil
 }
 
@@ -853,7 +1392,7 @@ func (k Keeper) pinCode(ctx sdk.Context, codeID uint64) error {
 		types.EventTypePinCode,
 		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
 	))
-	return nil
+	return k.emitTypedEvent(ctx, &types.EventCodePinned{CodeID: codeID})
 }
 
 // UnpinCode removes the wasm contract from wasmvm cache
@@ -873,7 +1412,7 @@ func (k Keeper) unpinCode(ctx sdk.Context, codeID uint64) error {
 		types.EventTypeUnpinCode,
 		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
 	))
-	return nil
+	return k.emitTypedEvent(ctx, &types.EventCodeUnpinned{CodeID: codeID})
 }
 
 // IsPinnedCode returns true when codeID is pinned in wasmvm cache
@@ -936,9 +1475,128 @@ func (k Keeper) setAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAd
 		evt.Attributes = append(evt.Attributes, attr.ToKVPair())
 	}
 	ctx.EventManager().EmitEvent(evt)
+	return k.emitTypedEvent(ctx, &types.EventCodeAccessConfigUpdated{CodeID: codeID, InstantiatePermission: newConfig})
+}
+
+// setCodeMetadata updates the source and builder of a code id that allow to verify the wasm byte code against its
+// sources.
+func (k Keeper) setCodeMetadata(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, source, builder string, authz AuthorizationPolicy) error {
+	info := k.GetCodeInfo(ctx, codeID)
+	if info == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "code info")
+	}
+	creator, err := sdk.AccAddressFromBech32(info.Creator)
+	if err != nil {
+		return sdkerrors.Wrap(err, "creator")
+	}
+	if !authz.CanModifyCodeMetadata(creator, caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not modify code metadata")
+	}
+	if err := types.ValidateCodeMetadata(source, builder); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalid, err.Error())
+	}
+
+	info.Source = source
+	info.Builder = builder
+	k.storeCodeInfo(ctx, codeID, *info)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateCodeMetadata,
+		sdk.NewAttribute(types.AttributeKeySource, source),
+		sdk.NewAttribute(types.AttributeKeyBuilder, builder),
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+	))
 	return nil
 }
 
+// initCodeMetadata sets the source and builder of a code that was stored by the caller and has no metadata, yet. It
+// is a noop for an existing code that was reused on upload so that the metadata of another creator or a previous
+// upload is not overwritten.
+func (k Keeper) initCodeMetadata(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, source, builder string, authz AuthorizationPolicy) error {
+	info := k.GetCodeInfo(ctx, codeID)
+	if info == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "code info")
+	}
+	if info.Creator != caller.String() || info.Source != "" || info.Builder != "" {
+		return nil
+	}
+	return k.setCodeMetadata(ctx, codeID, caller, source, builder, authz)
+}
+
+// removeCode deletes the code info of a code id that is not used by any contract and not pinned. When no other code
+// shares the same checksum, the wasm code is scheduled for removal from the wasmvm cache at the end of the block.
+func (k Keeper) removeCode(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, authz AuthorizationPolicy) error {
+	codeInfo := k.GetCodeInfo(ctx, codeID)
+	if codeInfo == nil {
+		return sdkerrors.Wrap(types.ErrNotFound, "code info")
+	}
+	creator, err := sdk.AccAddressFromBech32(codeInfo.Creator)
+	if err != nil {
+		return sdkerrors.Wrap(err, "creator")
+	}
+	if !authz.CanRemoveCode(creator, caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "can not remove code")
+	}
+	if k.IsPinnedCode(ctx, codeID) {
+		return sdkerrors.Wrap(types.ErrInvalid, "code is pinned")
+	}
+	var used bool
+	k.IterateContractsByCode(ctx, codeID, func(sdk.AccAddress) bool {
+		used = true
+		return true
+	})
+	if used {
+		return sdkerrors.Wrap(types.ErrInvalid, "code is used by contracts")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCodeKey(codeID))
+	store.Delete(types.GetCodeIDByChecksumKey(codeInfo.CodeHash, codeID))
+	if !k.isChecksumReferenced(ctx, codeInfo.CodeHash) {
+		// the wasmvm cache is not part of the state so that the files must not be removed before the tx is committed
+		store.Set(types.GetPendingCodeRemovalKey(codeInfo.CodeHash), []byte{1})
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRemoveCode,
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+		sdk.NewAttribute(types.AttributeKeyChecksum, hex.EncodeToString(codeInfo.CodeHash)),
+	))
+	return nil
+}
+
+// isChecksumReferenced returns true when any code info is stored with the given checksum
+func (k Keeper) isChecksumReferenced(ctx sdk.Context, checksum []byte) bool {
+	var found bool
+	k.IterateCodeIDsByChecksum(ctx, checksum, func(uint64) bool {
+		found = true
+		return true
+	})
+	return found
+}
+
+// PruneRemovedCodes removes the wasm code of all checksums that were scheduled by a code removal from the wasmvm
+// cache. A checksum that was uploaded again in the meantime is kept.
+func (k Keeper) PruneRemovedCodes(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	var checksums [][]byte
+	iter := prefix.NewStore(store, types.PendingCodeRemovalPrefix).Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		checksums = append(checksums, iter.Key())
+	}
+	iter.Close()
+
+	for _, checksum := range checksums {
+		store.Delete(types.GetPendingCodeRemovalKey(checksum))
+		if k.isChecksumReferenced(ctx, checksum) {
+			continue
+		}
+		if err := k.wasmVM.RemoveCode(checksum); err != nil {
+			// the cache is not part of the consensus state so that this must not halt the chain
+			k.Logger(ctx).Error("remove code from cache", "checksum", hex.EncodeToString(checksum), "error", err.Error())
+		}
+	}
+}
+
 // handleContractResponse processes the contract response data by emitting events and sending sub-/messages.
 func (k *Keeper) handleContractResponse(
 	ctx sdk.Context,
@@ -966,6 +1624,11 @@ func (k *Keeper) handleContractResponse(
 		}
 		ctx.EventManager().EmitEvents(customEvents)
 	}
+	if r, ok := ctx.Context().Value(contextKeyDispatchRecorder).(*dispatchRecorder); ok {
+		if err := r.record(contractAddr, msgs); err != nil {
+			return nil, err
+		}
+	}
 	return k.wasmVMResponseHandler.Handle(ctx, contractAddr, ibcPort, msgs, data)
 }
 
@@ -982,6 +1645,7 @@ func (k Keeper) runtimeGasForContract(ctx sdk.Context) uint64 {
 
 func (k Keeper) consumeRuntimeGas(ctx sdk.Context, gas uint64) {
 	consumed := k.gasRegister.FromWasmVMGas(gas)
+	currentCallTrace(ctx).addWasmGas(consumed)
 	ctx.GasMeter().ConsumeGas(consumed, "wasm contract")
 	// throw OutOfGas error if we ran out (got exactly to zero due to better limit enforcing)
 	if ctx.GasMeter().IsOutOfGas() {
@@ -1022,7 +1686,7 @@ func (k Keeper) importAutoIncrementID(ctx sdk.Context, lastIDKey []byte, val uin
 	return nil
 }
 
-func (k Keeper) importContract(ctx sdk.Context, contractAddr sdk.AccAddress, c *types.ContractInfo, state []types.Model, entries []types.ContractCodeHistoryEntry) error {
+func (k Keeper) importContract(ctx sdk.Context, contractAddr sdk.AccAddress, c *types.ContractInfo, state []types.Model, entries []types.ContractCodeHistoryEntry, storageDeposit sdk.Coins) error {
 	if !k.containsCodeInfo(ctx, c.CodeID) {
 		return sdkerrors.Wrapf(types.ErrNotFound, "code id: %d", c.CodeID)
 	}
@@ -1035,11 +1699,27 @@ func (k Keeper) importContract(ctx sdk.Context, contractAddr sdk.AccAddress, c *
 		return err
 	}
 
+	lastCodeEntry := entries[0]
+	for _, e := range entries {
+		if e.Operation.IsCodeUpdate() {
+			lastCodeEntry = e
+		}
+	}
 	k.appendToContractHistory(ctx, contractAddr, entries...)
 	k.storeContractInfo(ctx, contractAddr, c)
-	k.addToContractCodeSecondaryIndex(ctx, contractAddr, entries[len(entries)-1])
+	k.addToContractCodeSecondaryIndex(ctx, contractAddr, lastCodeEntry)
 	k.addToContractCreatorSecondaryIndex(ctx, creatorAddress, entries[0].Updated, contractAddr)
-	return k.importContractState(ctx, contractAddr, state)
+	k.addToContractAdminSecondaryIndex(ctx, c.AdminAddr(), contractAddr)
+	k.addToContractLabelSecondaryIndex(ctx, creatorAddress, c.Label, contractAddr)
+	if err := k.importContractState(ctx, contractAddr, state); err != nil {
+		return err
+	}
+	if !storageDeposit.IsZero() {
+		stats := k.GetContractStorageStats(ctx, contractAddr)
+		stats.Deposit = storageDeposit
+		k.setContractStorageStats(ctx, contractAddr, stats)
+	}
+	return nil
 }
 
 func (k Keeper) newQueryHandler(ctx sdk.Context, contractAddress sdk.AccAddress) QueryHandler {
@@ -1077,7 +1757,16 @@ func moduleLogger(ctx sdk.Context) log.Logger {
 
 // Querier creates a new grpc querier instance
 func Querier(k *Keeper) *grpcQuerier { //nolint:revive
-	return NewGrpcQuerier(k.cdc, k.storeKey, k, k.queryGasLimit)
+	q := NewGrpcQuerier(k.cdc, k.storeKey, k, k.queryGasLimit)
+	q.simulator = k
+	q.eventBroker = k.contractEvents
+	return q
+}
+
+// ContractEventBroker returns the broker for the contract event subscriptions or nil when they are disabled.
+// The broker must be registered with the BaseApp as streaming service to receive the events.
+func (k Keeper) ContractEventBroker() *ContractEventBroker {
+	return k.contractEvents
 }
 
 // QueryGasLimit returns the gas limit for smart queries.
@@ -1085,6 +1774,34 @@ func (k Keeper) QueryGasLimit() sdk.Gas {
 	return k.queryGasLimit
 }
 
+// SimulationGasLimit returns the gas limit for contract simulation queries.
+// When not configured, the consensus max block gas is used or the smart query gas limit as fallback.
+func (k Keeper) SimulationGasLimit(ctx sdk.Context) sdk.Gas {
+	if k.simulationGasLimit != 0 {
+		return k.simulationGasLimit
+	}
+	if cp := ctx.ConsensusParams(); cp != nil && cp.Block != nil && cp.Block.MaxGas > 0 {
+		return uint64(cp.Block.MaxGas)
+	}
+	return k.queryGasLimit
+}
+
+// dispatchRecorder collects the messages dispatched by contracts
+type dispatchRecorder struct {
+	msgs []types.DispatchedMsg
+}
+
+func (r *dispatchRecorder) record(contractAddr sdk.AccAddress, msgs []wasmvmtypes.SubMsg) error {
+	for _, m := range msgs {
+		bz, err := json.Marshal(m)
+		if err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		}
+		r.msgs = append(r.msgs, types.DispatchedMsg{ContractAddress: contractAddr.String(), Msg: bz})
+	}
+	return nil
+}
+
 // BankCoinTransferrer replicates the cosmos-sdk behaviour as in
 // https://github.com/cosmos/cosmos-sdk/blob/v0.41.4/x/bank/keeper/msg_server.go#L26
 type BankCoinTransferrer struct {
@@ -48,6 +48,7 @@ func NewKeeper(
 		cdc:                  cdc,
 		wasmVM:               wasmer,
 		accountKeeper:        accountKeeper,
+		bankKeeper:           bankKeeper,
 		bank:                 NewBankCoinTransferrer(bankKeeper),
 		accountPruner:        NewVestingCoinBurner(bankKeeper),
 		portKeeper:           portKeeper,
@@ -58,12 +59,38 @@ func NewKeeper(
 		gasRegister:          NewDefaultWasmGasRegister(),
 		maxQueryStackSize:    types.DefaultMaxQueryStackSize,
 		acceptedAccountTypes: defaultAcceptedAccountTypes,
+		debugMode:            wasmConfig.ContractDebugMode,
+		emitTypedEvents:      wasmConfig.EmitTypedEvents,
+		ics4Wrapper:          channelKeeper,
+		channelKeeper:        channelKeeper,
+	}
+	if wasmConfig.SimulationGasLimit != nil {
+		keeper.simulationGasLimit = *wasmConfig.SimulationGasLimit
+	}
+	if wasmConfig.MaxContractEventSubscriptions != 0 {
+		keeper.contractEvents = NewContractEventBroker(keeper, wasmConfig.MaxContractEventSubscriptions)
 	}
 	keeper.wasmVMQueryHandler = DefaultQueryPlugins(bankKeeper, stakingKeeper, distKeeper, channelKeeper, keeper)
 	for _, o := range opts {
 		o.apply(keeper)
 	}
 	// not updateable, yet
-	keeper.wasmVMResponseHandler = NewDefaultWasmVMContractResponseHandler(NewMessageDispatcher(keeper.messenger, keeper))
+	// the circuit breaker wraps any custom messenger so that contracts can not bypass disabled operations
+	handlers := []Messenger{keeper.messenger}
+	if keeper.ibcAsyncAcks {
+		handlers = append([]Messenger{NewIBCAsyncAckMessageHandler(keeper)}, handlers...)
+	}
+	if keeper.icaControllerKeeper != nil {
+		handlers = append([]Messenger{NewICAControllerMessageHandler(keeper)}, handlers...)
+	}
+	if keeper.scheduledCallbacks {
+		handlers = append([]Messenger{NewCallbackMessageHandler(keeper)}, handlers...)
+	}
+	var messenger Messenger = NewCircuitBreakerMessageHandler(NewMessageHandlerChain(handlers[0], handlers[1:]...), keeper)
+	if keeper.ibcTransferCallbackGasLimit != 0 {
+		// converts the custom transfer message before the circuit breaker so that it is checked as ibc send
+		messenger = NewIBCTransferCallbackMessageHandler(messenger, keeper)
+	}
+	keeper.wasmVMResponseHandler = NewDefaultWasmVMContractResponseHandler(NewMessageDispatcher(messenger, keeper))
 	return *keeper
 }
@@ -3,10 +3,12 @@ package keeper
 import (
 	"bytes"
 	_ "embed"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -25,7 +27,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v4/modules/core/exported"
 	fuzz "github.com/google/gofuzz"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -61,6 +68,10 @@ func TestCreateSuccess(t *testing.T) {
 	storedCode, err := keepers.WasmKeeper.GetByteCode(ctx, contractID)
 	require.NoError(t, err)
 	require.Equal(t, hackatomWasm, storedCode)
+	// and metadata stored
+	codeInfo := keepers.WasmKeeper.GetCodeInfo(ctx, contractID)
+	assert.Equal(t, uint64(len(hackatomWasm)), codeInfo.WasmSize)
+	assert.Equal(t, types.NewAbsoluteTxPosition(ctx), codeInfo.Created)
 	// and events emitted
 	codeHash := strings.ToLower("beb3de5e9b93b52e514c74ce87ccddb594b9bcd33b7f1af1bb6da63fc883917b")
 	exp := sdk.Events{sdk.NewEvent("store_code", sdk.NewAttribute("code_checksum", codeHash), sdk.NewAttribute("code_id", "1"))}
@@ -296,6 +307,85 @@ func TestCreateDuplicate(t *testing.T) {
 	require.Equal(t, hackatomWasm, storedCode)
 }
 
+func TestCreateOrReuse(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	creator := RandomAccountAddress(t)
+	myAccessConfig := types.AccessTypeOnlyAddress.With(creator)
+	existingID, checksum, err := keepers.ContractKeeper.Create(parentCtx, creator, hackatomWasm, &myAccessConfig)
+	require.NoError(t, err)
+	reflectWasm, err := os.ReadFile("./testdata/reflect.wasm")
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		wasmCode     []byte
+		accessConfig *types.AccessConfig
+		enforce      bool
+		reuse        bool
+		expReuse     bool
+	}{
+		"same code and permission": {
+			wasmCode:     hackatomWasm,
+			accessConfig: &myAccessConfig,
+			reuse:        true,
+			expReuse:     true,
+		},
+		"same code and permission enforced by param": {
+			wasmCode:     hackatomWasm,
+			accessConfig: &myAccessConfig,
+			enforce:      true,
+			expReuse:     true,
+		},
+		"same code and permission not requested": {
+			wasmCode:     hackatomWasm,
+			accessConfig: &myAccessConfig,
+		},
+		"different permission": {
+			wasmCode:     hackatomWasm,
+			accessConfig: &types.AllowNobody,
+			reuse:        true,
+		},
+		"different code": {
+			wasmCode:     reflectWasm,
+			accessConfig: &myAccessConfig,
+			reuse:        true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+			params := k.GetParams(ctx)
+			params.EnforceCodeReuse = spec.enforce
+			k.SetParams(ctx, params)
+
+			create := keepers.ContractKeeper.Create
+			if spec.reuse {
+				create = keepers.ContractKeeper.CreateOrReuse
+			}
+
+			// when
+			gotCodeID, gotChecksum, gotErr := create(ctx, creator, spec.wasmCode, spec.accessConfig)
+
+			// then
+			require.NoError(t, gotErr)
+			if !spec.expReuse {
+				assert.NotEqual(t, existingID, gotCodeID)
+				assert.Len(t, em.Events(), 1)
+				return
+			}
+			assert.Equal(t, existingID, gotCodeID)
+			assert.Equal(t, checksum, gotChecksum)
+			// and the store code event is emitted with the existing code id
+			require.Len(t, em.Events(), 1)
+			assert.Equal(t, types.EventTypeStoreCode, em.Events()[0].Type)
+			assert.Contains(t, em.Events()[0].Attributes, abci.EventAttribute{Key: []byte(types.AttributeKeyCodeID), Value: []byte(strconv.FormatUint(existingID, 10))})
+			assert.False(t, k.containsCodeInfo(ctx, existingID+1))
+		})
+	}
+}
+
 func TestCreateWithSimulation(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 
@@ -367,6 +457,8 @@ func TestCreateWithGzippedPayload(t *testing.T) {
 	storedCode, err := keepers.WasmKeeper.GetByteCode(ctx, contractID)
 	require.NoError(t, err)
 	require.Equal(t, hackatomWasm, storedCode)
+	// and size of the uncompressed code stored
+	assert.Equal(t, uint64(len(hackatomWasm)), keepers.WasmKeeper.GetCodeInfo(ctx, contractID).WasmSize)
 }
 
 func TestCreateWithBrokenGzippedPayload(t *testing.T) {
@@ -412,7 +504,7 @@ func TestInstantiate(t *testing.T) {
 
 	gasAfter := ctx.GasMeter().GasConsumed()
 	if types.EnableGasVerification {
-		require.Equal(t, uint64(0x1a7b6), gasAfter-gasBefore)
+		require.Equal(t, uint64(0x1d51b), gasAfter-gasBefore)
 	}
 
 	// ensure it is stored properly
@@ -427,6 +519,7 @@ func TestInstantiate(t *testing.T) {
 		CodeID:    example.CodeID,
 		Updated:   types.NewAbsoluteTxPosition(ctx),
 		Msg:       initMsgBz,
+		Sender:    creator.String(),
 	}}
 	assert.Equal(t, exp, keepers.WasmKeeper.GetContractHistory(ctx, gotContractAddr))
 
@@ -440,6 +533,151 @@ func TestInstantiate(t *testing.T) {
 	assert.Equal(t, expEvt, em.Events())
 }
 
+func TestInstantiateWithUniqueLabels(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := StoreHackatomExampleContract(t, parentCtx, keepers)
+	initMsgBz := HackatomExampleInitMsg{
+		Verifier:    RandomAccountAddress(t),
+		Beneficiary: RandomAccountAddress(t),
+	}.GetBytes(t)
+	_, _, err := keepers.ContractKeeper.Instantiate(parentCtx, example.CodeID, example.CreatorAddr, nil, initMsgBz, "my label", nil)
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		enforce bool
+		creator sdk.AccAddress
+		label   string
+		expErr  *sdkerrors.Error
+	}{
+		"same creator and label": {
+			enforce: true,
+			creator: example.CreatorAddr,
+			label:   "my label",
+			expErr:  types.ErrDuplicate,
+		},
+		"same creator and label not enforced": {
+			creator: example.CreatorAddr,
+			label:   "my label",
+		},
+		"same creator with label prefix": {
+			enforce: true,
+			creator: example.CreatorAddr,
+			label:   "my",
+		},
+		"same creator with label extension": {
+			enforce: true,
+			creator: example.CreatorAddr,
+			label:   "my label 2",
+		},
+		"other creator and same label": {
+			enforce: true,
+			creator: RandomAccountAddress(t),
+			label:   "my label",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			params := k.GetParams(ctx)
+			params.EnforceUniqueLabels = spec.enforce
+			k.SetParams(ctx, params)
+			k.SyncContractLabelIndex(ctx)
+
+			// when
+			gotAddr, _, gotErr := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, spec.creator, nil, initMsgBz, spec.label, nil)
+
+			// then
+			require.True(t, spec.expErr.Is(gotErr), "expected %v but got %+v", spec.expErr, gotErr)
+			if spec.expErr != nil {
+				return
+			}
+			var found bool
+			k.IterateContractsByLabel(ctx, spec.creator, spec.label, func(label string, address sdk.AccAddress) bool {
+				found = label == spec.label && address.Equals(gotAddr)
+				return found
+			})
+			// contracts are indexed by label per creator when unique labels are enforced
+			assert.Equal(t, spec.enforce, found)
+		})
+	}
+}
+
+func TestSyncContractLabelIndex(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	label := k.GetContractInfo(parentCtx, example.Contract).Label
+	creatorKey := types.GetContractByCreatorLabelSecondaryIndexKey(example.CreatorAddr, label, example.Contract)
+	globalKey := types.GetContractByLabelSecondaryIndexKey(label, example.Contract)
+
+	specs := map[string]struct {
+		srcScope     types.LabelIndexScope
+		scope        types.LabelIndexScope
+		enforce      bool
+		expScope     types.LabelIndexScope
+		expIndexed   bool
+		expGlobalIdx bool
+	}{
+		"none": {
+			expScope: types.LabelIndexScopeNone,
+		},
+		"none to creator": {
+			scope:      types.LabelIndexScopeCreator,
+			expScope:   types.LabelIndexScopeCreator,
+			expIndexed: true,
+		},
+		"none to global": {
+			scope:        types.LabelIndexScopeGlobal,
+			expScope:     types.LabelIndexScopeGlobal,
+			expIndexed:   true,
+			expGlobalIdx: true,
+		},
+		"none with unique labels enforced": {
+			enforce:    true,
+			expScope:   types.LabelIndexScopeCreator,
+			expIndexed: true,
+		},
+		"global to creator": {
+			srcScope:   types.LabelIndexScopeGlobal,
+			scope:      types.LabelIndexScopeCreator,
+			expScope:   types.LabelIndexScopeCreator,
+			expIndexed: true,
+		},
+		"global to none": {
+			srcScope: types.LabelIndexScopeGlobal,
+			expScope: types.LabelIndexScopeNone,
+		},
+		"global to global": {
+			srcScope:     types.LabelIndexScopeGlobal,
+			scope:        types.LabelIndexScopeGlobal,
+			expScope:     types.LabelIndexScopeGlobal,
+			expIndexed:   true,
+			expGlobalIdx: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			params := k.GetParams(ctx)
+			params.LabelIndexScope = spec.srcScope
+			k.SetParams(ctx, params)
+			k.SyncContractLabelIndex(ctx)
+			params.LabelIndexScope, params.EnforceUniqueLabels = spec.scope, spec.enforce
+			k.SetParams(ctx, params)
+
+			// when
+			k.SyncContractLabelIndex(ctx)
+
+			// then
+			assert.Equal(t, spec.expScope, k.GetLabelIndexScope(ctx))
+			store := ctx.KVStore(k.storeKey)
+			assert.Equal(t, spec.expIndexed, store.Has(creatorKey))
+			assert.Equal(t, spec.expGlobalIdx, store.Has(globalKey))
+		})
+	}
+}
+
 func TestInstantiateWithDeposit(t *testing.T) {
 	var (
 		bob  = bytes.Repeat([]byte{1}, types.SDKAddrLen)
@@ -856,7 +1094,7 @@ func TestExecute(t *testing.T) {
 	// make sure gas is properly deducted from ctx
 	gasAfter := ctx.GasMeter().GasConsumed()
 	if types.EnableGasVerification {
-		require.Equal(t, uint64(0x17d7f), gasAfter-gasBefore)
+		require.Equal(t, uint64(0x17d9d), gasAfter-gasBefore)
 	}
 	// ensure bob now exists and got both payments released
 	bobAcct = accKeeper.GetAccount(ctx, bob)
@@ -1278,11 +1516,15 @@ func TestMigrate(t *testing.T) {
 				CodeID:    spec.fromCodeID,
 				Updated:   types.NewAbsoluteTxPosition(ctx),
 				Msg:       initMsgBz,
+				Sender:    creator.String(),
+				Admin:     spec.admin.String(),
 			}, {
 				Operation: types.ContractCodeHistoryOperationTypeMigrate,
 				CodeID:    spec.toCodeID,
 				Updated:   types.NewAbsoluteTxPosition(ctx),
 				Msg:       spec.migrateMsg,
+				Sender:    spec.caller.String(),
+				Admin:     spec.admin.String(),
 			}}
 			assert.Equal(t, expHistory, keepers.WasmKeeper.GetContractHistory(ctx, contractAddr))
 
@@ -1579,6 +1821,17 @@ func prettyEvents(t *testing.T, events sdk.Events) string {
 	return string(mustMarshal(t, r))
 }
 
+// filterEventsByType returns only the events of the given type
+func filterEventsByType(events sdk.Events, eventType string) sdk.Events {
+	var r sdk.Events
+	for _, e := range events {
+		if e.Type == eventType {
+			r = append(r, e)
+		}
+	}
+	return r
+}
+
 func mustMarshal(t *testing.T, r interface{}) []byte {
 	t.Helper()
 	bz, err := json.Marshal(r)
@@ -1721,6 +1974,401 @@ func TestClearContractAdmin(t *testing.T) {
 	}
 }
 
+func TestDeleteContract(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	k.setIBCTransferCallback(parentCtx, "channel-1", 7, example.Contract)
+	fred := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		contractAddr sdk.AccAddress
+		caller       sdk.AccAddress
+		expErr       *sdkerrors.Error
+	}{
+		"all good when called by proper admin": {
+			contractAddr: example.Contract,
+			caller:       example.CreatorAddr,
+		},
+		"prevent deletion from non admin address": {
+			contractAddr: example.Contract,
+			caller:       fred,
+			expErr:       sdkerrors.ErrUnauthorized,
+		},
+		"fail with non existing contract addr": {
+			contractAddr: RandomAccountAddress(t),
+			caller:       example.CreatorAddr,
+			expErr:       sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			recipient := RandomAccountAddress(t)
+			// when
+			err := keepers.ContractKeeper.DeleteContract(ctx, spec.contractAddr, spec.caller, recipient)
+			// then
+			require.True(t, spec.expErr.Is(err), "expected %v but got %+v", spec.expErr, err)
+			if spec.expErr != nil {
+				return
+			}
+			assert.Nil(t, k.GetContractInfo(ctx, example.Contract))
+			assert.Empty(t, k.GetContractHistory(ctx, example.Contract))
+			k.IterateContractState(ctx, example.Contract, func(key, value []byte) bool {
+				t.Fatalf("unexpected state entry: %X", key)
+				return true
+			})
+			k.IterateContractsByCode(ctx, example.CodeID, func(address sdk.AccAddress) bool {
+				t.Fatalf("unexpected contract in code index: %s", address)
+				return true
+			})
+			k.IterateContractsByCreator(ctx, example.CreatorAddr, func(address sdk.AccAddress) bool {
+				t.Fatalf("unexpected contract in creator index: %s", address)
+				return true
+			})
+			k.IterateContractsByAdmin(ctx, example.CreatorAddr, func(address sdk.AccAddress) bool {
+				t.Fatalf("unexpected contract in admin index: %s", address)
+				return true
+			})
+			k.IterateContractsByLabel(ctx, example.CreatorAddr, "", func(_ string, address sdk.AccAddress) bool {
+				t.Fatalf("unexpected contract in label index: %s", address)
+				return true
+			})
+			assert.Nil(t, k.GetIBCTransferCallback(ctx, "channel-1", 7))
+			k.IterateIBCTransferCallbacks(ctx, func(c types.IBCTransferCallback) bool {
+				t.Fatalf("unexpected ibc transfer callback: %s", c.String())
+				return true
+			})
+			assert.True(t, keepers.BankKeeper.GetAllBalances(ctx, example.Contract).IsZero())
+			assert.Equal(t, example.Deposit, keepers.BankKeeper.GetAllBalances(ctx, recipient))
+			assert.Equal(t, sdk.Events{sdk.NewEvent(
+				"delete_contract",
+				sdk.NewAttribute("_contract_address", example.Contract.String()),
+				sdk.NewAttribute("recipient", recipient.String()),
+			)}, filterEventsByType(ctx.EventManager().Events(), "delete_contract"))
+		})
+	}
+}
+
+func TestDeleteContractRemovesAllContractState(t *testing.T) {
+	ics4Wrapper := &wasmtesting.MockChannelKeeper{
+		WriteAcknowledgementFn: func(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
+			return nil
+		},
+	}
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithICS4Wrapper(ics4Wrapper))
+	k := keepers.WasmKeeper
+	params := k.GetParams(ctx)
+	params.LabelIndexScope = types.LabelIndexScopeGlobal
+	k.SetParams(ctx, params)
+	k.SyncContractLabelIndex(ctx)
+
+	// given a contract with an entry for every per contract feature
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	contractInfo := k.GetContractInfo(ctx, example.Contract)
+	contractInfo.IBCPortID = PortIDForContract(example.Contract)
+	k.storeContractInfo(ctx, example.Contract, contractInfo)
+	k.storeCallback(ctx, example.Contract, types.CallbackFixture(func(c *types.Callback) {
+		c.ID = k.autoIncrementID(ctx, types.KeyLastCallbackID)
+		c.Contract = example.Contract.String()
+		c.Fee = nil
+	}))
+	k.storeCronJob(ctx, example.Contract, types.CronJobFixture(func(j *types.CronJob) {
+		j.Contract = example.Contract.String()
+	}))
+	k.setIBCTransferCallback(ctx, "channel-1", 7, example.Contract)
+	_, err := keepers.ScopedWasmKeeper.NewCapability(ctx, host.ChannelCapabilityPath(contractInfo.IBCPortID, "channel-1"))
+	require.NoError(t, err)
+	k.setAsyncAckPacket(ctx, types.AsyncAckPacketFixture(func(p *channeltypes.Packet) {
+		p.DestinationPort = contractInfo.IBCPortID
+	}))
+
+	// when
+	err = keepers.ContractKeeper.DeleteContract(ctx, example.Contract, example.CreatorAddr, RandomAccountAddress(t))
+	require.NoError(t, err)
+
+	// then no key or value in the wasm store references the contract
+	bech32Addr := []byte(example.Contract.String())
+	iter := ctx.KVStore(k.storeKey).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		for _, bz := range [][]byte{iter.Key(), iter.Value()} {
+			if bytes.Contains(bz, example.Contract) || bytes.Contains(bz, bech32Addr) {
+				t.Errorf("contract state left behind: key %X", iter.Key())
+			}
+		}
+	}
+}
+
+func TestDeleteContractHook(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	var mock wasmtesting.MockWasmer
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	recipient := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		sudoFn     func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error)
+		expSudoEvt bool
+	}{
+		"hook executed": {
+			sudoFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+				assert.JSONEq(t, fmt.Sprintf(`{"delete_contract":{"recipient":%q}}`, recipient.String()), string(sudoMsg))
+				return &wasmvmtypes.Response{}, 1, nil
+			},
+			expSudoEvt: true,
+		},
+		"hook failure ignored": {
+			sudoFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+				store.Set([]byte("foo"), []byte("bar"))
+				return nil, 1, errors.New("unknown variant")
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			mock.SudoFn = spec.sudoFn
+			// when
+			err := keepers.ContractKeeper.DeleteContract(ctx, example.Contract, example.CreatorAddr, recipient)
+			// then
+			require.NoError(t, err)
+			assert.False(t, keepers.WasmKeeper.HasContractInfo(ctx, example.Contract))
+			assert.Nil(t, keepers.WasmKeeper.QueryRaw(ctx, example.Contract, []byte("foo")))
+			assert.Equal(t, spec.expSudoEvt, len(filterEventsByType(ctx.EventManager().Events(), "sudo")) != 0)
+		})
+	}
+}
+
+func TestRemoveCode(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	unused := StoreBurnerExampleContract(t, parentCtx, keepers)
+	used := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	pinned := StoreReflectContract(t, parentCtx, keepers)
+	require.NoError(t, keepers.ContractKeeper.PinCode(parentCtx, pinned.CodeID))
+
+	specs := map[string]struct {
+		codeID uint64
+		caller sdk.AccAddress
+		expErr *sdkerrors.Error
+	}{
+		"all good when called by creator": {
+			codeID: unused.CodeID,
+			caller: unused.CreatorAddr,
+		},
+		"prevent removal from non creator address": {
+			codeID: unused.CodeID,
+			caller: RandomAccountAddress(t),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"fail with non existing code id": {
+			codeID: 9999,
+			caller: unused.CreatorAddr,
+			expErr: types.ErrNotFound,
+		},
+		"fail when code is used by a contract": {
+			codeID: used.CodeID,
+			caller: used.CreatorAddr,
+			expErr: types.ErrInvalid,
+		},
+		"fail when code is pinned": {
+			codeID: pinned.CodeID,
+			caller: pinned.CreatorAddr,
+			expErr: types.ErrInvalid,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			// when
+			err := keepers.ContractKeeper.RemoveCode(ctx, spec.codeID, spec.caller)
+			// then
+			require.True(t, spec.expErr.Is(err), "expected %v but got %+v", spec.expErr, err)
+			if spec.expErr != nil {
+				return
+			}
+			assert.Nil(t, k.GetCodeInfo(ctx, spec.codeID))
+			assert.False(t, ctx.KVStore(k.storeKey).Has(types.GetCodeIDByChecksumKey(unused.Checksum, spec.codeID)))
+			assert.True(t, ctx.KVStore(k.storeKey).Has(types.GetPendingCodeRemovalKey(unused.Checksum)))
+			// not removed from the wasmvm cache before end of block
+			_, err = k.wasmVM.GetCode(unused.Checksum)
+			require.NoError(t, err)
+			assert.Equal(t, sdk.Events{sdk.NewEvent(
+				"remove_code",
+				sdk.NewAttribute("code_id", fmt.Sprintf("%d", spec.codeID)),
+				sdk.NewAttribute("code_checksum", hex.EncodeToString(unused.Checksum)),
+			)}, filterEventsByType(ctx.EventManager().Events(), "remove_code"))
+		})
+	}
+}
+
+func TestPruneRemovedCodes(t *testing.T) {
+	specs := map[string]struct {
+		setup     func(t *testing.T, ctx sdk.Context, keepers TestKeepers, example ExampleContract)
+		expPruned bool
+	}{
+		"removed from wasmvm cache": {
+			setup:     func(t *testing.T, ctx sdk.Context, keepers TestKeepers, example ExampleContract) {},
+			expPruned: true,
+		},
+		"kept when uploaded again": {
+			setup: func(t *testing.T, ctx sdk.Context, keepers TestKeepers, example ExampleContract) {
+				StoreBurnerExampleContract(t, ctx, keepers)
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			k := keepers.WasmKeeper
+			example := StoreBurnerExampleContract(t, ctx, keepers)
+			require.NoError(t, keepers.ContractKeeper.RemoveCode(ctx, example.CodeID, example.CreatorAddr))
+			spec.setup(t, ctx, keepers, example)
+			// when
+			k.PruneRemovedCodes(ctx)
+			// then
+			assert.False(t, ctx.KVStore(k.storeKey).Has(types.GetPendingCodeRemovalKey(example.Checksum)))
+			_, err := k.wasmVM.GetCode(example.Checksum)
+			if spec.expPruned {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRemoveCodeWithSharedChecksum(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := StoreBurnerExampleContract(t, ctx, keepers)
+	other := StoreBurnerExampleContract(t, ctx, keepers)
+	require.Equal(t, example.Checksum, other.Checksum)
+
+	// when
+	require.NoError(t, keepers.ContractKeeper.RemoveCode(ctx, example.CodeID, example.CreatorAddr))
+	k.PruneRemovedCodes(ctx)
+
+	// then
+	assert.Nil(t, k.GetCodeInfo(ctx, example.CodeID))
+	assert.False(t, ctx.KVStore(k.storeKey).Has(types.GetPendingCodeRemovalKey(example.Checksum)))
+	bz, err := k.GetByteCode(ctx, other.CodeID)
+	require.NoError(t, err)
+	assert.NotEmpty(t, bz)
+}
+
+func TestFreezeContract(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	emergencyAuthority := RandomAccountAddress(t)
+	params := types.DefaultParams()
+	params.EmergencyAuthority = emergencyAuthority.String()
+	k.SetParams(parentCtx, params)
+
+	specs := map[string]struct {
+		contract sdk.AccAddress
+		caller   sdk.AccAddress
+		authZ    AuthorizationPolicy
+		expErr   *sdkerrors.Error
+	}{
+		"all good when called by emergency authority": {
+			contract: example.Contract,
+			caller:   emergencyAuthority,
+			authZ:    DefaultAuthorizationPolicy{},
+		},
+		"all good with gov": {
+			contract: example.Contract,
+			authZ:    GovAuthorizationPolicy{},
+		},
+		"prevent freeze by contract admin": {
+			contract: example.Contract,
+			caller:   example.CreatorAddr,
+			authZ:    DefaultAuthorizationPolicy{},
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"fail with non existing contract": {
+			contract: RandomAccountAddress(t),
+			caller:   emergencyAuthority,
+			authZ:    DefaultAuthorizationPolicy{},
+			expErr:   sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			// when
+			err := k.setContractFrozen(ctx, spec.contract, spec.caller, true, spec.authZ)
+			// then
+			require.True(t, spec.expErr.Is(err), "expected %v but got %+v", spec.expErr, err)
+			if spec.expErr != nil {
+				return
+			}
+			assert.True(t, k.GetContractInfo(ctx, spec.contract).IsFrozen)
+			assert.Equal(t, sdk.Events{sdk.NewEvent(
+				"freeze_contract",
+				sdk.NewAttribute("_contract_address", spec.contract.String()),
+			)}, ctx.EventManager().Events())
+
+			// and when frozen again
+			err = k.setContractFrozen(ctx, spec.contract, spec.caller, true, spec.authZ)
+			// then
+			require.ErrorIs(t, err, types.ErrInvalid)
+
+			// and when unfrozen
+			err = k.setContractFrozen(ctx, spec.contract, spec.caller, false, spec.authZ)
+			// then
+			require.NoError(t, err)
+			assert.False(t, k.GetContractInfo(ctx, spec.contract).IsFrozen)
+		})
+	}
+}
+
+func TestFrozenContract(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	govKeeper := NewGovPermissionKeeper(k)
+	require.NoError(t, govKeeper.FreezeContract(ctx, example.Contract, nil))
+
+	// when executed
+	_, err := keepers.ContractKeeper.Execute(ctx, example.Contract, example.VerifierAddr, []byte(`{"release":{}}`), nil)
+	// then
+	require.ErrorIs(t, err, types.ErrContractFrozen)
+
+	// when queried
+	_, err = k.QuerySmart(ctx, example.Contract, []byte(`{"verifier":{}}`))
+	// then
+	require.NoError(t, err)
+	assert.NotNil(t, k.QueryRaw(ctx, example.Contract, []byte("config")))
+
+	// when migrated by admin
+	migMsgBz, err := json.Marshal(struct {
+		Verifier sdk.AccAddress `json:"verifier"`
+	}{Verifier: example.VerifierAddr})
+	require.NoError(t, err)
+	_, err = keepers.ContractKeeper.Migrate(ctx, example.Contract, example.CreatorAddr, example.CodeID, migMsgBz)
+	// then
+	require.ErrorIs(t, err, types.ErrContractFrozen)
+
+	// when migrated by gov
+	_, err = govKeeper.Migrate(ctx, example.Contract, nil, example.CodeID, migMsgBz)
+	// then
+	require.NoError(t, err)
+	assert.True(t, k.GetContractInfo(ctx, example.Contract).IsFrozen)
+
+	// when unfrozen and executed
+	require.NoError(t, govKeeper.UnfreezeContract(ctx, example.Contract, nil))
+	_, err = keepers.ContractKeeper.Execute(ctx, example.Contract, example.VerifierAddr, []byte(`{"release":{}}`), nil)
+	// then
+	require.NoError(t, err)
+}
+
 func TestPinCode(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	k := keepers.WasmKeeper
@@ -1850,7 +2498,7 @@ func TestPinnedContractLoops(t *testing.T) {
 		}, 0, nil
 	}
 	ctx = ctx.WithGasMeter(sdk.NewGasMeter(20000))
-	require.PanicsWithValue(t, sdk.ErrorOutOfGas{Descriptor: "ReadFlat"}, func() {
+	require.PanicsWithValue(t, sdk.ErrorOutOfGas{Descriptor: "Has"}, func() {
 		_, err := k.execute(ctx, example.Contract, RandomAccountAddress(t), anyMsg, nil)
 		require.NoError(t, err)
 	})
@@ -2165,6 +2813,93 @@ func TestSetAccessConfig(t *testing.T) {
 	}
 }
 
+func TestSetCodeMetadata(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := StoreHackatomExampleContract(t, parentCtx, keepers)
+	const (
+		mySource  = "https://example.com/hackatom.tar.gz"
+		myBuilder = "cosmwasm/rust-optimizer:0.12.9"
+	)
+
+	specs := map[string]struct {
+		codeID  uint64
+		caller  sdk.AccAddress
+		authZ   AuthorizationPolicy
+		source  string
+		builder string
+		expErr  *sdkerrors.Error
+	}{
+		"all good when called by creator": {
+			codeID:  example.CodeID,
+			caller:  example.CreatorAddr,
+			authZ:   DefaultAuthorizationPolicy{},
+			source:  mySource,
+			builder: myBuilder,
+		},
+		"all good with gov": {
+			codeID:  example.CodeID,
+			authZ:   GovAuthorizationPolicy{},
+			source:  mySource,
+			builder: myBuilder,
+		},
+		"other address": {
+			codeID:  example.CodeID,
+			caller:  RandomAccountAddress(t),
+			authZ:   DefaultAuthorizationPolicy{},
+			source:  mySource,
+			builder: myBuilder,
+			expErr:  sdkerrors.ErrUnauthorized,
+		},
+		"invalid source": {
+			codeID:  example.CodeID,
+			caller:  example.CreatorAddr,
+			authZ:   DefaultAuthorizationPolicy{},
+			source:  "not a url",
+			builder: myBuilder,
+			expErr:  types.ErrInvalid,
+		},
+		"empty builder": {
+			codeID: example.CodeID,
+			caller: example.CreatorAddr,
+			authZ:  DefaultAuthorizationPolicy{},
+			source: mySource,
+			expErr: types.ErrInvalid,
+		},
+		"unknown code id": {
+			codeID:  example.CodeID + 1,
+			caller:  example.CreatorAddr,
+			authZ:   DefaultAuthorizationPolicy{},
+			source:  mySource,
+			builder: myBuilder,
+			expErr:  types.ErrNotFound,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+			ctx = ctx.WithEventManager(em)
+			// when
+			gotErr := k.setCodeMetadata(ctx, spec.codeID, spec.caller, spec.source, spec.builder, spec.authZ)
+			// then
+			require.True(t, spec.expErr.Is(gotErr), "expected %v but got %+v", spec.expErr, gotErr)
+			if spec.expErr != nil {
+				return
+			}
+			info := k.GetCodeInfo(ctx, spec.codeID)
+			assert.Equal(t, spec.source, info.Source)
+			assert.Equal(t, spec.builder, info.Builder)
+			assert.Equal(t, sdk.Events{sdk.NewEvent(
+				"update_code_metadata",
+				sdk.NewAttribute("source", spec.source),
+				sdk.NewAttribute("builder", spec.builder),
+				sdk.NewAttribute("code_id", fmt.Sprintf("%d", spec.codeID)),
+			)}, em.Events())
+		})
+	}
+}
+
 func TestAppendToContractHistory(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	var contractAddr sdk.AccAddress = rand.Bytes(types.ContractAddrLen)
@@ -2340,18 +3075,23 @@ func TestSetContractAdmin(t *testing.T) {
 	k := keepers.WasmKeeper
 	myAddr := RandomAccountAddress(t)
 	example := InstantiateReflectExampleContract(t, parentCtx, keepers)
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
 	specs := map[string]struct {
-		newAdmin sdk.AccAddress
-		caller   sdk.AccAddress
-		policy   AuthorizationPolicy
-		expAdmin string
-		expErr   bool
+		newAdmin     sdk.AccAddress
+		caller       sdk.AccAddress
+		policy       AuthorizationPolicy
+		expAdmin     string
+		expOperation types.ContractCodeHistoryOperationType
+		expSender    string
+		expErr       bool
 	}{
 		"update admin": {
-			newAdmin: myAddr,
-			caller:   example.CreatorAddr,
-			policy:   DefaultAuthorizationPolicy{},
-			expAdmin: myAddr.String(),
+			newAdmin:     myAddr,
+			caller:       example.CreatorAddr,
+			policy:       DefaultAuthorizationPolicy{},
+			expAdmin:     myAddr.String(),
+			expOperation: types.ContractCodeHistoryOperationTypeAdminUpdate,
+			expSender:    example.CreatorAddr.String(),
 		},
 		"update admin - unauthorized": {
 			newAdmin: myAddr,
@@ -2360,9 +3100,11 @@ func TestSetContractAdmin(t *testing.T) {
 			expErr:   true,
 		},
 		"clear admin - default policy": {
-			caller:   example.CreatorAddr,
-			policy:   DefaultAuthorizationPolicy{},
-			expAdmin: "",
+			caller:       example.CreatorAddr,
+			policy:       DefaultAuthorizationPolicy{},
+			expAdmin:     "",
+			expOperation: types.ContractCodeHistoryOperationTypeAdminClear,
+			expSender:    example.CreatorAddr.String(),
 		},
 		"clear admin - unauthorized": {
 			expAdmin: "",
@@ -2371,10 +3113,19 @@ func TestSetContractAdmin(t *testing.T) {
 			expErr:   true,
 		},
 		"clear admin - gov policy": {
-			newAdmin: nil,
-			policy:   GovAuthorizationPolicy{},
-			caller:   example.CreatorAddr,
-			expAdmin: "",
+			newAdmin:     nil,
+			policy:       GovAuthorizationPolicy{},
+			caller:       example.CreatorAddr,
+			expAdmin:     "",
+			expOperation: types.ContractCodeHistoryOperationTypeAdminClear,
+			expSender:    govAddr,
+		},
+		"update admin - gov policy without caller": {
+			newAdmin:     myAddr,
+			policy:       GovAuthorizationPolicy{},
+			expAdmin:     myAddr.String(),
+			expOperation: types.ContractCodeHistoryOperationTypeAdminUpdate,
+			expSender:    govAddr,
 		},
 	}
 	for name, spec := range specs {
@@ -2389,6 +3140,16 @@ func TestSetContractAdmin(t *testing.T) {
 			}
 			require.NoError(t, gotErr)
 			assert.Equal(t, spec.expAdmin, k.GetContractInfo(ctx, example.Contract).Admin)
+			// and history entry appended
+			history := k.GetContractHistory(ctx, example.Contract)
+			expEntry := types.ContractCodeHistoryEntry{
+				Operation: spec.expOperation,
+				CodeID:    example.CodeID,
+				Updated:   types.NewAbsoluteTxPosition(ctx),
+				Sender:    spec.expSender,
+				Admin:     spec.expAdmin,
+			}
+			assert.Equal(t, expEntry, history[len(history)-1])
 			// and event emitted
 			require.Len(t, em.Events(), 1)
 			assert.Equal(t, "update_contract_admin", em.Events()[0].Type)
@@ -2401,6 +3162,54 @@ func TestSetContractAdmin(t *testing.T) {
 	}
 }
 
+func TestMigrateAfterAdminUpdate(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	newAdmin := RandomAccountAddress(t)
+	newCodeID, _, err := keepers.ContractKeeper.Create(ctx, example.CreatorAddr, hackatomWasm, nil)
+	require.NoError(t, err)
+
+	// when
+	require.NoError(t, keepers.ContractKeeper.UpdateContractAdmin(ctx, example.Contract, example.CreatorAddr, newAdmin))
+	migMsgBz := []byte(fmt.Sprintf(`{"verifier":%q}`, RandomBech32AccountAddress(t)))
+	_, err = keepers.ContractKeeper.Migrate(ctx, example.Contract, newAdmin, newCodeID, migMsgBz)
+	require.NoError(t, err)
+
+	// then the history contains all operations
+	history := k.GetContractHistory(ctx, example.Contract)
+	require.Len(t, history, 3)
+	assert.Equal(t, types.ContractCodeHistoryOperationTypeInit, history[0].Operation)
+	assert.Equal(t, example.CreatorAddr.String(), history[0].Sender)
+	assert.Equal(t, types.ContractCodeHistoryEntry{
+		Operation: types.ContractCodeHistoryOperationTypeAdminUpdate,
+		CodeID:    example.CodeID,
+		Updated:   types.NewAbsoluteTxPosition(ctx),
+		Sender:    example.CreatorAddr.String(),
+		Admin:     newAdmin.String(),
+	}, history[1])
+	assert.Equal(t, types.ContractCodeHistoryEntry{
+		Operation: types.ContractCodeHistoryOperationTypeMigrate,
+		CodeID:    newCodeID,
+		Updated:   types.NewAbsoluteTxPosition(ctx),
+		Msg:       migMsgBz,
+		Sender:    newAdmin.String(),
+		Admin:     newAdmin.String(),
+	}, history[2])
+	// and the code index points to the new code only
+	var gotOld, gotNew []sdk.AccAddress
+	k.IterateContractsByCode(ctx, example.CodeID, func(a sdk.AccAddress) bool {
+		gotOld = append(gotOld, a)
+		return false
+	})
+	k.IterateContractsByCode(ctx, newCodeID, func(a sdk.AccAddress) bool {
+		gotNew = append(gotNew, a)
+		return false
+	})
+	assert.Empty(t, gotOld)
+	assert.Equal(t, []sdk.AccAddress{example.Contract}, gotNew)
+}
+
 func attrsToStringMap(attrs []abci.EventAttribute) map[string]string {
 	r := make(map[string]string, len(attrs))
 	for _, v := range attrs {
@@ -129,6 +129,10 @@ func queryCodeList(ctx sdk.Context, keeper types.ViewKeeper) ([]types.CodeInfoRe
 			Creator:               res.Creator,
 			DataHash:              res.CodeHash,
 			InstantiatePermission: res.InstantiateConfig,
+			Source:                res.Source,
+			Builder:               res.Builder,
+			Created:               res.Created,
+			WasmSize:              res.WasmSize,
 		})
 		return false
 	})
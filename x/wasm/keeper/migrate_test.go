@@ -59,3 +59,47 @@ func TestMigrate1To2(t *testing.T) {
 
 	require.Equal(t, []string{gotContractAddr1.String(), gotContractAddr2.String(), gotContractAddr3.String()}, allContract)
 }
+
+func TestMigrate2To3(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	wasmKeeper := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	expStats := wasmKeeper.GetContractStorageStats(ctx, example.Contract)
+	require.NotZero(t, expStats.Entries)
+
+	codeInfo := wasmKeeper.GetCodeInfo(ctx, example.CodeID)
+	expWasmSize := codeInfo.WasmSize
+	require.NotZero(t, expWasmSize)
+
+	// remove stats, wasm size and param
+	ctx.KVStore(wasmKeeper.storeKey).Delete(types.GetContractStorageStatsKey(example.Contract))
+	ctx.KVStore(wasmKeeper.storeKey).Delete(types.GetContractByAdminSecondaryIndexKey(example.CreatorAddr, example.Contract))
+	codeInfo.WasmSize = 0
+	wasmKeeper.storeCodeInfo(ctx, example.CodeID, *codeInfo)
+	ctx.KVStore(wasmKeeper.storeKey).Delete(types.GetCodeIDByChecksumKey(codeInfo.CodeHash, example.CodeID))
+	wasmKeeper.paramSpace.Set(ctx, types.ParamStoreKeyMaxContractStorage, uint64(1))
+	wasmKeeper.paramSpace.Set(ctx, types.ParamStoreKeyEnforceUniqueLabels, true)
+	wasmKeeper.paramSpace.Set(ctx, types.ParamStoreKeyLabelIndexScope, types.LabelIndexScopeGlobal)
+
+	// migrator
+	migrator := NewMigrator(*wasmKeeper)
+	require.NoError(t, migrator.Migrate2to3(ctx))
+
+	// then
+	require.Equal(t, expStats, wasmKeeper.GetContractStorageStats(ctx, example.Contract))
+	require.Equal(t, expWasmSize, wasmKeeper.GetCodeInfo(ctx, example.CodeID).WasmSize)
+	require.True(t, wasmKeeper.isChecksumReferenced(ctx, codeInfo.CodeHash))
+	require.True(t, ctx.KVStore(wasmKeeper.storeKey).Has(types.GetContractByAdminSecondaryIndexKey(example.CreatorAddr, example.Contract)))
+	label := wasmKeeper.GetContractInfo(ctx, example.Contract).Label
+	require.False(t, wasmKeeper.hasContractWithLabel(ctx, example.CreatorAddr, label))
+	params := wasmKeeper.GetParams(ctx)
+	require.Equal(t, uint64(0), params.MaxContractStorageBytes)
+	require.False(t, params.StorageDepositEnabled())
+	require.Equal(t, uint64(0), params.CronBlockGasLimit)
+	require.Equal(t, uint32(0), params.MaxCallbacksPerBlock)
+	require.Equal(t, uint64(0), params.MaxCallbackGasLimit)
+	require.False(t, params.CallbackFeeEnabled())
+	require.False(t, params.EnforceCodeReuse)
+	require.False(t, params.EnforceUniqueLabels)
+	require.Equal(t, types.LabelIndexScopeNone, params.LabelIndexScope)
+}
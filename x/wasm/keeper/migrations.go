@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
@@ -25,3 +26,45 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	})
 	return nil
 }
+
+// Migrate2to3 migrates from version 2 to 3. It sets the new max contract storage param to unlimited, disables storage
+// deposits, cron jobs, contract callbacks, enforced code reuse and unique labels and the label index, leaves the
+// emergency authority unset, calculates the storage stats of all existing contracts and indexes them by admin, sets the
+// wasm size of all existing codes and indexes them by checksum.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyMaxContractStorage, uint64(0))
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyStorageDepositDenom, "")
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyStorageDepositPrice, sdk.ZeroDec())
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyEmergencyAuthority, "")
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyCronBlockGasLimit, uint64(0))
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyMaxCallbacksPerBlock, uint32(0))
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyMaxCallbackGasLimit, uint64(0))
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyCallbackFeeDenom, "")
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyCallbackGasPrice, sdk.ZeroDec())
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyEnforceCodeReuse, false)
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyEnforceUniqueLabels, false)
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyLabelIndexScope, types.LabelIndexScopeNone)
+	m.keeper.IterateContractInfo(ctx, func(contractAddr sdk.AccAddress, contractInfo types.ContractInfo) bool {
+		m.keeper.setContractStorageStats(ctx, contractAddr, m.keeper.calculateContractStorageStats(ctx, contractAddr))
+		m.keeper.addToContractAdminSecondaryIndex(ctx, contractInfo.AdminAddr(), contractAddr)
+		return false
+	})
+	codeInfos := make(map[uint64]types.CodeInfo)
+	var codeIDs []uint64
+	m.keeper.IterateCodeInfos(ctx, func(codeID uint64, codeInfo types.CodeInfo) bool {
+		codeIDs = append(codeIDs, codeID)
+		codeInfos[codeID] = codeInfo
+		return false
+	})
+	for _, codeID := range codeIDs {
+		code, err := m.keeper.GetByteCode(ctx, codeID)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "code id %d", codeID)
+		}
+		codeInfo := codeInfos[codeID]
+		codeInfo.WasmSize = uint64(len(code))
+		m.keeper.storeCodeInfo(ctx, codeID, codeInfo)
+		m.keeper.addToCodeIDsByChecksumIndex(ctx, codeInfo.CodeHash, codeID)
+	}
+	return nil
+}
@@ -94,6 +94,8 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 		gasRemaining := ctx.GasMeter().Limit() - ctx.GasMeter().GasConsumed()
 		limitGas := msg.GasLimit != nil && (*msg.GasLimit < gasRemaining)
 
+		trace := startCallTrace(subCtx, "submsg", contractAddr)
+		trace.setSubmsgID(msg.ID)
 		var err error
 		var events []sdk.Event
 		var data [][]byte
@@ -102,6 +104,8 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 		} else {
 			events, data, err = d.messenger.DispatchMsg(subCtx, contractAddr, ibcPort, msg.Msg)
 		}
+		trace.addEvents(events)
+		trace.end(subCtx, err, nil)
 
 		// if it succeeds, commit state changes from submessage, and pass on events to Event Manager
 		var filteredEvents []sdk.Event
@@ -24,6 +24,9 @@ func (m msgServer) StoreCode(goCtx context.Context, msg *types.MsgStoreCode) (*t
 		return nil, err
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.assertOperationEnabled(ctx, types.OperationTypeStoreCode); err != nil {
+		return nil, err
+	}
 	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "sender")
@@ -35,10 +38,19 @@ func (m msgServer) StoreCode(goCtx context.Context, msg *types.MsgStoreCode) (*t
 		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
 	))
 
-	codeID, checksum, err := m.keeper.Create(ctx, senderAddr, msg.WASMByteCode, msg.InstantiatePermission)
+	create := m.keeper.Create
+	if msg.ReuseExistingCode {
+		create = m.keeper.CreateOrReuse
+	}
+	codeID, checksum, err := create(ctx, senderAddr, msg.WASMByteCode, msg.InstantiatePermission)
 	if err != nil {
 		return nil, err
 	}
+	if msg.Source != "" || msg.Builder != "" {
+		if err := m.keeper.InitCodeMetadata(ctx, codeID, senderAddr, msg.Source, msg.Builder); err != nil {
+			return nil, err
+		}
+	}
 
 	return &types.MsgStoreCodeResponse{
 		CodeID:   codeID,
@@ -52,6 +64,9 @@ func (m msgServer) InstantiateContract(goCtx context.Context, msg *types.MsgInst
 		return nil, err
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.assertOperationEnabled(ctx, types.OperationTypeInstantiate); err != nil {
+		return nil, err
+	}
 
 	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
@@ -87,6 +102,9 @@ func (m msgServer) InstantiateContract2(goCtx context.Context, msg *types.MsgIns
 		return nil, err
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.assertOperationEnabled(ctx, types.OperationTypeInstantiate); err != nil {
+		return nil, err
+	}
 
 	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
@@ -121,6 +139,9 @@ func (m msgServer) ExecuteContract(goCtx context.Context, msg *types.MsgExecuteC
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.assertOperationEnabled(ctx, types.OperationTypeExecute); err != nil {
+		return nil, err
+	}
 	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "sender")
@@ -152,6 +173,9 @@ func (m msgServer) MigrateContract(goCtx context.Context, msg *types.MsgMigrateC
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.assertOperationEnabled(ctx, types.OperationTypeMigrate); err != nil {
+		return nil, err
+	}
 	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "sender")
@@ -254,3 +278,195 @@ func (m msgServer) UpdateInstantiateConfig(goCtx context.Context, msg *types.Msg
 
 	return &types.MsgUpdateInstantiateConfigResponse{}, nil
 }
+
+func (m msgServer) DeleteContract(goCtx context.Context, msg *types.MsgDeleteContract) (*types.MsgDeleteContractResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+	recipientAddr, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "recipient")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.DeleteContract(ctx, contractAddr, senderAddr, recipientAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeleteContractResponse{}, nil
+}
+
+func (m msgServer) RemoveCode(goCtx context.Context, msg *types.MsgRemoveCode) (*types.MsgRemoveCodeResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.RemoveCode(ctx, msg.CodeID, senderAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveCodeResponse{}, nil
+}
+
+func (m msgServer) UpdateCodeMetadata(goCtx context.Context, msg *types.MsgUpdateCodeMetadata) (*types.MsgUpdateCodeMetadataResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.UpdateCodeMetadata(ctx, msg.CodeID, senderAddr, msg.Source, msg.Builder); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateCodeMetadataResponse{}, nil
+}
+
+func (m msgServer) FreezeContract(goCtx context.Context, msg *types.MsgFreezeContract) (*types.MsgFreezeContractResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.FreezeContract(ctx, contractAddr, senderAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFreezeContractResponse{}, nil
+}
+
+func (m msgServer) UnfreezeContract(goCtx context.Context, msg *types.MsgUnfreezeContract) (*types.MsgUnfreezeContractResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.UnfreezeContract(ctx, contractAddr, senderAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnfreezeContractResponse{}, nil
+}
+
+func (m msgServer) DisableOperations(goCtx context.Context, msg *types.MsgDisableOperations) (*types.MsgDisableOperationsResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.DisableOperations(ctx, senderAddr, msg.Operations); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDisableOperationsResponse{}, nil
+}
+
+func (m msgServer) EnableOperations(goCtx context.Context, msg *types.MsgEnableOperations) (*types.MsgEnableOperationsResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+	))
+
+	if err := m.keeper.EnableOperations(ctx, senderAddr, msg.Operations); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgEnableOperationsResponse{}, nil
+}
+
+// assertOperationEnabled returns an error when the operation was disabled by the circuit breaker
+func (m msgServer) assertOperationEnabled(ctx sdk.Context, op types.OperationType) error {
+	if m.keeper.IsOperationDisabled(ctx, op) {
+		return sdkerrors.Wrap(types.ErrOperationDisabled, op.String())
+	}
+	return nil
+}
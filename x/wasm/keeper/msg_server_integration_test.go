@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -44,3 +45,106 @@ func TestStoreCode(t *testing.T) {
 	assert.Equal(t, sender.String(), info.Creator)
 	assert.Equal(t, types.DefaultParams().InstantiateDefaultPermission.With(sender), info.InstantiateConfig)
 }
+
+func TestUpdateCodeMetadata(t *testing.T) {
+	wasmApp := app.Setup(false)
+	ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{})
+	_, _, sender := testdata.KeyTestPubAddr()
+	storeMsg := types.MsgStoreCodeFixture(func(m *types.MsgStoreCode) {
+		m.WASMByteCode = wasmContract
+		m.Sender = sender.String()
+		m.Source = "https://example.com/reflect/v1.tar.gz"
+		m.Builder = "cosmwasm/rust-optimizer:0.12.8"
+	})
+	_, err := wasmApp.MsgServiceRouter().Handler(storeMsg)(ctx, storeMsg)
+	require.NoError(t, err)
+	info := wasmApp.WasmKeeper.GetCodeInfo(ctx, 1)
+	require.NotNil(t, info)
+	assert.Equal(t, storeMsg.Source, info.Source)
+	assert.Equal(t, storeMsg.Builder, info.Builder)
+	assert.Equal(t, uint64(len(wasmContract)), info.WasmSize)
+
+	msg := &types.MsgUpdateCodeMetadata{
+		Sender:  sender.String(),
+		CodeID:  1,
+		Source:  "https://example.com/reflect/v2.tar.gz",
+		Builder: "cosmwasm/rust-optimizer:0.12.9",
+	}
+
+	// when
+	_, err = wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+
+	// then
+	require.NoError(t, err)
+	info = wasmApp.WasmKeeper.GetCodeInfo(ctx, 1)
+	assert.Equal(t, msg.Source, info.Source)
+	assert.Equal(t, msg.Builder, info.Builder)
+
+	// and when sent by other address
+	_, _, other := testdata.KeyTestPubAddr()
+	msg.Sender = other.String()
+	_, err = wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+
+	// then
+	require.Error(t, err)
+}
+
+func TestStoreCodeReuseKeepsMetadata(t *testing.T) {
+	_, _, creator := testdata.KeyTestPubAddr()
+	_, _, other := testdata.KeyTestPubAddr()
+	specs := map[string]struct {
+		sender sdk.AccAddress
+	}{
+		"reused by other sender": {sender: other},
+		"reused by creator":      {sender: creator},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			wasmApp := app.Setup(false)
+			ctx := wasmApp.BaseApp.NewContext(false, tmproto.Header{})
+			storeMsg := types.MsgStoreCodeFixture(func(m *types.MsgStoreCode) {
+				m.WASMByteCode = wasmContract
+				m.Sender = creator.String()
+				m.InstantiatePermission = &types.AllowEverybody
+				m.Source = "https://example.com/reflect/v1.tar.gz"
+				m.Builder = "cosmwasm/rust-optimizer:0.12.8"
+			})
+			_, err := wasmApp.MsgServiceRouter().Handler(storeMsg)(ctx, storeMsg)
+			require.NoError(t, err)
+
+			msg := types.MsgStoreCodeFixture(func(m *types.MsgStoreCode) {
+				m.WASMByteCode = wasmContract
+				m.Sender = spec.sender.String()
+				m.InstantiatePermission = &types.AllowEverybody
+				m.ReuseExistingCode = true
+				m.Source = "https://example.com/other/v2.tar.gz"
+				m.Builder = "cosmwasm/rust-optimizer:0.12.9"
+			})
+
+			// when
+			rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+
+			// then
+			require.NoError(t, err)
+			var result types.MsgStoreCodeResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &result))
+			assert.Equal(t, uint64(1), result.CodeID)
+			info := wasmApp.WasmKeeper.GetCodeInfo(ctx, 1)
+			assert.Equal(t, storeMsg.Source, info.Source)
+			assert.Equal(t, storeMsg.Builder, info.Builder)
+			// and the store code event contains the reused code id
+			var gotCodeID string
+			for _, e := range rsp.Events {
+				if e.Type != types.EventTypeStoreCode {
+					continue
+				}
+				for _, a := range e.Attributes {
+					if string(a.Key) == types.AttributeKeyCodeID {
+						gotCodeID = string(a.Value)
+					}
+				}
+			}
+			assert.Equal(t, "1", gotCodeID)
+		})
+	}
+}
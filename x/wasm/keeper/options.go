@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -154,6 +155,76 @@ func WithAcceptedAccountTypesOnContractInstantiation(accts ...authtypes.AccountI
 	})
 }
 
+// WithIBCTransferCallbacks enables callbacks to contracts on acknowledgement or timeout of the ICS-20 transfers that
+// they send with the `ibc_transfer_with_callback` custom message. The gas limit is the max gas that a contract can
+// spend on a callback. It is charged to the relayer of the acknowledgement or timeout.
+// The `IBCTransferCallbacksMiddleware` must be part of the ibc transfer stack.
+func WithIBCTransferCallbacks(gasLimit uint64) Option {
+	return optsFn(func(k *Keeper) {
+		k.ibcTransferCallbackGasLimit = gasLimit
+	})
+}
+
+// WithIBCFeeQueries enables the `ibc_fee` custom queries for contracts to read the ICS-29 fee data.
+// This option expects the default `QueryHandler` set and should not be combined with Option `WithQueryHandler` or `WithQueryHandlerDecorator`.
+func WithIBCFeeQueries(feeKeeper types.IBCFeeKeeper) Option {
+	return optsFn(func(k *Keeper) {
+		WithQueryPlugins(&QueryPlugins{IBCFee: IBCFeeQuerier(k, feeKeeper)}).apply(k)
+	})
+}
+
+// WithIBCFeeMessages enables the `ibc_fee` custom messages for contracts to pay ICS-29 relayer fees.
+// This option expects the default `MessageHandler` set and should not be combined with Option `WithMessageHandler` or `WithMessageHandlerDecorator`.
+func WithIBCFeeMessages() Option {
+	return WithMessageEncoders(&MessageEncoders{IBCFee: EncodeIBCFeeMsg})
+}
+
+// WithIBCAsyncAcks enables contracts to acknowledge received packets asynchronously with the `write_acknowledgement`
+// custom message. Without it, a contract that returns no acknowledgement in `ibc_packet_receive` fails the packet
+// with an error acknowledgement.
+func WithIBCAsyncAcks() Option {
+	return optsFn(func(k *Keeper) {
+		k.ibcAsyncAcks = true
+	})
+}
+
+// WithScheduledCallbacks enables the `schedule_callback` and `cancel_callback` custom messages for contracts to
+// schedule sudo calls at a future height. Without it, these messages are passed on to the next message handler
+// like any other custom message.
+func WithScheduledCallbacks() Option {
+	return optsFn(func(k *Keeper) {
+		k.scheduledCallbacks = true
+	})
+}
+
+// WithStateStreaming journals all contract storage and contract info writes in the given transient store so that
+// the `StateStreamingService` can stream them in order. The journal is not gas metered.
+func WithStateStreaming(tStoreKey sdk.StoreKey) Option {
+	return optsFn(func(k *Keeper) {
+		k.stateChangeJournalKey = tStoreKey
+	})
+}
+
+// WithICAController enables interchain accounts owned by contracts. The capability keeper must be scoped to
+// `types.ICAControllerModuleName`. The gas limit is the max gas that a contract can spend on a callback.
+// The `ICAControllerMiddleware` must be part of the ICS-27 controller stack.
+func WithICAController(icaControllerKeeper types.ICAControllerKeeper, scopedKeeper types.CapabilityKeeper, callbackGasLimit uint64) Option {
+	return optsFn(func(k *Keeper) {
+		k.icaControllerKeeper = icaControllerKeeper
+		k.icaCapabilityKeeper = scopedKeeper
+		k.icaCallbackGasLimit = callbackGasLimit
+	})
+}
+
+// WithICS4Wrapper sets the ICS-4 wrapper that the asynchronous acknowledgements of contracts are written with.
+// Defaults to the channel keeper and must be set to the ICS-29 fee keeper when the wasm stack is wrapped by the
+// fee middleware.
+func WithICS4Wrapper(x types.ICS4Wrapper) Option {
+	return optsFn(func(k *Keeper) {
+		k.ics4Wrapper = x
+	})
+}
+
 func asTypeMap(accts []authtypes.AccountI) map[reflect.Type]struct{} {
 	m := make(map[reflect.Type]struct{}, len(accts))
 	for _, a := range accts {
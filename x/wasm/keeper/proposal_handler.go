@@ -55,6 +55,18 @@ func NewWasmProposalHandlerX(k types.ContractOpsKeeper, enabledProposalTypes []t
 			return handleUpdateInstantiateConfigProposal(ctx, k, *c)
 		case *types.StoreAndInstantiateContractProposal:
 			return handleStoreAndInstantiateContractProposal(ctx, k, *c)
+		case *types.DeleteContractProposal:
+			return handleDeleteContractProposal(ctx, k, *c)
+		case *types.RemoveCodeProposal:
+			return handleRemoveCodeProposal(ctx, k, *c)
+		case *types.RegisterCronJobProposal:
+			return handleRegisterCronJobProposal(ctx, k, *c)
+		case *types.DeregisterCronJobProposal:
+			return handleDeregisterCronJobProposal(ctx, k, *c)
+		case *types.FreezeContractProposal:
+			return handleFreezeContractProposal(ctx, k, *c)
+		case *types.UnfreezeContractProposal:
+			return handleUnfreezeContractProposal(ctx, k, *c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized wasm proposal content type: %T", c)
 		}
@@ -78,6 +90,11 @@ func handleStoreCodeProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types
 	if len(p.CodeHash) != 0 && !bytes.Equal(checksum, p.CodeHash) {
 		return fmt.Errorf("code-hash mismatch: %X, checksum: %X", p.CodeHash, checksum)
 	}
+	if p.Source != "" {
+		if err := k.InitCodeMetadata(ctx, codeID, runAsAddr, p.Source, p.Builder); err != nil {
+			return err
+		}
+	}
 
 	// if code should not be pinned return earlier
 	if p.UnpinCode {
@@ -168,6 +185,11 @@ func handleStoreAndInstantiateContractProposal(ctx sdk.Context, k types.Contract
 	if p.CodeHash != nil && !bytes.Equal(checksum, p.CodeHash) {
 		return sdkerrors.Wrap(fmt.Errorf("code-hash mismatch: %X, checksum: %X", p.CodeHash, checksum), "code-hash mismatch")
 	}
+	if p.Source != "" {
+		if err := k.InitCodeMetadata(ctx, codeID, runAsAddr, p.Source, p.Builder); err != nil {
+			return err
+		}
+	}
 
 	if !p.UnpinCode {
 		if err := k.PinCode(ctx, codeID); err != nil {
@@ -287,6 +309,53 @@ func handleClearAdminProposal(ctx sdk.Context, k types.ContractOpsKeeper, p type
 	return nil
 }
 
+func handleDeleteContractProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.DeleteContractProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	recipientAddr, err := sdk.AccAddressFromBech32(p.Recipient)
+	if err != nil {
+		return sdkerrors.Wrap(err, "recipient")
+	}
+	return k.DeleteContract(ctx, contractAddr, nil, recipientAddr)
+}
+
+func handleRemoveCodeProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.RemoveCodeProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	return k.RemoveCode(ctx, p.CodeID, nil)
+}
+
+func handleFreezeContractProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.FreezeContractProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return k.FreezeContract(ctx, contractAddr, nil)
+}
+
+func handleUnfreezeContractProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.UnfreezeContractProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return k.UnfreezeContract(ctx, contractAddr, nil)
+}
+
 func handlePinCodesProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.PinCodesProposal) error {
 	if err := p.ValidateBasic(); err != nil {
 		return err
@@ -324,3 +393,22 @@ func handleUpdateInstantiateConfigProposal(ctx sdk.Context, k types.ContractOpsK
 	}
 	return nil
 }
+
+func handleRegisterCronJobProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.RegisterCronJobProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	return k.RegisterCronJob(ctx, p.CronJob())
+}
+
+func handleDeregisterCronJobProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types.DeregisterCronJobProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return k.DeregisterCronJob(ctx, contractAddr)
+}
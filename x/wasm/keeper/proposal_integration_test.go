@@ -10,6 +10,7 @@ import (
 
 	wasmvm "github.com/CosmWasm/wasmvm"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/params/client/utils"
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
@@ -69,6 +70,8 @@ func TestStoreCodeProposal(t *testing.T) {
 			cInfo := wasmKeeper.GetCodeInfo(ctx, 1)
 			require.NotNil(t, cInfo)
 			assert.Equal(t, myActorAddress, cInfo.Creator)
+			assert.Equal(t, src.Source, cInfo.Source)
+			assert.Equal(t, src.Builder, cInfo.Builder)
 			assert.Equal(t, !spec.unpinCode, wasmKeeper.IsPinnedCode(ctx, 1))
 
 			storedCode, err := wasmKeeper.GetByteCode(ctx, 1)
@@ -130,6 +133,8 @@ func TestInstantiateProposal(t *testing.T) {
 		CodeID:    src.CodeID,
 		Updated:   types.NewAbsoluteTxPosition(ctx),
 		Msg:       src.Msg,
+		Sender:    authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Admin:     cInfo.Admin,
 	}}
 	assert.Equal(t, expHistory, wasmKeeper.GetContractHistory(ctx, contractAddr))
 	// and event
@@ -194,6 +199,8 @@ func TestInstantiate2Proposal(t *testing.T) {
 		CodeID:    src.CodeID,
 		Updated:   types.NewAbsoluteTxPosition(ctx),
 		Msg:       src.Msg,
+		Sender:    authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Admin:     cInfo.Admin,
 	}}
 	assert.Equal(t, expHistory, wasmKeeper.GetContractHistory(ctx, contractAddress))
 	// and event
@@ -266,6 +273,8 @@ func TestInstantiateProposal_NoAdmin(t *testing.T) {
 		CodeID:    src.CodeID,
 		Updated:   types.NewAbsoluteTxPosition(ctx),
 		Msg:       src.Msg,
+		Sender:    authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Admin:     cInfo.Admin,
 	}}
 	assert.Equal(t, expHistory, wasmKeeper.GetContractHistory(ctx, contractAddr))
 	// and event
@@ -328,17 +337,20 @@ func TestStoreAndInstantiateContractProposal(t *testing.T) {
 		CodeID:    cInfo.CodeID,
 		Updated:   types.NewAbsoluteTxPosition(ctx),
 		Msg:       src.Msg,
+		Sender:    authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Admin:     cInfo.Admin,
 	}}
 	assert.Equal(t, expHistory, wasmKeeper.GetContractHistory(ctx, contractAddr))
 	// and event
-	require.Len(t, em.Events(), 5, "%#v", em.Events())
+	require.Len(t, em.Events(), 6, "%#v", em.Events())
 	require.Equal(t, types.EventTypeStoreCode, em.Events()[0].Type)
-	require.Equal(t, types.EventTypePinCode, em.Events()[1].Type)
-	require.Equal(t, types.EventTypeInstantiate, em.Events()[2].Type)
-	require.Equal(t, types.WasmModuleEventType, em.Events()[3].Type)
-	require.Equal(t, types.EventTypeGovContractResult, em.Events()[4].Type)
-	require.Len(t, em.Events()[4].Attributes, 1)
-	require.NotEmpty(t, em.Events()[4].Attributes[0])
+	require.Equal(t, types.EventTypeUpdateCodeMetadata, em.Events()[1].Type)
+	require.Equal(t, types.EventTypePinCode, em.Events()[2].Type)
+	require.Equal(t, types.EventTypeInstantiate, em.Events()[3].Type)
+	require.Equal(t, types.WasmModuleEventType, em.Events()[4].Type)
+	require.Equal(t, types.EventTypeGovContractResult, em.Events()[5].Type)
+	require.Len(t, em.Events()[5].Attributes, 1)
+	require.NotEmpty(t, em.Events()[5].Attributes[0])
 }
 
 func TestMigrateProposal(t *testing.T) {
@@ -373,7 +385,7 @@ func TestMigrateProposal(t *testing.T) {
 	key, err := hex.DecodeString("636F6E666967")
 	require.NoError(t, err)
 	m := types.Model{Key: key, Value: []byte(`{"verifier":"AAAAAAAAAAAAAAAAAAAAAAAAAAA=","beneficiary":"AAAAAAAAAAAAAAAAAAAAAAAAAAA=","funder":"AQEBAQEBAQEBAQEBAQEBAQEBAQE="}`)}
-	require.NoError(t, wasmKeeper.importContract(ctx, contractAddr, &contractInfo, []types.Model{m}, entries))
+	require.NoError(t, wasmKeeper.importContract(ctx, contractAddr, &contractInfo, []types.Model{m}, entries, nil))
 
 	migMsg := struct {
 		Verifier sdk.AccAddress `json:"verifier"`
@@ -416,6 +428,8 @@ func TestMigrateProposal(t *testing.T) {
 		CodeID:    src.CodeID,
 		Updated:   types.NewAbsoluteTxPosition(ctx),
 		Msg:       src.Msg,
+		Sender:    authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Admin:     anyAddress.String(),
 	}}
 	assert.Equal(t, expHistory, wasmKeeper.GetContractHistory(ctx, contractAddr))
 	// and events emitted
@@ -615,7 +629,7 @@ func TestAdminProposals(t *testing.T) {
 				},
 			}
 
-			require.NoError(t, wasmKeeper.importContract(ctx, contractAddr, &spec.state, []types.Model{}, entries))
+			require.NoError(t, wasmKeeper.importContract(ctx, contractAddr, &spec.state, []types.Model{}, entries, nil))
 			// when stored
 			storedProposal, err := govKeeper.SubmitProposal(ctx, spec.srcProposal)
 			require.NoError(t, err)
@@ -633,6 +647,116 @@ func TestAdminProposals(t *testing.T) {
 	}
 }
 
+func TestDeleteContractProposal(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, "staking")
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	recipient := RandomAccountAddress(t)
+
+	src := types.DeleteContractProposalFixture(func(p *types.DeleteContractProposal) {
+		p.Contract = example.Contract.String()
+		p.Recipient = recipient.String()
+	})
+
+	// when stored
+	storedProposal, err := govKeeper.SubmitProposal(ctx, src)
+	require.NoError(t, err)
+
+	// and proposal execute
+	handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+	err = handler(ctx, storedProposal.GetContent())
+	require.NoError(t, err)
+
+	// then
+	assert.False(t, wasmKeeper.HasContractInfo(ctx, example.Contract))
+	assert.Equal(t, example.Deposit, keepers.BankKeeper.GetAllBalances(ctx, recipient))
+}
+
+func TestRemoveCodeProposal(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, "staking")
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+
+	src := types.RemoveCodeProposalFixture(func(p *types.RemoveCodeProposal) {
+		p.CodeID = example.CodeID
+	})
+
+	// when stored
+	storedProposal, err := govKeeper.SubmitProposal(ctx, src)
+	require.NoError(t, err)
+
+	// and proposal execute
+	handler := govKeeper.Router().GetRoute(storedProposal.ProposalRoute())
+	err = handler(ctx, storedProposal.GetContent())
+	require.NoError(t, err)
+
+	// then
+	assert.Nil(t, wasmKeeper.GetCodeInfo(ctx, example.CodeID))
+}
+
+func TestFreezeContractProposal(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, "staking")
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+	var mock wasmtesting.MockWasmer
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	handler := govKeeper.Router().GetRoute(types.RouterKey)
+
+	// when freeze proposal executed
+	src := types.FreezeContractProposalFixture(func(p *types.FreezeContractProposal) {
+		p.Contract = example.Contract.String()
+	})
+	storedProposal, err := govKeeper.SubmitProposal(ctx, src)
+	require.NoError(t, err)
+	require.NoError(t, handler(ctx, storedProposal.GetContent()))
+	// then
+	assert.True(t, wasmKeeper.GetContractInfo(ctx, example.Contract).IsFrozen)
+
+	// when unfreeze proposal executed
+	unfreezeSrc := types.UnfreezeContractProposalFixture(func(p *types.UnfreezeContractProposal) {
+		p.Contract = example.Contract.String()
+	})
+	storedProposal, err = govKeeper.SubmitProposal(ctx, unfreezeSrc)
+	require.NoError(t, err)
+	require.NoError(t, handler(ctx, storedProposal.GetContent()))
+	// then
+	assert.False(t, wasmKeeper.GetContractInfo(ctx, example.Contract).IsFrozen)
+}
+
+func TestRegisterCronJobProposal(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, "staking")
+	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
+	var mock wasmtesting.MockWasmer
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	handler := govKeeper.Router().GetRoute(types.RouterKey)
+
+	// when register proposal executed
+	src := types.RegisterCronJobProposalFixture(func(p *types.RegisterCronJobProposal) {
+		p.Contract = example.Contract.String()
+	})
+	storedProposal, err := govKeeper.SubmitProposal(ctx, src)
+	require.NoError(t, err)
+	require.NoError(t, handler(ctx, storedProposal.GetContent()))
+	// then
+	gotJob := wasmKeeper.GetCronJob(ctx, example.Contract)
+	require.NotNil(t, gotJob)
+	assert.Equal(t, src.Interval, gotJob.Interval)
+	assert.Equal(t, src.GasLimit, gotJob.GasLimit)
+	assert.Equal(t, src.Msg, gotJob.Msg)
+	assert.Equal(t, src.MaxFailures, gotJob.MaxFailures)
+
+	// when deregister proposal executed
+	deregisterSrc := types.DeregisterCronJobProposalFixture(func(p *types.DeregisterCronJobProposal) {
+		p.Contract = example.Contract.String()
+	})
+	storedProposal, err = govKeeper.SubmitProposal(ctx, deregisterSrc)
+	require.NoError(t, err)
+	require.NoError(t, handler(ctx, storedProposal.GetContent()))
+	// then
+	assert.Nil(t, wasmKeeper.GetCronJob(ctx, example.Contract))
+}
+
 func TestUpdateParamsProposal(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, "staking")
 	govKeeper, wasmKeeper := keepers.GovKeeper, keepers.WasmKeeper
@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/binary"
+	"encoding/hex"
 	"runtime/debug"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -13,6 +16,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
@@ -24,6 +29,10 @@ type grpcQuerier struct {
 	storeKey      sdk.StoreKey
 	keeper        types.ViewKeeper
 	queryGasLimit sdk.Gas
+	// simulator executes the contract simulation queries. Simulations are not supported when nil
+	simulator *Keeper
+	// eventBroker publishes the contract events to subscribers. Subscriptions are not supported when nil
+	eventBroker *ContractEventBroker
 }
 
 // NewGrpcQuerier constructor
@@ -58,16 +67,27 @@ func (q grpcQuerier) ContractHistory(c context.Context, req *types.QueryContract
 		return nil, err
 	}
 
+	operations := make(map[types.ContractCodeHistoryOperationType]struct{}, len(req.Operations))
+	for _, o := range req.Operations {
+		if _, ok := types.ContractCodeHistoryOperationType_name[int32(o)]; !ok || o == types.ContractCodeHistoryOperationTypeUnspecified {
+			return nil, status.Errorf(codes.InvalidArgument, "operation: %s", o)
+		}
+		operations[o] = struct{}{}
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
 	r := make([]types.ContractCodeHistoryEntry, 0)
 
 	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetContractCodeHistoryElementPrefix(contractAddr))
 	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var e types.ContractCodeHistoryEntry
+		if err := q.cdc.Unmarshal(value, &e); err != nil {
+			return false, err
+		}
+		if _, ok := operations[e.Operation]; len(operations) != 0 && !ok {
+			return false, nil
+		}
 		if accumulate {
-			var e types.ContractCodeHistoryEntry
-			if err := q.cdc.Unmarshal(value, &e); err != nil {
-				return false, err
-			}
 			r = append(r, e)
 		}
 		return true, nil
@@ -142,6 +162,84 @@ func (q grpcQuerier) AllContractState(c context.Context, req *types.QueryAllCont
 	}, nil
 }
 
+func (q grpcQuerier) ContractStateRange(c context.Context, req *types.QueryContractStateRangeRequest) (*types.QueryContractStateRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	start, end := req.Start, req.End
+	if req.StartExclusive && start != nil {
+		start = nextKey(start)
+	}
+	if req.EndInclusive && end != nil {
+		end = nextKey(end)
+	}
+	if start != nil && end != nil && bytes.Compare(start, end) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "start after end")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if !q.keeper.HasContractInfo(ctx, contractAddr) {
+		return nil, types.ErrNotFound
+	}
+
+	r := make([]types.Model, 0)
+	contractStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetContractStorePrefix(contractAddr))
+	rangeStore := boundedStore{KVStore: prefix.NewStore(contractStore, req.Prefix), start: start, end: end}
+	pageRes, err := query.Paginate(rangeStore, req.Pagination, func(key []byte, value []byte) error {
+		m := types.Model{Key: append(append([]byte{}, req.Prefix...), key...)}
+		if !req.KeysOnly {
+			m.Value = value
+		}
+		r = append(r, m)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryContractStateRangeResponse{
+		Models:     r,
+		Pagination: pageRes,
+	}, nil
+}
+
+// nextKey returns the smallest key that is greater than the given one
+func nextKey(key []byte) []byte {
+	return append(append(make([]byte, 0, len(key)+1), key...), 0)
+}
+
+// boundedStore restricts all iterators to the [start, end) range. A nil bound is open.
+type boundedStore struct {
+	sdk.KVStore
+	start, end []byte
+}
+
+func (b boundedStore) Iterator(start, end []byte) sdk.Iterator {
+	start, end = b.clamp(start, end)
+	return b.KVStore.Iterator(start, end)
+}
+
+func (b boundedStore) ReverseIterator(start, end []byte) sdk.Iterator {
+	start, end = b.clamp(start, end)
+	return b.KVStore.ReverseIterator(start, end)
+}
+
+func (b boundedStore) clamp(start, end []byte) ([]byte, []byte) {
+	if b.start != nil && (start == nil || bytes.Compare(start, b.start) < 0) {
+		start = b.start
+	}
+	if b.end != nil && (end == nil || bytes.Compare(end, b.end) > 0) {
+		end = b.end
+	}
+	if start != nil && end != nil && bytes.Compare(start, end) > 0 {
+		// empty range
+		start = end
+	}
+	return start, end
+}
+
 func (q grpcQuerier) RawContractState(c context.Context, req *types.QueryRawContractStateRequest) (*types.QueryRawContractStateResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -160,7 +258,7 @@ func (q grpcQuerier) RawContractState(c context.Context, req *types.QueryRawCont
 	return &types.QueryRawContractStateResponse{Data: rsp}, nil
 }
 
-func (q grpcQuerier) SmartContractState(c context.Context, req *types.QuerySmartContractStateRequest) (rsp *types.QuerySmartContractStateResponse, err error) {
+func (q grpcQuerier) SmartContractState(c context.Context, req *types.QuerySmartContractStateRequest) (*types.QuerySmartContractStateResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
@@ -171,36 +269,240 @@ func (q grpcQuerier) SmartContractState(c context.Context, req *types.QuerySmart
 	if err != nil {
 		return nil, err
 	}
-	ctx := sdk.UnwrapSDKContext(c).WithGasMeter(sdk.NewGasMeter(q.queryGasLimit))
+	ctx := sdk.UnwrapSDKContext(c)
+	bz, _, err := q.querySmart(ctx, contractAddr, req.QueryData, q.queryGasLimit)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySmartContractStateResponse{Data: bz}, nil
+}
+
+// maxBatchSmartQueries is the max number of queries accepted within a single batch request
+const maxBatchSmartQueries = 50
+
+func (q grpcQuerier) BatchSmartContractState(c context.Context, req *types.QueryBatchSmartContractStateRequest) (*types.QueryBatchSmartContractStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	switch n := len(req.Queries); {
+	case n == 0:
+		return nil, status.Error(codes.InvalidArgument, "empty queries")
+	case n > maxBatchSmartQueries:
+		return nil, status.Errorf(codes.InvalidArgument, "too many queries: max %d", maxBatchSmartQueries)
+	}
+	// all queries are executed on the same context so that they read from the same store version.
+	// The gas limit is shared between them.
+	ctx := sdk.UnwrapSDKContext(c)
+	totalGas := sdk.NewGasMeter(q.queryGasLimit)
+	results := make([]types.BatchSmartQueryResult, len(req.Queries))
+	for i, query := range req.Queries {
+		if err := query.QueryData.ValidateBasic(); err != nil {
+			results[i].Error = "invalid query data"
+			continue
+		}
+		contractAddr, err := sdk.AccAddressFromBech32(query.Address)
+		if err != nil {
+			results[i].Error = sdkerrors.Wrap(err, "address").Error()
+			continue
+		}
+		remaining := totalGas.Limit() - totalGas.GasConsumedToLimit()
+		if remaining == 0 {
+			results[i].Error = sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "batch gas limit exceeded").Error()
+			continue
+		}
+		bz, gasUsed, err := q.querySmart(ctx, contractAddr, query.QueryData, remaining)
+		totalGas.ConsumeGas(gasUsed, "batch smart query")
+		results[i].GasUsed = gasUsed
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		results[i].Data = bz
+	}
+	return &types.QueryBatchSmartContractStateResponse{Results: results, GasUsed: totalGas.GasConsumedToLimit()}, nil
+}
+
+// querySmart executes a smart query with its own gas meter and the given limit.
+// Out-of-gas and other panics are recovered and returned as error. The gas consumed, capped by the
+// limit, is returned in any case.
+func (q grpcQuerier) querySmart(parentCtx sdk.Context, contractAddr sdk.AccAddress, queryData []byte, gasLimit sdk.Gas) (bz []byte, gasUsed sdk.Gas, err error) {
+	ctx := parentCtx.WithGasMeter(sdk.NewGasMeter(gasLimit))
 	// recover from out-of-gas panic
 	defer func() {
+		// set for all return paths
+		gasUsed = ctx.GasMeter().GasConsumedToLimit()
 		if r := recover(); r != nil {
-			switch rType := r.(type) {
-			case sdk.ErrorOutOfGas:
-				err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas,
-					"out of gas in location: %v; gasWanted: %d, gasUsed: %d",
-					rType.Descriptor, ctx.GasMeter().Limit(), ctx.GasMeter().GasConsumed(),
-				)
-			default:
-				err = sdkerrors.ErrPanic
-			}
-			rsp = nil
+			err = panicToError(ctx, r)
+			bz = nil
 			moduleLogger(ctx).
 				Debug("smart query contract",
 					"error", "recovering panic",
-					"contract-address", req.Address,
+					"contract-address", contractAddr.String(),
 					"stacktrace", string(debug.Stack()))
 		}
 	}()
 
-	bz, err := q.keeper.QuerySmart(ctx, contractAddr, req.QueryData)
+	bz, err = q.keeper.QuerySmart(ctx, contractAddr, queryData)
 	switch {
 	case err != nil:
-		return nil, err
+		return nil, 0, err
 	case bz == nil:
-		return nil, types.ErrNotFound
+		return nil, 0, types.ErrNotFound
 	}
-	return &types.QuerySmartContractStateResponse{Data: bz}, nil
+	return bz, 0, nil
+}
+
+func (q grpcQuerier) SimulateExecuteContract(c context.Context, req *types.QuerySimulateExecuteContractRequest) (*types.QuerySimulateExecuteContractResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := req.Msg.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	senderAddr, err := sdk.AccAddressFromBech32(req.Msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+	result, err := q.simulate(c, req.Msg.Sender, req.Trace, func(ctx sdk.Context, k types.ContractOpsKeeper) ([]byte, error) {
+		return k.Execute(ctx, contractAddr, senderAddr, req.Msg.Msg, req.Msg.Funds)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySimulateExecuteContractResponse{Result: *result}, nil
+}
+
+func (q grpcQuerier) SimulateInstantiateContract(c context.Context, req *types.QuerySimulateInstantiateContractRequest) (*types.QuerySimulateInstantiateContractResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := req.Msg.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(req.Salt) != 0 {
+		if err := types.ValidateSalt(req.Salt); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	senderAddr, err := sdk.AccAddressFromBech32(req.Msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	var adminAddr sdk.AccAddress
+	if req.Msg.Admin != "" {
+		if adminAddr, err = sdk.AccAddressFromBech32(req.Msg.Admin); err != nil {
+			return nil, sdkerrors.Wrap(err, "admin")
+		}
+	}
+	var contractAddr sdk.AccAddress
+	result, err := q.simulate(c, req.Msg.Sender, req.Trace, func(ctx sdk.Context, k types.ContractOpsKeeper) (data []byte, err error) {
+		if len(req.Salt) == 0 {
+			contractAddr, data, err = k.Instantiate(ctx, req.Msg.CodeID, senderAddr, adminAddr, req.Msg.Msg, req.Msg.Label, req.Msg.Funds)
+		} else {
+			contractAddr, data, err = k.Instantiate2(ctx, req.Msg.CodeID, senderAddr, adminAddr, req.Msg.Msg, req.Msg.Label, req.Msg.Funds, req.Salt, req.FixMsg)
+		}
+		return data, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySimulateInstantiateContractResponse{Address: contractAddr.String(), Result: *result}, nil
+}
+
+func (q grpcQuerier) SimulateMigrateContract(c context.Context, req *types.QuerySimulateMigrateContractRequest) (*types.QuerySimulateMigrateContractResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := req.Msg.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	senderAddr, err := sdk.AccAddressFromBech32(req.Msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Msg.Contract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "contract")
+	}
+	result, err := q.simulate(c, req.Msg.Sender, req.Trace, func(ctx sdk.Context, k types.ContractOpsKeeper) ([]byte, error) {
+		return k.Migrate(ctx, contractAddr, senderAddr, req.Msg.CodeID, req.Msg.Msg)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySimulateMigrateContractResponse{Result: *result}, nil
+}
+
+// simulate runs the contract operation on a cached context that is never committed.
+// The gov module account as sender executes the operation with the governance authorization policy.
+// When tracing, the call tree is recorded and a failure is returned within the result.
+func (q grpcQuerier) simulate(c context.Context, sender string, trace bool, op func(ctx sdk.Context, k types.ContractOpsKeeper) ([]byte, error)) (result *types.SimulationResult, err error) {
+	if q.simulator == nil {
+		return nil, status.Error(codes.Unimplemented, "simulation not supported")
+	}
+	var contractKeeper types.ContractOpsKeeper = NewDefaultPermissionKeeper(q.simulator)
+	if sender == authtypes.NewModuleAddress(govtypes.ModuleName).String() {
+		contractKeeper = NewGovPermissionKeeper(q.simulator)
+	}
+	parentCtx := sdk.UnwrapSDKContext(c)
+	cacheCtx, _ := parentCtx.CacheContext() // state is never written back
+	recorder := &dispatchRecorder{}
+	ctx := cacheCtx.WithGasMeter(sdk.NewGasMeter(q.simulator.SimulationGasLimit(parentCtx))).
+		WithEventManager(sdk.NewEventManager()).
+		WithContext(context.WithValue(cacheCtx.Context(), contextKeyDispatchRecorder, recorder))
+	var tracer *callTracer
+	if trace || q.simulator.debugMode {
+		tracer = newCallTracer(q.simulator.debugMode)
+		ctx = withCallTracer(ctx, tracer)
+	}
+	// recover from out-of-gas panic
+	defer func() {
+		if r := recover(); r != nil {
+			err = panicToError(ctx, r)
+			result = nil
+			if trace {
+				result = &types.SimulationResult{Trace: tracer.root, Error: err.Error(), GasUsed: ctx.GasMeter().GasConsumedToLimit()}
+				err = nil
+			}
+			moduleLogger(ctx).
+				Debug("simulate contract operation",
+					"error", "recovering panic",
+					"stacktrace", string(debug.Stack()))
+		}
+	}()
+
+	data, err := op(ctx, contractKeeper)
+	if err != nil {
+		if !trace {
+			return nil, err
+		}
+		return &types.SimulationResult{Trace: tracer.root, Error: err.Error(), GasUsed: ctx.GasMeter().GasConsumed()}, nil
+	}
+	result = &types.SimulationResult{
+		Data:     data,
+		Events:   ctx.EventManager().ABCIEvents(),
+		Messages: recorder.msgs,
+		GasUsed:  ctx.GasMeter().GasConsumed(),
+	}
+	if tracer != nil {
+		result.Trace = tracer.root
+	}
+	return result, nil
+}
+
+// panicToError converts a recovered panic into an error
+func panicToError(ctx sdk.Context, r interface{}) error {
+	if rType, ok := r.(sdk.ErrorOutOfGas); ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrOutOfGas,
+			"out of gas in location: %v; gasWanted: %d, gasUsed: %d",
+			rType.Descriptor, ctx.GasMeter().Limit(), ctx.GasMeter().GasConsumed(),
+		)
+	}
+	return sdkerrors.ErrPanic
 }
 
 func (q grpcQuerier) Code(c context.Context, req *types.QueryCodeRequest) (*types.QueryCodeResponse, error) {
@@ -241,6 +543,10 @@ func (q grpcQuerier) Codes(c context.Context, req *types.QueryCodesRequest) (*ty
 				Creator:               c.Creator,
 				DataHash:              c.CodeHash,
 				InstantiatePermission: c.InstantiateConfig,
+				Source:                c.Source,
+				Builder:               c.Builder,
+				Created:               c.Created,
+				WasmSize:              c.WasmSize,
 			})
 		}
 		return true, nil
@@ -251,6 +557,60 @@ func (q grpcQuerier) Codes(c context.Context, req *types.QueryCodesRequest) (*ty
 	return &types.QueryCodesResponse{CodeInfos: r, Pagination: pageRes}, nil
 }
 
+func (q grpcQuerier) ContractStorageStats(c context.Context, req *types.QueryContractStorageStatsRequest) (*types.QueryContractStorageStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if !q.keeper.HasContractInfo(ctx, contractAddr) {
+		return nil, types.ErrNotFound
+	}
+	return &types.QueryContractStorageStatsResponse{
+		Stats: q.keeper.GetContractStorageStats(ctx, contractAddr),
+	}, nil
+}
+
+func (q grpcQuerier) DisabledOperations(c context.Context, req *types.QueryDisabledOperationsRequest) (*types.QueryDisabledOperationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryDisabledOperationsResponse{
+		Operations: q.keeper.GetDisabledOperations(ctx),
+	}, nil
+}
+
+func (q grpcQuerier) CronJobs(c context.Context, req *types.QueryCronJobsRequest) (*types.QueryCronJobsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]types.CronJob, 0)
+
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.CronJobPrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var job types.CronJob
+			if err := q.cdc.Unmarshal(value, &job); err != nil {
+				return false, err
+			}
+			r = append(r, job)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryCronJobsResponse{
+		CronJobs:   r,
+		Pagination: pageRes,
+	}, nil
+}
+
 func queryContractInfo(ctx sdk.Context, addr sdk.AccAddress, keeper types.ViewKeeper) (*types.QueryContractInfoResponse, error) {
 	info := keeper.GetContractInfo(ctx, addr)
 	if info == nil {
@@ -259,6 +619,7 @@ func queryContractInfo(ctx sdk.Context, addr sdk.AccAddress, keeper types.ViewKe
 	return &types.QueryContractInfoResponse{
 		Address:      addr.String(),
 		ContractInfo: *info,
+		StorageStats: keeper.GetContractStorageStats(ctx, addr),
 	}, nil
 }
 
@@ -276,6 +637,10 @@ func queryCode(ctx sdk.Context, codeID uint64, keeper types.ViewKeeper) (*types.
 		Creator:               res.Creator,
 		DataHash:              res.CodeHash,
 		InstantiatePermission: res.InstantiateConfig,
+		Source:                res.Source,
+		Builder:               res.Builder,
+		Created:               res.Created,
+		WasmSize:              res.WasmSize,
 	}
 
 	code, err := keeper.GetByteCode(ctx, codeID)
@@ -344,3 +709,168 @@ func (q grpcQuerier) ContractsByCreator(c context.Context, req *types.QueryContr
 		Pagination:        pageRes,
 	}, nil
 }
+
+func (q grpcQuerier) ContractsByAdmin(c context.Context, req *types.QueryContractsByAdminRequest) (*types.QueryContractsByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	contracts := make([]string, 0)
+
+	adminAddress, err := sdk.AccAddressFromBech32(req.AdminAddress)
+	if err != nil {
+		return nil, err
+	}
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetContractsByAdminPrefix(adminAddress))
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			contracts = append(contracts, sdk.AccAddress(key).String())
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractsByAdminResponse{
+		ContractAddresses: contracts,
+		Pagination:        pageRes,
+	}, nil
+}
+
+// ContractsByLabel lists the contracts with the given label or all labels starting with it, optionally scoped to a
+// creator
+func (q grpcQuerier) ContractsByLabel(c context.Context, req *types.QueryContractsByLabelRequest) (*types.QueryContractsByLabelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Label == "" && !req.Prefix {
+		return nil, status.Error(codes.InvalidArgument, "empty label")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	scope := q.keeper.GetLabelIndexScope(ctx)
+	keyPrefix := types.GetContractsByLabelPrefix(req.Label)
+	if req.CreatorAddress != "" {
+		creatorAddress, err := sdk.AccAddressFromBech32(req.CreatorAddress)
+		if err != nil {
+			return nil, err
+		}
+		keyPrefix = types.GetContractsByCreatorLabelPrefix(creatorAddress, req.Label)
+	} else if scope != types.LabelIndexScopeGlobal {
+		return nil, status.Error(codes.FailedPrecondition, "contracts are not indexed by label globally")
+	}
+	if scope == types.LabelIndexScopeNone {
+		return nil, status.Error(codes.FailedPrecondition, "contracts are not indexed by label")
+	}
+	contracts := make([]types.LabeledContract, 0)
+
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), keyPrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		labelSuffix, contractAddr, err := types.ParseLabelIndexKey(key)
+		if err != nil {
+			return false, err
+		}
+		if labelSuffix != "" && !req.Prefix {
+			return false, nil
+		}
+		if accumulate {
+			contracts = append(contracts, types.LabeledContract{Address: contractAddr.String(), Label: req.Label + labelSuffix})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractsByLabelResponse{
+		Contracts:  contracts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// SubscribeContractEvents streams the contract events that match the request until the client disconnects
+func (q grpcQuerier) SubscribeContractEvents(req *types.QuerySubscribeContractEventsRequest, stream types.Query_SubscribeContractEventsServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "empty request")
+	}
+	if q.eventBroker == nil {
+		return status.Error(codes.Unimplemented, "contract event subscriptions not enabled")
+	}
+	sub, err := q.eventBroker.subscribe(req)
+	if err != nil {
+		return err
+	}
+	defer q.eventBroker.unsubscribe(sub)
+	for {
+		select {
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		case evt, ok := <-sub.events:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "subscriber dropped for not keeping up with the events")
+			}
+			if err := stream.Send(evt); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (q grpcQuerier) ContractCallbacks(c context.Context, req *types.QueryContractCallbacksRequest) (*types.QueryContractCallbacksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]types.Callback, 0)
+
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetContractCallbacksPrefix(contractAddr))
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			if callback := q.keeper.GetCallback(ctx, contractAddr, binary.BigEndian.Uint64(key)); callback != nil {
+				r = append(r, *callback)
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryContractCallbacksResponse{
+		Callbacks:  r,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q grpcQuerier) CodeIDsByChecksum(c context.Context, req *types.QueryCodeIDsByChecksumRequest) (*types.QueryCodeIDsByChecksumResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	checksum, err := hex.DecodeString(req.Checksum)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "checksum: "+err.Error())
+	}
+	if len(checksum) != sha256.Size {
+		return nil, status.Error(codes.InvalidArgument, "invalid checksum length")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	r := make([]uint64, 0)
+
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetCodeIDsByChecksumPrefix(checksum))
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			r = append(r, sdk.BigEndianToUint64(key))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryCodeIDsByChecksumResponse{
+		CodeIDs:    r,
+		Pagination: pageRes,
+	}, nil
+}
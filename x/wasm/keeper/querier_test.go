@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -17,6 +20,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -201,6 +206,505 @@ func TestQuerySmartContractPanics(t *testing.T) {
 	}
 }
 
+func TestQueryBatchSmartContractState(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	exampleContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	contractAddr := exampleContract.Contract.String()
+	verifierQuery := types.BatchSmartQuery{Address: contractAddr, QueryData: []byte(`{"verifier":{}}`)}
+	expVerifierResp := fmt.Sprintf(`{"verifier":"%s"}`, exampleContract.VerifierAddr.String())
+
+	tooManyQueries := make([]types.BatchSmartQuery, maxBatchSmartQueries+1)
+	for i := range tooManyQueries {
+		tooManyQueries[i] = verifierQuery
+	}
+
+	q := Querier(keeper)
+	specs := map[string]struct {
+		srcQuery *types.QueryBatchSmartContractStateRequest
+		expResp  []string
+		expErrs  []string
+		expErr   error
+	}{
+		"multiple queries": {
+			srcQuery: &types.QueryBatchSmartContractStateRequest{Queries: []types.BatchSmartQuery{verifierQuery, verifierQuery}},
+			expResp:  []string{expVerifierResp, expVerifierResp},
+			expErrs:  []string{"", ""},
+		},
+		"errors reported per query": {
+			srcQuery: &types.QueryBatchSmartContractStateRequest{Queries: []types.BatchSmartQuery{
+				{Address: contractAddr, QueryData: []byte(`{"raw":{"key":"config"}}`)},
+				{Address: contractAddr, QueryData: []byte(`not a json string`)},
+				{Address: "not an address", QueryData: []byte(`{"verifier":{}}`)},
+				{Address: RandomBech32AccountAddress(t), QueryData: []byte(`{"verifier":{}}`)},
+				verifierQuery,
+			}},
+			expResp: []string{"", "", "", "", expVerifierResp},
+			expErrs: []string{"query wasm contract failed", "invalid query data", "address", types.ErrNotFound.Error(), ""},
+		},
+		"empty queries": {
+			srcQuery: &types.QueryBatchSmartContractStateRequest{},
+			expErr:   status.Error(codes.InvalidArgument, "empty queries"),
+		},
+		"too many queries": {
+			srcQuery: &types.QueryBatchSmartContractStateRequest{Queries: tooManyQueries},
+			expErr:   status.Errorf(codes.InvalidArgument, "too many queries: max %d", maxBatchSmartQueries),
+		},
+		"nil request": {
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := q.BatchSmartContractState(sdk.WrapSDKContext(ctx), spec.srcQuery)
+			require.True(t, errors.Is(err, spec.expErr), "but got %+v", err)
+			if spec.expErr != nil {
+				return
+			}
+			require.Len(t, got.Results, len(spec.expResp))
+			var totalGas uint64
+			for i, r := range got.Results {
+				totalGas += r.GasUsed
+				if spec.expErrs[i] != "" {
+					assert.Contains(t, r.Error, spec.expErrs[i])
+					assert.Nil(t, r.Data)
+					continue
+				}
+				assert.Empty(t, r.Error)
+				assert.JSONEq(t, spec.expResp[i], string(r.Data))
+				assert.NotZero(t, r.GasUsed)
+			}
+			assert.Equal(t, totalGas, got.GasUsed)
+		})
+	}
+}
+
+func TestQueryBatchSmartContractStateGasLimit(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	exampleContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	verifierQuery := types.BatchSmartQuery{Address: exampleContract.Contract.String(), QueryData: []byte(`{"verifier":{}}`)}
+	req := &types.QueryBatchSmartContractStateRequest{Queries: []types.BatchSmartQuery{verifierQuery, verifierQuery, verifierQuery}}
+
+	// measure the gas of a single query
+	got, err := Querier(keeper).BatchSmartContractState(sdk.WrapSDKContext(ctx), &types.QueryBatchSmartContractStateRequest{Queries: req.Queries[0:1]})
+	require.NoError(t, err)
+	singleQueryGas := got.GasUsed
+	require.NotZero(t, singleQueryGas)
+
+	// when the limit is enough for only 1.5 queries
+	gasLimit := singleQueryGas + singleQueryGas/2
+	q := NewGrpcQuerier(keeper.cdc, keeper.storeKey, keeper, gasLimit)
+	got, err = q.BatchSmartContractState(sdk.WrapSDKContext(ctx), req)
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, got.Results, 3)
+	assert.Empty(t, got.Results[0].Error)
+	assert.NotNil(t, got.Results[0].Data)
+	assert.Equal(t, singleQueryGas, got.Results[0].GasUsed)
+
+	assert.Contains(t, got.Results[1].Error, sdkErrors.ErrOutOfGas.Error())
+	assert.Nil(t, got.Results[1].Data)
+	assert.Equal(t, gasLimit-singleQueryGas, got.Results[1].GasUsed)
+
+	assert.Contains(t, got.Results[2].Error, "batch gas limit exceeded")
+	assert.Zero(t, got.Results[2].GasUsed)
+	assert.Equal(t, gasLimit, got.GasUsed)
+}
+
+func TestQueryContractStateRange(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	contractAddr := BuildContractAddressClassic(1, 1)
+	keeper.storeCodeInfo(ctx, 1, types.CodeInfo{})
+	keeper.storeContractInfo(ctx, contractAddr, &types.ContractInfo{CodeID: 1, Created: types.NewAbsoluteTxPosition(ctx)})
+	require.NoError(t, keeper.importContractState(ctx, contractAddr, []types.Model{
+		{Key: []byte("a1"), Value: []byte(`1`)},
+		{Key: []byte("a2"), Value: []byte(`2`)},
+		{Key: []byte("a3"), Value: []byte(`3`)},
+		{Key: []byte("b1"), Value: []byte(`4`)},
+	}))
+
+	q := Querier(keeper)
+	specs := map[string]struct {
+		srcQuery   *types.QueryContractStateRangeRequest
+		expKeys    []string
+		expNextKey []byte
+		expTotal   uint64
+		keysOnly   bool
+		expErr     error
+	}{
+		"all": {
+			srcQuery: &types.QueryContractStateRangeRequest{},
+			expKeys:  []string{"a1", "a2", "a3", "b1"},
+		},
+		"prefix": {
+			srcQuery: &types.QueryContractStateRangeRequest{Prefix: []byte("a")},
+			expKeys:  []string{"a1", "a2", "a3"},
+		},
+		"prefix without match": {
+			srcQuery: &types.QueryContractStateRangeRequest{Prefix: []byte("c")},
+			expKeys:  []string{},
+		},
+		"start inclusive": {
+			srcQuery: &types.QueryContractStateRangeRequest{Prefix: []byte("a"), Start: []byte("2")},
+			expKeys:  []string{"a2", "a3"},
+		},
+		"start exclusive": {
+			srcQuery: &types.QueryContractStateRangeRequest{Prefix: []byte("a"), Start: []byte("2"), StartExclusive: true},
+			expKeys:  []string{"a3"},
+		},
+		"end exclusive": {
+			srcQuery: &types.QueryContractStateRangeRequest{Prefix: []byte("a"), End: []byte("2")},
+			expKeys:  []string{"a1"},
+		},
+		"end inclusive": {
+			srcQuery: &types.QueryContractStateRangeRequest{Prefix: []byte("a"), End: []byte("2"), EndInclusive: true},
+			expKeys:  []string{"a1", "a2"},
+		},
+		"start and end without prefix": {
+			srcQuery: &types.QueryContractStateRangeRequest{Start: []byte("a2"), End: []byte("b1"), EndInclusive: true},
+			expKeys:  []string{"a2", "a3", "b1"},
+		},
+		"start equals end": {
+			srcQuery: &types.QueryContractStateRangeRequest{Start: []byte("a2"), End: []byte("a2")},
+			expKeys:  []string{},
+		},
+		"reverse": {
+			srcQuery: &types.QueryContractStateRangeRequest{Prefix: []byte("a"), Pagination: &query.PageRequest{Reverse: true}},
+			expKeys:  []string{"a3", "a2", "a1"},
+		},
+		"reverse with bounds": {
+			srcQuery: &types.QueryContractStateRangeRequest{Start: []byte("a2"), End: []byte("b1"), Pagination: &query.PageRequest{Reverse: true}},
+			expKeys:  []string{"a3", "a2"},
+		},
+		"keys only": {
+			srcQuery: &types.QueryContractStateRangeRequest{Prefix: []byte("b"), KeysOnly: true},
+			expKeys:  []string{"b1"},
+			keysOnly: true,
+		},
+		"with pagination limit": {
+			srcQuery:   &types.QueryContractStateRangeRequest{Prefix: []byte("a"), Start: []byte("2"), Pagination: &query.PageRequest{Limit: 1, CountTotal: true}},
+			expKeys:    []string{"a2"},
+			expNextKey: []byte("3"),
+			expTotal:   2,
+		},
+		"with pagination next key": {
+			srcQuery: &types.QueryContractStateRangeRequest{Prefix: []byte("a"), End: []byte("3"), Pagination: &query.PageRequest{Key: []byte("2")}},
+			expKeys:  []string{"a2"},
+		},
+		"with pagination next key before start": {
+			srcQuery: &types.QueryContractStateRangeRequest{Prefix: []byte("a"), Start: []byte("2"), Pagination: &query.PageRequest{Key: []byte("1")}},
+			expKeys:  []string{"a2", "a3"},
+		},
+		"with pagination offset": {
+			srcQuery: &types.QueryContractStateRangeRequest{Start: []byte("a2"), Pagination: &query.PageRequest{Offset: 1}},
+			expKeys:  []string{"a3", "b1"},
+		},
+		"start after end": {
+			srcQuery: &types.QueryContractStateRangeRequest{Start: []byte("b"), End: []byte("a")},
+			expErr:   status.Error(codes.InvalidArgument, "start after end"),
+		},
+		"unknown address": {
+			srcQuery: &types.QueryContractStateRangeRequest{Address: RandomBech32AccountAddress(t)},
+			expErr:   types.ErrNotFound,
+		},
+		"nil request": {
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			if spec.srcQuery != nil && spec.srcQuery.Address == "" {
+				spec.srcQuery.Address = contractAddr.String()
+			}
+			got, err := q.ContractStateRange(sdk.WrapSDKContext(ctx), spec.srcQuery)
+			require.True(t, errors.Is(err, spec.expErr), "but got %+v", err)
+			if spec.expErr != nil {
+				return
+			}
+			gotKeys := make([]string, len(got.Models))
+			for i, m := range got.Models {
+				gotKeys[i] = string(m.Key)
+				if spec.keysOnly {
+					assert.Nil(t, m.Value)
+				} else {
+					assert.NotNil(t, m.Value)
+				}
+			}
+			assert.Equal(t, spec.expKeys, gotKeys)
+			assert.Equal(t, spec.expNextKey, got.Pagination.NextKey)
+			if spec.expTotal != 0 {
+				assert.Equal(t, spec.expTotal, got.Pagination.Total)
+			}
+		})
+	}
+}
+
+func TestQuerySimulateExecuteContract(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	q := Querier(keeper)
+	specs := map[string]struct {
+		srcMsg    types.MsgExecuteContract
+		expEvents []string
+		expMsgs   int
+		expErr    error
+	}{
+		"release funds": {
+			srcMsg: types.MsgExecuteContract{
+				Sender:   example.VerifierAddr.String(),
+				Contract: example.Contract.String(),
+				Msg:      []byte(`{"release":{}}`),
+			},
+			expEvents: []string{types.WasmModuleEventType, banktypes.EventTypeTransfer},
+			expMsgs:   1,
+		},
+		"unauthorized sender": {
+			srcMsg: types.MsgExecuteContract{
+				Sender:   example.BeneficiaryAddr.String(),
+				Contract: example.Contract.String(),
+				Msg:      []byte(`{"release":{}}`),
+			},
+			expErr: types.ErrExecuteFailed,
+		},
+		"unknown contract": {
+			srcMsg: types.MsgExecuteContract{
+				Sender:   example.VerifierAddr.String(),
+				Contract: RandomBech32AccountAddress(t),
+				Msg:      []byte(`{"release":{}}`),
+			},
+			expErr: types.ErrNotFound,
+		},
+		"invalid msg": {
+			srcMsg: types.MsgExecuteContract{
+				Sender:   example.VerifierAddr.String(),
+				Contract: example.Contract.String(),
+				Msg:      []byte(`not json`),
+			},
+			expErr: status.Error(codes.InvalidArgument, "payload msg: invalid"),
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := q.SimulateExecuteContract(sdk.WrapSDKContext(ctx), &types.QuerySimulateExecuteContractRequest{Msg: spec.srcMsg})
+			require.True(t, errors.Is(err, spec.expErr), "but got %+v", err)
+			if spec.expErr != nil {
+				return
+			}
+			gotEventTypes := make(map[string]struct{})
+			for _, e := range got.Result.Events {
+				gotEventTypes[e.Type] = struct{}{}
+			}
+			for _, e := range spec.expEvents {
+				assert.Contains(t, gotEventTypes, e)
+			}
+			require.Len(t, got.Result.Messages, spec.expMsgs)
+			assert.Equal(t, example.Contract.String(), got.Result.Messages[0].ContractAddress)
+			assert.Contains(t, string(got.Result.Messages[0].Msg), `"bank"`)
+			assert.NotZero(t, got.Result.GasUsed)
+			// and state not persisted
+			assert.Equal(t, example.Deposit, keepers.BankKeeper.GetAllBalances(ctx, example.Contract))
+			assert.True(t, keepers.BankKeeper.GetAllBalances(ctx, example.BeneficiaryAddr).Empty())
+		})
+	}
+}
+
+func TestQuerySimulateInstantiateContract(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	initMsg := HackatomExampleInitMsg{Verifier: RandomAccountAddress(t), Beneficiary: RandomAccountAddress(t)}.GetBytes(t)
+	q := Querier(keeper)
+	specs := map[string]struct {
+		srcReq  types.QuerySimulateInstantiateContractRequest
+		expAddr sdk.AccAddress
+		expErr  error
+	}{
+		"classic address": {
+			srcReq: types.QuerySimulateInstantiateContractRequest{Msg: types.MsgInstantiateContract{
+				Sender: example.CreatorAddr.String(),
+				CodeID: example.CodeID,
+				Label:  "my label",
+				Msg:    initMsg,
+			}},
+			expAddr: BuildContractAddressClassic(example.CodeID, 1),
+		},
+		"predictable address": {
+			srcReq: types.QuerySimulateInstantiateContractRequest{
+				Msg: types.MsgInstantiateContract{
+					Sender: example.CreatorAddr.String(),
+					CodeID: example.CodeID,
+					Label:  "my label",
+					Msg:    initMsg,
+				},
+				Salt: []byte("my salt"),
+			},
+			expAddr: BuildContractAddressPredictable(example.Checksum, example.CreatorAddr, []byte("my salt"), nil),
+		},
+		"predictable address with fix msg": {
+			srcReq: types.QuerySimulateInstantiateContractRequest{
+				Msg: types.MsgInstantiateContract{
+					Sender: example.CreatorAddr.String(),
+					CodeID: example.CodeID,
+					Label:  "my label",
+					Msg:    initMsg,
+				},
+				Salt:   []byte("my salt"),
+				FixMsg: true,
+			},
+			expAddr: BuildContractAddressPredictable(example.Checksum, example.CreatorAddr, []byte("my salt"), initMsg),
+		},
+		"unknown code": {
+			srcReq: types.QuerySimulateInstantiateContractRequest{Msg: types.MsgInstantiateContract{
+				Sender: example.CreatorAddr.String(),
+				CodeID: 999,
+				Label:  "my label",
+				Msg:    initMsg,
+			}},
+			expErr: types.ErrNotFound,
+		},
+		"contract fails": {
+			srcReq: types.QuerySimulateInstantiateContractRequest{Msg: types.MsgInstantiateContract{
+				Sender: example.CreatorAddr.String(),
+				CodeID: example.CodeID,
+				Label:  "my label",
+				Msg:    []byte(`{}`),
+			}},
+			expErr: types.ErrInstantiateFailed,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := q.SimulateInstantiateContract(sdk.WrapSDKContext(ctx), &spec.srcReq)
+			require.True(t, errors.Is(err, spec.expErr), "but got %+v", err)
+			if spec.expErr != nil {
+				return
+			}
+			assert.Equal(t, spec.expAddr.String(), got.Address)
+			assert.NotEmpty(t, got.Result.Events)
+			assert.NotZero(t, got.Result.GasUsed)
+			// and state not persisted
+			assert.False(t, keeper.HasContractInfo(ctx, spec.expAddr))
+		})
+	}
+}
+
+func TestQuerySimulateMigrateContract(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	newVerifierAddr := RandomAccountAddress(t)
+	migMsg := []byte(fmt.Sprintf(`{"verifier":%q}`, newVerifierAddr.String()))
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	q := Querier(keeper)
+	specs := map[string]struct {
+		sender sdk.AccAddress
+		expErr error
+	}{
+		"admin": {
+			sender: example.CreatorAddr,
+		},
+		"gov as proposal": {
+			sender: govAddr,
+		},
+		"non admin": {
+			sender: example.VerifierAddr,
+			expErr: sdkErrors.ErrUnauthorized,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := q.SimulateMigrateContract(sdk.WrapSDKContext(ctx), &types.QuerySimulateMigrateContractRequest{
+				Msg: types.MsgMigrateContract{
+					Sender:   spec.sender.String(),
+					Contract: example.Contract.String(),
+					CodeID:   example.CodeID,
+					Msg:      migMsg,
+				},
+			})
+			require.True(t, errors.Is(err, spec.expErr), "but got %+v", err)
+			if spec.expErr != nil {
+				return
+			}
+			assert.NotEmpty(t, got.Result.Events)
+			assert.NotZero(t, got.Result.GasUsed)
+			// and state not persisted
+			history := keeper.GetContractHistory(ctx, example.Contract)
+			assert.Len(t, history, 1)
+			raw := keeper.QueryRaw(ctx, example.Contract, []byte("config"))
+			assert.NotContains(t, string(raw), newVerifierAddr.String())
+		})
+	}
+}
+
+func TestQuerySimulateWithTrace(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	q := Querier(keeper)
+	specs := map[string]struct {
+		sender         sdk.AccAddress
+		expErr         string
+		expChildren    []string
+		expRootWasmGas bool
+	}{
+		"success": {
+			sender:         example.VerifierAddr,
+			expChildren:    []string{"submsg"},
+			expRootWasmGas: true,
+		},
+		"failure returned with trace": {
+			sender: example.BeneficiaryAddr,
+			expErr: "Unauthorized",
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := q.SimulateExecuteContract(sdk.WrapSDKContext(ctx), &types.QuerySimulateExecuteContractRequest{
+				Msg: types.MsgExecuteContract{
+					Sender:   spec.sender.String(),
+					Contract: example.Contract.String(),
+					Msg:      []byte(`{"release":{}}`),
+				},
+				Trace: true,
+			})
+			require.NoError(t, err)
+			root := got.Result.Trace
+			require.NotNil(t, root)
+			assert.Equal(t, "execute", root.EntryPoint)
+			assert.Equal(t, example.Contract.String(), root.ContractAddress)
+			assert.Equal(t, root.GasUsed, root.WasmGas+root.SDKGas)
+			assert.NotZero(t, root.GasUsed)
+			if spec.expErr != "" {
+				assert.Contains(t, got.Result.Error, spec.expErr)
+				assert.Contains(t, root.Error, spec.expErr)
+				return
+			}
+			assert.Empty(t, got.Result.Error)
+			assert.Empty(t, root.Error)
+			assert.NotZero(t, root.WasmGas)
+			assert.NotEmpty(t, root.Events)
+			gotChildren := make([]string, len(root.Children))
+			for i, c := range root.Children {
+				gotChildren[i] = c.EntryPoint
+				assert.Equal(t, example.Contract.String(), c.ContractAddress)
+			}
+			assert.Equal(t, spec.expChildren, gotChildren)
+		})
+	}
+}
+
 func TestQueryRawContractState(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	keeper := keepers.WasmKeeper
@@ -325,6 +829,7 @@ func TestQueryContractHistory(t *testing.T) {
 		srcHistory []types.ContractCodeHistoryEntry
 		req        types.QueryContractHistoryRequest
 		expContent []types.ContractCodeHistoryEntry
+		expErr     bool
 	}{
 		"response with internal fields cleared": {
 			srcHistory: []types.ContractCodeHistoryEntry{{
@@ -426,6 +931,90 @@ func TestQueryContractHistory(t *testing.T) {
 				Updated:   &types.AbsoluteTxPosition{BlockHeight: 1, TxIndex: 2},
 			}},
 		},
+		"filtered by operations": {
+			srcHistory: []types.ContractCodeHistoryEntry{{
+				Operation: types.ContractCodeHistoryOperationTypeInit,
+				CodeID:    firstCodeID,
+				Updated:   &types.AbsoluteTxPosition{BlockHeight: 1, TxIndex: 2},
+				Msg:       []byte(`"init message"`),
+				Admin:     otherBech32Addr,
+			}, {
+				Operation: types.ContractCodeHistoryOperationTypeAdminUpdate,
+				CodeID:    firstCodeID,
+				Updated:   &types.AbsoluteTxPosition{BlockHeight: 3, TxIndex: 4},
+				Sender:    otherBech32Addr,
+				Admin:     myContractBech32Addr,
+			}, {
+				Operation: types.ContractCodeHistoryOperationTypeMigrate,
+				CodeID:    2,
+				Updated:   &types.AbsoluteTxPosition{BlockHeight: 5, TxIndex: 6},
+				Msg:       []byte(`"migrate message 1"`),
+			}, {
+				Operation: types.ContractCodeHistoryOperationTypeAdminClear,
+				CodeID:    2,
+				Updated:   &types.AbsoluteTxPosition{BlockHeight: 7, TxIndex: 8},
+				Sender:    myContractBech32Addr,
+			}},
+			req: types.QueryContractHistoryRequest{
+				Address:    myContractBech32Addr,
+				Operations: []types.ContractCodeHistoryOperationType{types.ContractCodeHistoryOperationTypeAdminUpdate, types.ContractCodeHistoryOperationTypeAdminClear},
+			},
+			expContent: []types.ContractCodeHistoryEntry{{
+				Operation: types.ContractCodeHistoryOperationTypeAdminUpdate,
+				CodeID:    firstCodeID,
+				Updated:   &types.AbsoluteTxPosition{BlockHeight: 3, TxIndex: 4},
+				Sender:    otherBech32Addr,
+				Admin:     myContractBech32Addr,
+			}, {
+				Operation: types.ContractCodeHistoryOperationTypeAdminClear,
+				CodeID:    2,
+				Updated:   &types.AbsoluteTxPosition{BlockHeight: 7, TxIndex: 8},
+				Sender:    myContractBech32Addr,
+			}},
+		},
+		"filtered by operations with pagination": {
+			srcHistory: []types.ContractCodeHistoryEntry{{
+				Operation: types.ContractCodeHistoryOperationTypeInit,
+				CodeID:    firstCodeID,
+				Updated:   &types.AbsoluteTxPosition{BlockHeight: 1, TxIndex: 2},
+				Msg:       []byte(`"init message"`),
+			}, {
+				Operation: types.ContractCodeHistoryOperationTypeAdminClear,
+				CodeID:    firstCodeID,
+				Updated:   &types.AbsoluteTxPosition{BlockHeight: 3, TxIndex: 4},
+			}, {
+				Operation: types.ContractCodeHistoryOperationTypeMigrate,
+				CodeID:    2,
+				Updated:   &types.AbsoluteTxPosition{BlockHeight: 5, TxIndex: 6},
+				Msg:       []byte(`"migrate message 1"`),
+			}},
+			req: types.QueryContractHistoryRequest{
+				Address:    myContractBech32Addr,
+				Operations: []types.ContractCodeHistoryOperationType{types.ContractCodeHistoryOperationTypeInit, types.ContractCodeHistoryOperationTypeMigrate},
+				Pagination: &query.PageRequest{
+					Offset: 1,
+				},
+			},
+			expContent: []types.ContractCodeHistoryEntry{{
+				Operation: types.ContractCodeHistoryOperationTypeMigrate,
+				CodeID:    2,
+				Msg:       []byte(`"migrate message 1"`),
+				Updated:   &types.AbsoluteTxPosition{BlockHeight: 5, TxIndex: 6},
+			}},
+		},
+		"unknown operation filter": {
+			srcHistory: []types.ContractCodeHistoryEntry{{
+				Operation: types.ContractCodeHistoryOperationTypeInit,
+				CodeID:    firstCodeID,
+				Updated:   &types.AbsoluteTxPosition{BlockHeight: 1, TxIndex: 2},
+				Msg:       []byte(`"init message"`),
+			}},
+			req: types.QueryContractHistoryRequest{
+				Address:    myContractBech32Addr,
+				Operations: []types.ContractCodeHistoryOperationType{99},
+			},
+			expErr: true,
+		},
 		"unknown contract address": {
 			req: types.QueryContractHistoryRequest{Address: otherBech32Addr},
 			srcHistory: []types.ContractCodeHistoryEntry{{
@@ -449,6 +1038,10 @@ func TestQueryContractHistory(t *testing.T) {
 			got, err := q.ContractHistory(sdk.WrapSDKContext(xCtx), &spec.req)
 
 			// then
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
 			if spec.expContent == nil {
 				require.Error(t, types.ErrEmpty)
 				return
@@ -557,10 +1150,11 @@ func TestQueryContractInfo(t *testing.T) {
 		info.SetExtension(&myExt)
 	}
 	specs := map[string]struct {
-		src    *types.QueryContractInfoRequest
-		stored types.ContractInfo
-		expRsp *types.QueryContractInfoResponse
-		expErr bool
+		src         *types.QueryContractInfoRequest
+		stored      types.ContractInfo
+		storedStats types.ContractStorageStats
+		expRsp      *types.QueryContractInfoResponse
+		expErr      bool
 	}{
 		"found": {
 			src:    &types.QueryContractInfoRequest{Address: contractAddr.String()},
@@ -570,6 +1164,16 @@ func TestQueryContractInfo(t *testing.T) {
 				ContractInfo: types.ContractInfoFixture(),
 			},
 		},
+		"with storage stats": {
+			src:         &types.QueryContractInfoRequest{Address: contractAddr.String()},
+			stored:      types.ContractInfoFixture(),
+			storedStats: types.ContractStorageStats{Bytes: 100, Entries: 2},
+			expRsp: &types.QueryContractInfoResponse{
+				Address:      contractAddr.String(),
+				ContractInfo: types.ContractInfoFixture(),
+				StorageStats: types.ContractStorageStats{Bytes: 100, Entries: 2},
+			},
+		},
 		"with extension": {
 			src:    &types.QueryContractInfoRequest{Address: contractAddr.String()},
 			stored: types.ContractInfoFixture(myExtension),
@@ -588,6 +1192,7 @@ func TestQueryContractInfo(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			xCtx, _ := ctx.CacheContext()
 			k.storeContractInfo(xCtx, contractAddr, &spec.stored)
+			k.setContractStorageStats(xCtx, contractAddr, spec.storedStats)
 			// when
 			gotRsp, gotErr := querier.ContractInfo(sdk.WrapSDKContext(xCtx), spec.src)
 			if spec.expErr {
@@ -600,6 +1205,166 @@ func TestQueryContractInfo(t *testing.T) {
 	}
 }
 
+func TestQueryContractStorageStats(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	querier := NewGrpcQuerier(k.cdc, k.storeKey, k, k.queryGasLimit)
+
+	specs := map[string]struct {
+		src    *types.QueryContractStorageStatsRequest
+		expRsp *types.QueryContractStorageStatsResponse
+		expErr error
+	}{
+		"found": {
+			src: &types.QueryContractStorageStatsRequest{Address: example.Contract.String()},
+			expRsp: &types.QueryContractStorageStatsResponse{
+				Stats: k.calculateContractStorageStats(ctx, example.Contract),
+			},
+		},
+		"not found": {
+			src:    &types.QueryContractStorageStatsRequest{Address: RandomBech32AccountAddress(t)},
+			expErr: types.ErrNotFound,
+		},
+		"invalid address": {
+			src:    &types.QueryContractStorageStatsRequest{Address: "invalid"},
+			expErr: errors.New("decoding bech32 failed: invalid bech32 string length 7"),
+		},
+		"nil request": {
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotRsp, gotErr := querier.ContractStorageStats(sdk.WrapSDKContext(ctx), spec.src)
+			if spec.expErr != nil {
+				require.Error(t, gotErr)
+				assert.ErrorContains(t, gotErr, spec.expErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expRsp, gotRsp)
+			assert.NotZero(t, gotRsp.Stats.Entries)
+		})
+	}
+}
+
+func TestQueryContractCallbacks(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	params := types.DefaultParams()
+	params.MaxCallbacksPerBlock, params.MaxCallbackGasLimit = 1, 100_000
+	k.SetParams(ctx, params)
+	var expCallbacks []types.Callback
+	for i := int64(3); i > 0; i-- {
+		id, err := k.scheduleCallback(ctx, example.Contract, ctx.BlockHeight()+i, 100_000, []byte(`{}`))
+		require.NoError(t, err)
+		expCallbacks = append(expCallbacks, *k.GetCallback(ctx, example.Contract, id))
+	}
+	querier := NewGrpcQuerier(k.cdc, k.storeKey, k, k.queryGasLimit)
+
+	specs := map[string]struct {
+		src    *types.QueryContractCallbacksRequest
+		expRsp []types.Callback
+		expErr error
+	}{
+		"all": {
+			src:    &types.QueryContractCallbacksRequest{Address: example.Contract.String()},
+			expRsp: expCallbacks,
+		},
+		"with pagination": {
+			src: &types.QueryContractCallbacksRequest{
+				Address:    example.Contract.String(),
+				Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+			},
+			expRsp: expCallbacks[1:2],
+		},
+		"none": {
+			src:    &types.QueryContractCallbacksRequest{Address: RandomBech32AccountAddress(t)},
+			expRsp: []types.Callback{},
+		},
+		"invalid address": {
+			src:    &types.QueryContractCallbacksRequest{Address: "invalid"},
+			expErr: errors.New("decoding bech32 failed: invalid bech32 string length 7"),
+		},
+		"nil request": {
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotRsp, gotErr := querier.ContractCallbacks(sdk.WrapSDKContext(ctx), spec.src)
+			if spec.expErr != nil {
+				require.Error(t, gotErr)
+				assert.ErrorContains(t, gotErr, spec.expErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expRsp, gotRsp.Callbacks)
+		})
+	}
+}
+
+func TestQueryCodeIDsByChecksum(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	creator := RandomAccountAddress(t)
+	var checksum []byte
+	for i := 0; i < 3; i++ {
+		var err error
+		_, checksum, err = keepers.ContractKeeper.Create(ctx, creator, hackatomWasm, nil)
+		require.NoError(t, err)
+	}
+	otherChecksum := sha256.Sum256([]byte("other"))
+	querier := NewGrpcQuerier(k.cdc, k.storeKey, k, k.queryGasLimit)
+
+	specs := map[string]struct {
+		src    *types.QueryCodeIDsByChecksumRequest
+		expRsp []uint64
+		expErr error
+	}{
+		"all": {
+			src:    &types.QueryCodeIDsByChecksumRequest{Checksum: hex.EncodeToString(checksum)},
+			expRsp: []uint64{1, 2, 3},
+		},
+		"with pagination": {
+			src: &types.QueryCodeIDsByChecksumRequest{
+				Checksum:   hex.EncodeToString(checksum),
+				Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+			},
+			expRsp: []uint64{2},
+		},
+		"none": {
+			src:    &types.QueryCodeIDsByChecksumRequest{Checksum: hex.EncodeToString(otherChecksum[:])},
+			expRsp: []uint64{},
+		},
+		"invalid checksum": {
+			src:    &types.QueryCodeIDsByChecksumRequest{Checksum: "invalid"},
+			expErr: status.Error(codes.InvalidArgument, "checksum: encoding/hex: invalid byte: U+0069 'i'"),
+		},
+		"invalid checksum length": {
+			src:    &types.QueryCodeIDsByChecksumRequest{Checksum: "0102"},
+			expErr: status.Error(codes.InvalidArgument, "invalid checksum length"),
+		},
+		"nil request": {
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotRsp, gotErr := querier.CodeIDsByChecksum(sdk.WrapSDKContext(ctx), spec.src)
+			if spec.expErr != nil {
+				require.Error(t, gotErr)
+				assert.ErrorContains(t, gotErr, spec.expErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expRsp, gotRsp.CodeIDs)
+		})
+	}
+}
+
 func TestQueryPinnedCodes(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	keeper := keepers.WasmKeeper
@@ -715,6 +1480,9 @@ func TestQueryCodeInfo(t *testing.T) {
 		t.Run(msg, func(t *testing.T) {
 			codeInfo := types.CodeInfoFixture(types.WithSHA256CodeHash(wasmCode))
 			codeInfo.InstantiateConfig = spec.accessConfig
+			codeInfo.Source = "https://example.com/hackatom.tar.gz"
+			codeInfo.Builder = "cosmwasm/rust-optimizer:0.12.9"
+			codeInfo.Created = &types.AbsoluteTxPosition{BlockHeight: 1, TxIndex: 2}
 			require.NoError(t, keeper.importCode(ctx, spec.codeId,
 				codeInfo,
 				wasmCode),
@@ -731,6 +1499,10 @@ func TestQueryCodeInfo(t *testing.T) {
 					Creator:               codeInfo.Creator,
 					DataHash:              codeInfo.CodeHash,
 					InstantiatePermission: spec.accessConfig,
+					Source:                codeInfo.Source,
+					Builder:               codeInfo.Builder,
+					Created:               codeInfo.Created,
+					WasmSize:              uint64(len(wasmCode)),
 				},
 				Data: wasmCode,
 			}
@@ -791,6 +1563,7 @@ func TestQueryCodeInfoList(t *testing.T) {
 			Creator:               code.codeInfo.Creator,
 			DataHash:              code.codeInfo.CodeHash,
 			InstantiatePermission: code.codeInfo.InstantiateConfig,
+			WasmSize:              uint64(len(wasmCode)),
 		})
 	}
 	q := Querier(keeper)
@@ -804,6 +1577,199 @@ func TestQueryCodeInfoList(t *testing.T) {
 	require.EqualValues(t, allCodesResponse, got.CodeInfos)
 }
 
+func TestQueryContractsByAdmin(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	myAdmin, otherAdmin := RandomAccountAddress(t), RandomAccountAddress(t)
+	initMsgBz := HackatomExampleInitMsg{
+		Verifier:    RandomAccountAddress(t),
+		Beneficiary: RandomAccountAddress(t),
+	}.GetBytes(t)
+
+	var contracts []sdk.AccAddress
+	for i := 0; i < 4; i++ {
+		contract, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, myAdmin, initMsgBz, fmt.Sprintf("contract %d", i), nil)
+		require.NoError(t, err)
+		contracts = append(contracts, contract)
+	}
+	// move one contract to the other admin and clear the admin of another one
+	require.NoError(t, keepers.ContractKeeper.UpdateContractAdmin(ctx, contracts[0], myAdmin, otherAdmin))
+	require.NoError(t, keepers.ContractKeeper.ClearContractAdmin(ctx, contracts[1], myAdmin))
+	// contracts are returned in order of their address bytes
+	myContracts := []string{contracts[2].String(), contracts[3].String()}
+	if bytes.Compare(contracts[2], contracts[3]) > 0 {
+		myContracts[0], myContracts[1] = myContracts[1], myContracts[0]
+	}
+	querier := NewGrpcQuerier(k.cdc, k.storeKey, k, k.queryGasLimit)
+
+	specs := map[string]struct {
+		src    *types.QueryContractsByAdminRequest
+		expRsp []string
+		expErr error
+	}{
+		"query all": {
+			src:    &types.QueryContractsByAdminRequest{AdminAddress: myAdmin.String()},
+			expRsp: myContracts,
+		},
+		"with pagination": {
+			src: &types.QueryContractsByAdminRequest{
+				AdminAddress: myAdmin.String(),
+				Pagination:   &query.PageRequest{Offset: 1, Limit: 1},
+			},
+			expRsp: myContracts[1:],
+		},
+		"other admin": {
+			src:    &types.QueryContractsByAdminRequest{AdminAddress: otherAdmin.String()},
+			expRsp: []string{contracts[0].String()},
+		},
+		"unknown admin": {
+			src:    &types.QueryContractsByAdminRequest{AdminAddress: RandomBech32AccountAddress(t)},
+			expRsp: []string{},
+		},
+		"invalid address": {
+			src:    &types.QueryContractsByAdminRequest{AdminAddress: "invalid"},
+			expErr: errors.New("decoding bech32 failed: invalid bech32 string length 7"),
+		},
+		"nil request": {
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotRsp, gotErr := querier.ContractsByAdmin(sdk.WrapSDKContext(ctx), spec.src)
+			if spec.expErr != nil {
+				require.Error(t, gotErr)
+				assert.ErrorContains(t, gotErr, spec.expErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expRsp, gotRsp.ContractAddresses)
+		})
+	}
+}
+
+func TestQueryContractsByLabel(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	myCreator, otherCreator := example.CreatorAddr, RandomAccountAddress(t)
+	initMsgBz := HackatomExampleInitMsg{
+		Verifier:    RandomAccountAddress(t),
+		Beneficiary: RandomAccountAddress(t),
+	}.GetBytes(t)
+	params := k.GetParams(ctx)
+	params.LabelIndexScope = types.LabelIndexScopeGlobal
+	k.SetParams(ctx, params)
+	k.SyncContractLabelIndex(ctx)
+
+	instantiate := func(creator sdk.AccAddress, label string) types.LabeledContract {
+		contract, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, creator, nil, initMsgBz, label, nil)
+		require.NoError(t, err)
+		return types.LabeledContract{Address: contract.String(), Label: label}
+	}
+	myAlpha := instantiate(myCreator, "alpha")
+	myAlpha2 := instantiate(myCreator, "alpha 2")
+	myBeta := instantiate(myCreator, "beta")
+	otherAlpha := instantiate(otherCreator, "alpha")
+	// contracts with the same label are returned in order of their address bytes
+	allAlpha := []types.LabeledContract{myAlpha, otherAlpha}
+	if bytes.Compare(sdk.MustAccAddressFromBech32(myAlpha.Address), sdk.MustAccAddressFromBech32(otherAlpha.Address)) > 0 {
+		allAlpha[0], allAlpha[1] = allAlpha[1], allAlpha[0]
+	}
+	querier := NewGrpcQuerier(k.cdc, k.storeKey, k, k.queryGasLimit)
+	creatorScope, noScope := types.LabelIndexScopeCreator, types.LabelIndexScopeNone
+
+	specs := map[string]struct {
+		src    *types.QueryContractsByLabelRequest
+		scope  *types.LabelIndexScope
+		expRsp []types.LabeledContract
+		expErr error
+	}{
+		"exact label": {
+			src:    &types.QueryContractsByLabelRequest{Label: "alpha"},
+			expRsp: allAlpha,
+		},
+		"exact label by creator": {
+			src:    &types.QueryContractsByLabelRequest{Label: "alpha", CreatorAddress: myCreator.String()},
+			expRsp: []types.LabeledContract{myAlpha},
+		},
+		"label prefix": {
+			src:    &types.QueryContractsByLabelRequest{Label: "alpha", Prefix: true},
+			expRsp: append(allAlpha, myAlpha2),
+		},
+		"label prefix by creator": {
+			src:    &types.QueryContractsByLabelRequest{Label: "al", Prefix: true, CreatorAddress: myCreator.String()},
+			expRsp: []types.LabeledContract{myAlpha, myAlpha2},
+		},
+		"empty prefix by creator": {
+			src:    &types.QueryContractsByLabelRequest{Prefix: true, CreatorAddress: myCreator.String()},
+			expRsp: []types.LabeledContract{myAlpha, myAlpha2, myBeta},
+		},
+		"with pagination": {
+			src: &types.QueryContractsByLabelRequest{
+				Prefix:         true,
+				CreatorAddress: myCreator.String(),
+				Pagination:     &query.PageRequest{Offset: 1, Limit: 1},
+			},
+			expRsp: []types.LabeledContract{myAlpha2},
+		},
+		"unknown label": {
+			src:    &types.QueryContractsByLabelRequest{Label: "gamma"},
+			expRsp: []types.LabeledContract{},
+		},
+		"unknown creator": {
+			src:    &types.QueryContractsByLabelRequest{Label: "alpha", CreatorAddress: RandomBech32AccountAddress(t)},
+			expRsp: []types.LabeledContract{},
+		},
+		"exact label by creator in creator scope": {
+			src:    &types.QueryContractsByLabelRequest{Label: "alpha", CreatorAddress: myCreator.String()},
+			scope:  &creatorScope,
+			expRsp: []types.LabeledContract{myAlpha},
+		},
+		"exact label in creator scope": {
+			src:    &types.QueryContractsByLabelRequest{Label: "alpha"},
+			scope:  &creatorScope,
+			expErr: status.Error(codes.FailedPrecondition, "contracts are not indexed by label globally"),
+		},
+		"exact label by creator without index": {
+			src:    &types.QueryContractsByLabelRequest{Label: "alpha", CreatorAddress: myCreator.String()},
+			scope:  &noScope,
+			expErr: status.Error(codes.FailedPrecondition, "contracts are not indexed by label"),
+		},
+		"empty label": {
+			src:    &types.QueryContractsByLabelRequest{},
+			expErr: status.Error(codes.InvalidArgument, "empty label"),
+		},
+		"invalid creator": {
+			src:    &types.QueryContractsByLabelRequest{Label: "alpha", CreatorAddress: "invalid"},
+			expErr: errors.New("decoding bech32 failed: invalid bech32 string length 7"),
+		},
+		"nil request": {
+			expErr: status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			if spec.scope != nil {
+				params := k.GetParams(ctx)
+				params.LabelIndexScope = *spec.scope
+				k.SetParams(ctx, params)
+				k.SyncContractLabelIndex(ctx)
+			}
+			gotRsp, gotErr := querier.ContractsByLabel(sdk.WrapSDKContext(ctx), spec.src)
+			if spec.expErr != nil {
+				require.Error(t, gotErr)
+				assert.ErrorContains(t, gotErr, spec.expErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expRsp, gotRsp.Contracts)
+		})
+	}
+}
+
 func TestQueryContractsByCreatorList(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 
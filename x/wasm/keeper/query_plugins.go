@@ -84,6 +84,66 @@ type QueryPlugins struct {
 	Staking  func(ctx sdk.Context, request *wasmvmtypes.StakingQuery) ([]byte, error)
 	Stargate func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error)
 	Wasm     func(ctx sdk.Context, request *wasmvmtypes.WasmQuery) ([]byte, error)
+	IBCFee   func(ctx sdk.Context, caller sdk.AccAddress, request *IBCFeeQuery) ([]byte, error)
+}
+
+// IBCFeeQuery is the custom query a contract sends to read ICS-29 fee data. It is wrapped in an `ibc_fee` object:
+//
+//	{"ibc_fee": {"incentivized_packets": {...}}}
+//
+// The `ibc_fee` key is only reserved when the chain enables the querier with Option `WithIBCFeeQueries`. Otherwise
+// the query is passed to the custom querier of the chain.
+type IBCFeeQuery struct {
+	// IncentivizedPackets returns the fees in escrow for the unrelayed packets of a channel
+	IncentivizedPackets *IncentivizedPacketsQuery `json:"incentivized_packets,omitempty"`
+	// CounterpartyPayee returns the payee address on the counterparty chain registered by a relayer
+	CounterpartyPayee *CounterpartyPayeeQuery `json:"counterparty_payee,omitempty"`
+	// FeeEnabled returns if ICS-29 fees are enabled for a channel
+	FeeEnabled *FeeEnabledQuery `json:"fee_enabled,omitempty"`
+}
+
+type IncentivizedPacketsQuery struct {
+	// PortID is the source port of the packets. The IBC port of the contract is used when empty
+	PortID    string `json:"port_id,omitempty"`
+	ChannelID string `json:"channel_id"`
+}
+
+type IncentivizedPacketsResponse struct {
+	Packets []IncentivizedPacket `json:"packets"`
+}
+
+type IncentivizedPacket struct {
+	PortID    string      `json:"port_id"`
+	ChannelID string      `json:"channel_id"`
+	Sequence  uint64      `json:"sequence"`
+	Fees      []PacketFee `json:"fees"`
+}
+
+type PacketFee struct {
+	Fee IBCFee `json:"fee"`
+	// RefundAddress receives the fees that are not paid to relayers
+	RefundAddress string `json:"refund_address"`
+}
+
+type CounterpartyPayeeQuery struct {
+	ChannelID string `json:"channel_id"`
+	// Relayer is the relayer address on this chain
+	Relayer string `json:"relayer"`
+}
+
+type CounterpartyPayeeResponse struct {
+	// Payee is empty when the relayer has not registered a counterparty payee
+	Payee string `json:"payee"`
+}
+
+type FeeEnabledQuery struct {
+	// PortID is the port of the channel. The IBC port of the contract is used when empty
+	PortID    string `json:"port_id,omitempty"`
+	ChannelID string `json:"channel_id"`
+}
+
+type FeeEnabledResponse struct {
+	Enabled bool `json:"enabled"`
 }
 
 type contractMetaDataSource interface {
@@ -138,6 +198,9 @@ func (e QueryPlugins) Merge(o *QueryPlugins) QueryPlugins {
 	if o.Wasm != nil {
 		e.Wasm = o.Wasm
 	}
+	if o.IBCFee != nil {
+		e.IBCFee = o.IBCFee
+	}
 	return e
 }
 
@@ -148,6 +211,11 @@ func (e QueryPlugins) HandleQuery(ctx sdk.Context, caller sdk.AccAddress, reques
 		return e.Bank(ctx, request.Bank)
 	}
 	if request.Custom != nil {
+		if e.IBCFee != nil {
+			if feeQuery := parseIBCFeeQuery(request.Custom); feeQuery != nil {
+				return e.IBCFee(ctx, caller, feeQuery)
+			}
+		}
 		return e.Custom(ctx, request.Custom)
 	}
 	if request.IBC != nil {
@@ -281,6 +349,73 @@ func IBCQuerier(wasm contractMetaDataSource, channelKeeper types.ChannelKeeper)
 	}
 }
 
+// parseIBCFeeQuery returns the ICS-29 fee query of a custom query or nil when it has no `ibc_fee` object
+func parseIBCFeeQuery(request json.RawMessage) *IBCFeeQuery {
+	var wrapper struct {
+		IBCFee *IBCFeeQuery `json:"ibc_fee"`
+	}
+	if err := json.Unmarshal(request, &wrapper); err != nil {
+		return nil
+	}
+	return wrapper.IBCFee
+}
+
+// IBCFeeQuerier returns the ICS-29 fee data of the ibc fee keeper
+func IBCFeeQuerier(wasm contractMetaDataSource, feeKeeper types.IBCFeeKeeper) func(ctx sdk.Context, caller sdk.AccAddress, request *IBCFeeQuery) ([]byte, error) {
+	return func(ctx sdk.Context, caller sdk.AccAddress, request *IBCFeeQuery) ([]byte, error) {
+		portOrDefault := func(portID string) (string, error) {
+			if portID != "" {
+				return portID, nil
+			}
+			contractInfo := wasm.GetContractInfo(ctx, caller)
+			if contractInfo == nil {
+				return "", sdkerrors.Wrap(types.ErrNotFound, "contract")
+			}
+			return contractInfo.IBCPortID, nil
+		}
+		switch {
+		case request.IncentivizedPackets != nil:
+			portID, err := portOrDefault(request.IncentivizedPackets.PortID)
+			if err != nil {
+				return nil, err
+			}
+			packets := make([]IncentivizedPacket, 0)
+			for _, p := range feeKeeper.GetIdentifiedPacketFeesForChannel(ctx, portID, request.IncentivizedPackets.ChannelID) {
+				fees := make([]PacketFee, len(p.PacketFees))
+				for i, f := range p.PacketFees {
+					fees[i] = PacketFee{
+						Fee: IBCFee{
+							RecvFee:    ConvertSdkCoinsToWasmCoins(f.Fee.RecvFee),
+							AckFee:     ConvertSdkCoinsToWasmCoins(f.Fee.AckFee),
+							TimeoutFee: ConvertSdkCoinsToWasmCoins(f.Fee.TimeoutFee),
+						},
+						RefundAddress: f.RefundAddress,
+					}
+				}
+				packets = append(packets, IncentivizedPacket{
+					PortID:    p.PacketId.PortId,
+					ChannelID: p.PacketId.ChannelId,
+					Sequence:  p.PacketId.Sequence,
+					Fees:      fees,
+				})
+			}
+			return json.Marshal(IncentivizedPacketsResponse{Packets: packets})
+		case request.CounterpartyPayee != nil:
+			payee, _ := feeKeeper.GetCounterpartyPayeeAddress(ctx, request.CounterpartyPayee.Relayer, request.CounterpartyPayee.ChannelID)
+			return json.Marshal(CounterpartyPayeeResponse{Payee: payee})
+		case request.FeeEnabled != nil:
+			portID, err := portOrDefault(request.FeeEnabled.PortID)
+			if err != nil {
+				return nil, err
+			}
+			enabled := feeKeeper.IsFeeEnabled(ctx, portID, request.FeeEnabled.ChannelID)
+			return json.Marshal(FeeEnabledResponse{Enabled: enabled})
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown IBCFeeQuery variant"}
+		}
+	}
+}
+
 // RejectStargateQuerier rejects all stargate queries
 func RejectStargateQuerier() func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
 	return func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
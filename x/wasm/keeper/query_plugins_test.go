@@ -18,6 +18,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v4/modules/apps/29-fee/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
@@ -331,6 +332,122 @@ func TestIBCQuerier(t *testing.T) {
 	}
 }
 
+func TestIBCFeeQuerier(t *testing.T) {
+	myContractAddr := keeper.RandomAccountAddress(t)
+	wasmKeeper := mockWasmQueryKeeper{GetContractInfoFn: func(ctx sdk.Context, contractAddress sdk.AccAddress) *types.ContractInfo {
+		if !contractAddress.Equals(myContractAddr) {
+			return nil
+		}
+		return &types.ContractInfo{IBCPortID: "myIBCPort"}
+	}}
+	feeKeeper := mockIBCFeeKeeper{
+		packetFees: []ibcfeetypes.IdentifiedPacketFees{{
+			PacketId: channeltypes.NewPacketId("myIBCPort", "channel-1", 7),
+			PacketFees: []ibcfeetypes.PacketFee{
+				ibcfeetypes.NewPacketFee(ibcfeetypes.NewFee(sdk.NewCoins(sdk.NewInt64Coin("ALX", 1)), sdk.NewCoins(sdk.NewInt64Coin("ALX", 2)), nil), "myRefundAddr", nil),
+			},
+		}},
+		payees:       map[string]string{"channel-1/myRelayer": "myPayee"},
+		feeEnabledOn: "myIBCPort/channel-1",
+	}
+	specs := map[string]struct {
+		srcQuery      *keeper.IBCFeeQuery
+		caller        sdk.AccAddress
+		expJSONResult string
+		expErr        error
+	}{
+		"incentivized packets": {
+			srcQuery:      &keeper.IBCFeeQuery{IncentivizedPackets: &keeper.IncentivizedPacketsQuery{ChannelID: "channel-1"}},
+			expJSONResult: `{"packets":[{"port_id":"myIBCPort","channel_id":"channel-1","sequence":7,"fees":[{"fee":{"recv_fee":[{"denom":"ALX","amount":"1"}],"ack_fee":[{"denom":"ALX","amount":"2"}],"timeout_fee":[]},"refund_address":"myRefundAddr"}]}]}`,
+		},
+		"incentivized packets - other port": {
+			srcQuery:      &keeper.IBCFeeQuery{IncentivizedPackets: &keeper.IncentivizedPacketsQuery{PortID: "transfer", ChannelID: "channel-1"}},
+			expJSONResult: `{"packets":[]}`,
+		},
+		"counterparty payee": {
+			srcQuery:      &keeper.IBCFeeQuery{CounterpartyPayee: &keeper.CounterpartyPayeeQuery{ChannelID: "channel-1", Relayer: "myRelayer"}},
+			expJSONResult: `{"payee":"myPayee"}`,
+		},
+		"counterparty payee - not registered": {
+			srcQuery:      &keeper.IBCFeeQuery{CounterpartyPayee: &keeper.CounterpartyPayeeQuery{ChannelID: "channel-1", Relayer: "otherRelayer"}},
+			expJSONResult: `{"payee":""}`,
+		},
+		"fee enabled": {
+			srcQuery:      &keeper.IBCFeeQuery{FeeEnabled: &keeper.FeeEnabledQuery{ChannelID: "channel-1"}},
+			expJSONResult: `{"enabled":true}`,
+		},
+		"fee not enabled": {
+			srcQuery:      &keeper.IBCFeeQuery{FeeEnabled: &keeper.FeeEnabledQuery{PortID: "transfer", ChannelID: "channel-1"}},
+			expJSONResult: `{"enabled":false}`,
+		},
+		"incentivized packets - unknown caller without port": {
+			srcQuery: &keeper.IBCFeeQuery{IncentivizedPackets: &keeper.IncentivizedPacketsQuery{ChannelID: "channel-1"}},
+			caller:   keeper.RandomAccountAddress(t),
+			expErr:   types.ErrNotFound,
+		},
+		"fee enabled - unknown caller without port": {
+			srcQuery: &keeper.IBCFeeQuery{FeeEnabled: &keeper.FeeEnabledQuery{ChannelID: "channel-1"}},
+			caller:   keeper.RandomAccountAddress(t),
+			expErr:   types.ErrNotFound,
+		},
+		"fee enabled - unknown caller with port": {
+			srcQuery:      &keeper.IBCFeeQuery{FeeEnabled: &keeper.FeeEnabledQuery{PortID: "myIBCPort", ChannelID: "channel-1"}},
+			caller:        keeper.RandomAccountAddress(t),
+			expJSONResult: `{"enabled":true}`,
+		},
+		"unknown variant": {
+			srcQuery: &keeper.IBCFeeQuery{},
+			expErr:   wasmvmtypes.UnsupportedRequest{Kind: "unknown IBCFeeQuery variant"},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			caller := spec.caller
+			if caller == nil {
+				caller = myContractAddr
+			}
+			h := keeper.IBCFeeQuerier(wasmKeeper, feeKeeper)
+			gotResult, gotErr := h(sdk.Context{}, caller, spec.srcQuery)
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.JSONEq(t, spec.expJSONResult, string(gotResult), string(gotResult))
+		})
+	}
+}
+
+func TestHandleIBCFeeQuery(t *testing.T) {
+	var gotQuery *keeper.IBCFeeQuery
+	plugins := keeper.QueryPlugins{
+		Custom: func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+			return []byte("custom"), nil
+		},
+		IBCFee: func(ctx sdk.Context, caller sdk.AccAddress, request *keeper.IBCFeeQuery) ([]byte, error) {
+			gotQuery = request
+			return []byte("ibc_fee"), nil
+		},
+	}
+	// when
+	gotResult, gotErr := plugins.HandleQuery(sdk.Context{}, keeper.RandomAccountAddress(t), wasmvmtypes.QueryRequest{Custom: []byte(`{"ibc_fee":{"fee_enabled":{"channel_id":"channel-1"}}}`)})
+	// then
+	require.NoError(t, gotErr)
+	assert.Equal(t, "ibc_fee", string(gotResult))
+	assert.Equal(t, &keeper.IBCFeeQuery{FeeEnabled: &keeper.FeeEnabledQuery{ChannelID: "channel-1"}}, gotQuery)
+
+	// and other custom queries are passed to the custom querier
+	gotResult, gotErr = plugins.HandleQuery(sdk.Context{}, keeper.RandomAccountAddress(t), wasmvmtypes.QueryRequest{Custom: []byte(`{"foo":{}}`)})
+	require.NoError(t, gotErr)
+	assert.Equal(t, "custom", string(gotResult))
+
+	// and the `ibc_fee` key is not reserved when the querier is not enabled
+	plugins.IBCFee = nil
+	gotResult, gotErr = plugins.HandleQuery(sdk.Context{}, keeper.RandomAccountAddress(t), wasmvmtypes.QueryRequest{Custom: []byte(`{"ibc_fee":{"fee_enabled":{"channel_id":"channel-1"}}}`)})
+	require.NoError(t, gotErr)
+	assert.Equal(t, "custom", string(gotResult))
+}
+
 func TestBankQuerierBalance(t *testing.T) {
 	mock := bankKeeperMock{GetBalanceFn: func(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
 		return sdk.NewCoin(denom, sdk.NewInt(1))
@@ -823,3 +940,28 @@ func TestDeterministicJsonMarshal(t *testing.T) {
 		})
 	}
 }
+
+type mockIBCFeeKeeper struct {
+	packetFees   []ibcfeetypes.IdentifiedPacketFees
+	payees       map[string]string
+	feeEnabledOn string
+}
+
+func (m mockIBCFeeKeeper) GetIdentifiedPacketFeesForChannel(ctx sdk.Context, portID, channelID string) []ibcfeetypes.IdentifiedPacketFees {
+	var r []ibcfeetypes.IdentifiedPacketFees
+	for _, v := range m.packetFees {
+		if v.PacketId.PortId == portID && v.PacketId.ChannelId == channelID {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+func (m mockIBCFeeKeeper) GetCounterpartyPayeeAddress(ctx sdk.Context, address, channelID string) (string, bool) {
+	payee, ok := m.payees[channelID+"/"+address]
+	return payee, ok
+}
+
+func (m mockIBCFeeKeeper) IsFeeEnabled(ctx sdk.Context, portID, channelID string) bool {
+	return m.feeEnabledOn == portID+"/"+channelID
+}
@@ -22,8 +22,10 @@ func (k Keeper) OnOpenChannel(
 	ctx sdk.Context,
 	contractAddr sdk.AccAddress,
 	msg wasmvmtypes.IBCChannelOpenMsg,
-) (string, error) {
+) (_ string, err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-open-channel")
+	ctx, trace := k.startCallTrace(ctx, "ibc_channel_open", contractAddr)
+	defer func() { trace.end(ctx, err, recover()) }()
 	_, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return "", err
@@ -38,6 +40,9 @@ func (k Keeper) OnOpenChannel(
 	if execErr != nil {
 		return "", sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := k.commitStorageStats(ctx, contractAddr, nil, prefixStore); err != nil {
+		return "", err
+	}
 	if res != nil {
 		return res.Version, nil
 	}
@@ -55,8 +60,10 @@ func (k Keeper) OnConnectChannel(
 	ctx sdk.Context,
 	contractAddr sdk.AccAddress,
 	msg wasmvmtypes.IBCChannelConnectMsg,
-) error {
+) (err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-connect-channel")
+	ctx, trace := k.startCallTrace(ctx, "ibc_channel_connect", contractAddr)
+	defer func() { trace.end(ctx, err, recover()) }()
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return err
@@ -71,6 +78,9 @@ func (k Keeper) OnConnectChannel(
 	if execErr != nil {
 		return sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := k.commitStorageStats(ctx, contractAddr, nil, prefixStore); err != nil {
+		return err
+	}
 
 	return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res)
 }
@@ -85,8 +95,10 @@ func (k Keeper) OnCloseChannel(
 	ctx sdk.Context,
 	contractAddr sdk.AccAddress,
 	msg wasmvmtypes.IBCChannelCloseMsg,
-) error {
+) (err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-close-channel")
+	ctx, trace := k.startCallTrace(ctx, "ibc_channel_close", contractAddr)
+	defer func() { trace.end(ctx, err, recover()) }()
 
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
@@ -102,6 +114,9 @@ func (k Keeper) OnCloseChannel(
 	if execErr != nil {
 		return sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := k.commitStorageStats(ctx, contractAddr, nil, prefixStore); err != nil {
+		return err
+	}
 
 	return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res)
 }
@@ -116,12 +131,17 @@ func (k Keeper) OnRecvPacket(
 	ctx sdk.Context,
 	contractAddr sdk.AccAddress,
 	msg wasmvmtypes.IBCPacketReceiveMsg,
-) ([]byte, error) {
+) (_ []byte, err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-recv-packet")
+	ctx, trace := k.startCallTrace(ctx, "ibc_packet_receive", contractAddr)
+	defer func() { trace.end(ctx, err, recover()) }()
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return nil, err
 	}
+	if contractInfo.IsFrozen {
+		return nil, sdkerrors.Wrap(types.ErrContractFrozen, "can not receive packet")
+	}
 
 	env := types.NewEnv(ctx, contractAddr)
 	querier := k.newQueryHandler(ctx, contractAddr)
@@ -132,6 +152,9 @@ func (k Keeper) OnRecvPacket(
 	if execErr != nil {
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := k.commitStorageStats(ctx, contractAddr, nil, prefixStore); err != nil {
+		return nil, err
+	}
 	if res.Err != "" { // handle error case as before https://github.com/CosmWasm/wasmvm/commit/c300106fe5c9426a495f8e10821e00a9330c56c6
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, res.Err)
 	}
@@ -150,8 +173,10 @@ func (k Keeper) OnAckPacket(
 	ctx sdk.Context,
 	contractAddr sdk.AccAddress,
 	msg wasmvmtypes.IBCPacketAckMsg,
-) error {
+) (err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-ack-packet")
+	ctx, trace := k.startCallTrace(ctx, "ibc_packet_ack", contractAddr)
+	defer func() { trace.end(ctx, err, recover()) }()
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
 		return err
@@ -166,6 +191,9 @@ func (k Keeper) OnAckPacket(
 	if execErr != nil {
 		return sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := k.commitStorageStats(ctx, contractAddr, nil, prefixStore); err != nil {
+		return err
+	}
 	return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res)
 }
 
@@ -176,8 +204,10 @@ func (k Keeper) OnTimeoutPacket(
 	ctx sdk.Context,
 	contractAddr sdk.AccAddress,
 	msg wasmvmtypes.IBCPacketTimeoutMsg,
-) error {
+) (err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-timeout-packet")
+	ctx, trace := k.startCallTrace(ctx, "ibc_packet_timeout", contractAddr)
+	defer func() { trace.end(ctx, err, recover()) }()
 
 	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
 	if err != nil {
@@ -193,6 +223,9 @@ func (k Keeper) OnTimeoutPacket(
 	if execErr != nil {
 		return sdkerrors.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := k.commitStorageStats(ctx, contractAddr, nil, prefixStore); err != nil {
+		return err
+	}
 
 	return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res)
 }
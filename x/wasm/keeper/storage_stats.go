@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// accountingStore is the prefix store of a contract that keeps track of the changes in number of entries and
+// key/value bytes written. The changes are persisted with the contract storage stats via `commitStorageStats`.
+// The size of a previous value is read without gas metering so that the accounting does not add to the gas cost
+// of a write.
+type accountingStore struct {
+	prefix.Store
+	// unmetered is the same prefix store without gas metering
+	unmetered    prefix.Store
+	bytesDelta   int64
+	entriesDelta int64
+	// onWrite is called for every write when set
+	onWrite func(key, value []byte, delete bool)
+}
+
+// newAccountingStore constructor. The unmetered store must be the parent store without the gas meter.
+func newAccountingStore(parent, unmetered sdk.KVStore, contractAddress sdk.AccAddress) *accountingStore {
+	storePrefix := types.GetContractStorePrefix(contractAddress)
+	return &accountingStore{
+		Store:     prefix.NewStore(parent, storePrefix),
+		unmetered: prefix.NewStore(unmetered, storePrefix),
+	}
+}
+
+// Set implements wasmvm.KVStore and accounts the size difference to any previous value
+func (s *accountingStore) Set(key, value []byte) {
+	if old := s.unmetered.Get(key); old != nil {
+		s.bytesDelta += int64(len(value) - len(old))
+	} else {
+		s.entriesDelta++
+		s.bytesDelta += int64(len(key) + len(value))
+	}
+	s.Store.Set(key, value)
+	if s.onWrite != nil {
+		s.onWrite(key, value, false)
+	}
+}
+
+// Delete implements wasmvm.KVStore and accounts the removed entry
+func (s *accountingStore) Delete(key []byte) {
+	if old := s.unmetered.Get(key); old != nil {
+		s.entriesDelta--
+		s.bytesDelta -= int64(len(key) + len(old))
+	}
+	s.Store.Delete(key)
+	if s.onWrite != nil {
+		s.onWrite(key, nil, true)
+	}
+}
+
+// GetContractStorageStats returns the accounted size of the contract's state
+func (k Keeper) GetContractStorageStats(ctx sdk.Context, contractAddress sdk.AccAddress) types.ContractStorageStats {
+	var stats types.ContractStorageStats
+	bz := ctx.KVStore(k.storeKey).Get(types.GetContractStorageStatsKey(contractAddress))
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &stats)
+	}
+	return stats
+}
+
+func (k Keeper) setContractStorageStats(ctx sdk.Context, contractAddress sdk.AccAddress, stats types.ContractStorageStats) {
+	store := ctx.KVStore(k.storeKey)
+	if stats.Entries == 0 && stats.Deposit.IsZero() {
+		store.Delete(types.GetContractStorageStatsKey(contractAddress))
+		return
+	}
+	store.Set(types.GetContractStorageStatsKey(contractAddress), k.cdc.MustMarshal(&stats))
+}
+
+// commitStorageStats applies the changes accounted by the store to the contract storage stats. It fails when the
+// contract grows beyond the max contract storage bytes param.
+// When storage deposits are enabled, the deposit for the stored bytes is adjusted. The optional payer is charged
+// when the contract balance does not cover additional deposit.
+func (k Keeper) commitStorageStats(ctx sdk.Context, contractAddress, payer sdk.AccAddress, store *accountingStore) error {
+	if store.bytesDelta == 0 && store.entriesDelta == 0 {
+		return nil
+	}
+	stats := k.GetContractStorageStats(ctx, contractAddress)
+	stats.Bytes = uint64(int64(stats.Bytes) + store.bytesDelta)
+	stats.Entries = uint64(int64(stats.Entries) + store.entriesDelta)
+	if maxBytes := k.getMaxContractStorageBytes(ctx); maxBytes != 0 && store.bytesDelta > 0 && stats.Bytes > maxBytes {
+		return sdkerrors.Wrapf(types.ErrContractStorageLimitExceeded, "%d bytes stored, max %d", stats.Bytes, maxBytes)
+	}
+	deposit, err := k.settleStorageDeposit(ctx, contractAddress, payer, stats.Deposit, k.requiredStorageDeposit(ctx, stats.Bytes))
+	if err != nil {
+		return err
+	}
+	stats.Deposit = deposit
+	k.setContractStorageStats(ctx, contractAddress, stats)
+	store.bytesDelta, store.entriesDelta = 0, 0
+	return nil
+}
+
+// requiredStorageDeposit returns the deposit to lock for the given number of stored bytes. Returns nil when storage
+// deposits are disabled.
+func (k Keeper) requiredStorageDeposit(ctx sdk.Context, bytes uint64) sdk.Coins {
+	var params types.Params
+	k.paramSpace.Get(ctx, types.ParamStoreKeyStorageDepositDenom, &params.StorageDepositDenom)
+	k.paramSpace.Get(ctx, types.ParamStoreKeyStorageDepositPrice, &params.StorageDepositPrice)
+	if !params.StorageDepositEnabled() {
+		return nil
+	}
+	amount := params.StorageDepositPrice.MulInt(sdk.NewIntFromUint64(bytes)).Ceil().TruncateInt()
+	return sdk.NewCoins(sdk.NewCoin(params.StorageDepositDenom, amount))
+}
+
+// settleStorageDeposit locks the missing amount of the required deposit in the module account and refunds any excess
+// to the contract. The missing amount is paid from the contract balance or, when that is insufficient, by the payer.
+// Returns the new locked deposit.
+func (k Keeper) settleStorageDeposit(ctx sdk.Context, contractAddress, payer sdk.AccAddress, locked, required sdk.Coins) (sdk.Coins, error) {
+	if locked.IsEqual(required) {
+		return locked, nil
+	}
+	var missing, excess sdk.Coins
+	for _, c := range required {
+		if diff := c.Amount.Sub(locked.AmountOf(c.Denom)); diff.IsPositive() {
+			missing = missing.Add(sdk.NewCoin(c.Denom, diff))
+		}
+	}
+	for _, c := range locked {
+		if diff := c.Amount.Sub(required.AmountOf(c.Denom)); diff.IsPositive() {
+			excess = excess.Add(sdk.NewCoin(c.Denom, diff))
+		}
+	}
+	if !excess.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, contractAddress, excess); err != nil {
+			return nil, sdkerrors.Wrap(err, "refund storage deposit")
+		}
+	}
+	if !missing.IsZero() {
+		from := contractAddress
+		if !k.bankKeeper.GetAllBalances(ctx, contractAddress).IsAllGTE(missing) {
+			if payer == nil || !k.bankKeeper.GetAllBalances(ctx, payer).IsAllGTE(missing) {
+				return nil, sdkerrors.Wrapf(types.ErrInsufficientStorageDeposit, "requires %s", missing)
+			}
+			from = payer
+		}
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, types.ModuleName, missing); err != nil {
+			return nil, sdkerrors.Wrap(types.ErrInsufficientStorageDeposit, err.Error())
+		}
+	}
+	return required, nil
+}
+
+// releaseStorageDeposit refunds the full storage deposit of a contract to its balance
+func (k Keeper) releaseStorageDeposit(ctx sdk.Context, contractAddress sdk.AccAddress) error {
+	stats := k.GetContractStorageStats(ctx, contractAddress)
+	if stats.Deposit.IsZero() {
+		return nil
+	}
+	if _, err := k.settleStorageDeposit(ctx, contractAddress, nil, stats.Deposit, nil); err != nil {
+		return err
+	}
+	stats.Deposit = nil
+	k.setContractStorageStats(ctx, contractAddress, stats)
+	return nil
+}
+
+// calculateContractStorageStats iterates through the contract's state to sum up the storage stats
+func (k Keeper) calculateContractStorageStats(ctx sdk.Context, contractAddress sdk.AccAddress) types.ContractStorageStats {
+	var stats types.ContractStorageStats
+	k.IterateContractState(ctx, contractAddress, func(key, value []byte) bool {
+		stats.Entries++
+		stats.Bytes += uint64(len(key) + len(value))
+		return false
+	})
+	return stats
+}
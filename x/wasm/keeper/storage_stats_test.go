@@ -0,0 +1,302 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/gaskv"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestAccountingStore(t *testing.T) {
+	specs := map[string]struct {
+		setup      func(s *accountingStore)
+		expBytes   int64
+		expEntries int64
+	}{
+		"set new entry": {
+			setup: func(s *accountingStore) {
+				s.Set([]byte("foo"), []byte("bar"))
+			},
+			expBytes:   6,
+			expEntries: 1,
+		},
+		"overwrite existing entry with longer value": {
+			setup: func(s *accountingStore) {
+				s.Set([]byte("existing"), []byte("12345"))
+			},
+			expBytes: 2,
+		},
+		"overwrite existing entry with shorter value": {
+			setup: func(s *accountingStore) {
+				s.Set([]byte("existing"), []byte("1"))
+			},
+			expBytes: -2,
+		},
+		"delete existing entry": {
+			setup: func(s *accountingStore) {
+				s.Delete([]byte("existing"))
+			},
+			expBytes:   -11,
+			expEntries: -1,
+		},
+		"delete non existing entry": {
+			setup: func(s *accountingStore) {
+				s.Delete([]byte("foo"))
+			},
+		},
+		"set and delete": {
+			setup: func(s *accountingStore) {
+				s.Set([]byte("foo"), []byte("bar"))
+				s.Delete([]byte("foo"))
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			contractAddr := RandomAccountAddress(t)
+			parent := dbadapter.Store{DB: dbm.NewMemDB()}
+			parent.Set(append(types.GetContractStorePrefix(contractAddr), []byte("existing")...), []byte("123"))
+			s := newAccountingStore(parent, parent, contractAddr)
+			// when
+			spec.setup(s)
+			// then
+			assert.Equal(t, spec.expBytes, s.bytesDelta)
+			assert.Equal(t, spec.expEntries, s.entriesDelta)
+		})
+	}
+}
+
+func TestAccountingStoreGas(t *testing.T) {
+	contractAddr := RandomAccountAddress(t)
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	parent.Set(append(types.GetContractStorePrefix(contractAddr), []byte("existing")...), []byte("123"))
+	specs := map[string]func(s sdk.KVStore){
+		"set new entry":       func(s sdk.KVStore) { s.Set([]byte("foo"), []byte("bar")) },
+		"overwrite entry":     func(s sdk.KVStore) { s.Set([]byte("existing"), []byte("12345")) },
+		"delete entry":        func(s sdk.KVStore) { s.Delete([]byte("existing")) },
+		"delete non existing": func(s sdk.KVStore) { s.Delete([]byte("foo")) },
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			expMeter := sdk.NewInfiniteGasMeter()
+			spec(prefix.NewStore(gaskv.NewStore(parent.CacheWrap().(sdk.KVStore), expMeter, storetypes.KVGasConfig()), types.GetContractStorePrefix(contractAddr)))
+			gotMeter := sdk.NewInfiniteGasMeter()
+			cacheStore := parent.CacheWrap().(sdk.KVStore)
+			// when
+			spec(newAccountingStore(gaskv.NewStore(cacheStore, gotMeter, storetypes.KVGasConfig()), cacheStore, contractAddr))
+			// then
+			assert.Equal(t, expMeter.GasConsumed(), gotMeter.GasConsumed())
+		})
+	}
+}
+
+func TestContractStorageStats(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	// when instantiated
+	gotStats := k.GetContractStorageStats(ctx, example.Contract)
+	// then
+	assert.NotZero(t, gotStats.Entries)
+	assert.Equal(t, k.calculateContractStorageStats(ctx, example.Contract), gotStats)
+
+	// when migrated to a contract that writes state
+	burner := StoreBurnerExampleContract(t, ctx, keepers)
+	migMsg := struct {
+		Payout sdk.AccAddress `json:"payout"`
+	}{Payout: RandomAccountAddress(t)}
+	migMsgBz, err := json.Marshal(migMsg)
+	require.NoError(t, err)
+	_, err = keepers.ContractKeeper.Migrate(ctx, example.Contract, example.CreatorAddr, burner.CodeID, migMsgBz)
+	require.NoError(t, err)
+	// then
+	assert.Equal(t, k.calculateContractStorageStats(ctx, example.Contract), k.GetContractStorageStats(ctx, example.Contract))
+
+	// when deleted
+	require.NoError(t, keepers.ContractKeeper.DeleteContract(ctx, example.Contract, example.CreatorAddr, RandomAccountAddress(t)))
+	// then
+	assert.Equal(t, types.ContractStorageStats{}, k.GetContractStorageStats(ctx, example.Contract))
+	assert.False(t, ctx.KVStore(k.storeKey).Has(types.GetContractStorageStatsKey(example.Contract)))
+}
+
+func TestContractStorageLimit(t *testing.T) {
+	specs := map[string]struct {
+		maxBytes uint64
+		expErr   bool
+	}{
+		"unlimited": {
+			maxBytes: 0,
+		},
+		"within limit": {
+			maxBytes: 1_000_000,
+		},
+		"limit exceeded": {
+			maxBytes: 1,
+			expErr:   true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			k := keepers.WasmKeeper
+			params := types.DefaultParams()
+			params.MaxContractStorageBytes = spec.maxBytes
+			k.SetParams(ctx, params)
+			example := StoreHackatomExampleContract(t, ctx, keepers)
+			initMsgBz, err := json.Marshal(HackatomExampleInitMsg{
+				Verifier:    RandomAccountAddress(t),
+				Beneficiary: RandomAccountAddress(t),
+			})
+			require.NoError(t, err)
+
+			// when
+			_, _, gotErr := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, initMsgBz, "demo contract", nil)
+
+			// then
+			if spec.expErr {
+				assert.ErrorIs(t, gotErr, types.ErrContractStorageLimitExceeded)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestStorageDeposit(t *testing.T) {
+	specs := map[string]struct {
+		price            sdk.Dec
+		funds            sdk.Coins
+		expPaidByCreator bool
+		expErr           bool
+	}{
+		"disabled": {
+			price: sdk.ZeroDec(),
+			funds: sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+		},
+		"paid from contract funds": {
+			price: sdk.NewDecWithPrec(1, 1),
+			funds: sdk.NewCoins(sdk.NewInt64Coin("denom", 100)),
+		},
+		"paid by creator when contract funds insufficient": {
+			price:            sdk.OneDec(),
+			expPaidByCreator: true,
+		},
+		"insufficient funds": {
+			price:  sdk.NewDec(100),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			k := keepers.WasmKeeper
+			params := types.DefaultParams()
+			params.StorageDepositDenom = "denom"
+			params.StorageDepositPrice = spec.price
+			k.SetParams(ctx, params)
+			example := StoreHackatomExampleContract(t, ctx, keepers)
+			initMsgBz, err := json.Marshal(HackatomExampleInitMsg{
+				Verifier:    RandomAccountAddress(t),
+				Beneficiary: RandomAccountAddress(t),
+			})
+			require.NoError(t, err)
+
+			// when
+			contractAddr, _, gotErr := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, initMsgBz, "demo contract", spec.funds)
+
+			// then
+			if spec.expErr {
+				assert.ErrorIs(t, gotErr, types.ErrInsufficientStorageDeposit)
+				return
+			}
+			require.NoError(t, gotErr)
+			stats := k.GetContractStorageStats(ctx, contractAddr)
+			expDeposit := sdk.NewCoins(sdk.NewCoin("denom", spec.price.MulInt64(int64(stats.Bytes)).Ceil().TruncateInt()))
+			assert.True(t, expDeposit.IsEqual(stats.Deposit), "exp %s, got %s", expDeposit, stats.Deposit)
+			moduleAddr := keepers.AccountKeeper.GetModuleAddress(types.ModuleName)
+			assert.True(t, expDeposit.IsEqual(keepers.BankKeeper.GetAllBalances(ctx, moduleAddr)))
+
+			expCreatorBalance, expContractBalance := example.InitialAmount.Sub(spec.funds), spec.funds
+			if spec.expPaidByCreator {
+				expCreatorBalance = expCreatorBalance.Sub(expDeposit)
+			} else {
+				expContractBalance = expContractBalance.Sub(expDeposit)
+			}
+			assert.True(t, expCreatorBalance.IsEqual(keepers.BankKeeper.GetAllBalances(ctx, example.CreatorAddr)))
+			assert.True(t, expContractBalance.IsEqual(keepers.BankKeeper.GetAllBalances(ctx, contractAddr)))
+		})
+	}
+}
+
+func TestStorageDepositRelease(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	params := types.DefaultParams()
+	params.StorageDepositDenom = "denom"
+	params.StorageDepositPrice = sdk.NewDecWithPrec(1, 1)
+	k.SetParams(ctx, params)
+	moduleAddr := keepers.AccountKeeper.GetModuleAddress(types.ModuleName)
+
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	deposit := k.GetContractStorageStats(ctx, example.Contract).Deposit
+	require.False(t, deposit.IsZero())
+
+	// when migrated to a contract that deletes all state and pays out the balance
+	burner := StoreBurnerExampleContract(t, ctx, keepers)
+	payout := RandomAccountAddress(t)
+	migMsgBz, err := json.Marshal(struct {
+		Payout sdk.AccAddress `json:"payout"`
+	}{Payout: payout})
+	require.NoError(t, err)
+	_, err = keepers.ContractKeeper.Migrate(ctx, example.Contract, example.CreatorAddr, burner.CodeID, migMsgBz)
+	require.NoError(t, err)
+	// then the deposit is refunded to the contract
+	assert.Empty(t, k.GetContractStorageStats(ctx, example.Contract).Deposit)
+	assert.Empty(t, keepers.BankKeeper.GetAllBalances(ctx, moduleAddr))
+	assert.Equal(t, deposit, keepers.BankKeeper.GetAllBalances(ctx, example.Contract))
+	assert.Equal(t, example.Deposit.Sub(deposit), keepers.BankKeeper.GetAllBalances(ctx, payout))
+
+	// when deleted
+	example = InstantiateHackatomExampleContract(t, ctx, keepers)
+	require.False(t, k.GetContractStorageStats(ctx, example.Contract).Deposit.IsZero())
+	recipient := RandomAccountAddress(t)
+	require.NoError(t, keepers.ContractKeeper.DeleteContract(ctx, example.Contract, example.CreatorAddr, recipient))
+	// then the deposit is released to the recipient
+	assert.Empty(t, keepers.BankKeeper.GetAllBalances(ctx, moduleAddr))
+	assert.Equal(t, example.Deposit, keepers.BankKeeper.GetAllBalances(ctx, recipient))
+	assert.False(t, ctx.KVStore(k.storeKey).Has(types.GetContractStorageStatsKey(example.Contract)))
+}
+
+func TestStorageDepositGenesisExportImport(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	params := types.DefaultParams()
+	params.StorageDepositDenom = "denom"
+	params.StorageDepositPrice = sdk.NewDecWithPrec(1, 1)
+	k.SetParams(ctx, params)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	expStats := k.GetContractStorageStats(ctx, example.Contract)
+	require.False(t, expStats.Deposit.IsZero())
+
+	// when
+	exported := ExportGenesis(ctx, k)
+	require.Len(t, exported.Contracts, 1)
+	assert.Equal(t, expStats.Deposit, exported.Contracts[0].StorageDeposit)
+
+	dstKeeper, dstCtx, _ := setupKeeper(t)
+	_, err := InitGenesis(dstCtx, dstKeeper, *exported)
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, expStats, dstKeeper.GetContractStorageStats(dstCtx, example.Contract))
+}
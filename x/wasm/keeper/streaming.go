@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	protoio "github.com/gogo/protobuf/io"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// StateChangeSink receives the contract state changes of a block when it is committed
+type StateChangeSink interface {
+	// WriteBlock persists the ordered changes of a block. It is called for every block, also without changes.
+	WriteBlock(height int64, changes []types.ContractStateChange) error
+	io.Closer
+}
+
+var _ baseapp.StreamingService = &StateStreamingService{}
+
+// StateStreamingService streams the contract storage and contract info writes of every block to a sink.
+//
+// The multistore passes the writes of a block to store listeners only when the block is committed, with the last value
+// of each key. Instead, the keeper journals every write in the transient store when the `WithStateStreaming` option is
+// set. Writes that are reverted, for example by a failed tx or sub message, are discarded with their journal entries.
+// The service reads the journal after the begin blocker, each tx and the end blocker to attribute the writes to the
+// block stage and tx index.
+type StateStreamingService struct {
+	tStoreKey sdk.StoreKey
+	sink      StateChangeSink
+
+	height  int64
+	txIndex uint32
+	// next journal sequence to read
+	nextSeq uint64
+	changes []types.ContractStateChange
+}
+
+// NewStateStreamingService constructor. The transient store key must be the one passed to the keeper with Option
+// `WithStateStreaming`.
+func NewStateStreamingService(tStoreKey sdk.StoreKey, sink StateChangeSink) *StateStreamingService {
+	return &StateStreamingService{tStoreKey: tStoreKey, sink: sink}
+}
+
+// Listeners returns no store listeners as the writes are read from the journal
+func (s *StateStreamingService) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return nil
+}
+
+// ListenBeginBlock collects the writes of the begin blocker. On the first block this includes the genesis state.
+func (s *StateStreamingService) ListenBeginBlock(goCtx context.Context, req abci.RequestBeginBlock, _ abci.ResponseBeginBlock) error {
+	s.height = req.Header.Height
+	s.txIndex = 0
+	s.collect(sdk.UnwrapSDKContext(goCtx), types.BlockStageBeginBlock, 0)
+	return nil
+}
+
+// ListenDeliverTx collects the writes of the tx. The writes of a failed tx were reverted and are not included.
+func (s *StateStreamingService) ListenDeliverTx(goCtx context.Context, _ abci.RequestDeliverTx, _ abci.ResponseDeliverTx) error {
+	s.collect(sdk.UnwrapSDKContext(goCtx), types.BlockStageDeliverTx, s.txIndex)
+	s.txIndex++
+	return nil
+}
+
+// ListenEndBlock collects the writes of the end blocker
+func (s *StateStreamingService) ListenEndBlock(goCtx context.Context, _ abci.RequestEndBlock, _ abci.ResponseEndBlock) error {
+	s.collect(sdk.UnwrapSDKContext(goCtx), types.BlockStageEndBlock, 0)
+	return nil
+}
+
+// ListenCommit passes all changes of the block to the sink. Sink errors are logged only so that they do not halt
+// the node.
+func (s *StateStreamingService) ListenCommit(goCtx context.Context, _ abci.ResponseCommit) error {
+	changes := s.changes
+	s.changes, s.nextSeq = nil, 0 // the transient store is reset on commit
+	if err := s.sink.WriteBlock(s.height, changes); err != nil {
+		moduleLogger(sdk.UnwrapSDKContext(goCtx)).Error("write state changes", "height", s.height, "error", err.Error())
+	}
+	return nil
+}
+
+// Stream is a noop as changes are passed to the sink synchronously on commit
+func (s *StateStreamingService) Stream(*sync.WaitGroup) error {
+	return nil
+}
+
+// Close closes the sink
+func (s *StateStreamingService) Close() error {
+	return s.sink.Close()
+}
+
+// collect reads the journal entries written since the last call and assigns them to the given stage
+func (s *StateStreamingService) collect(ctx sdk.Context, stage types.BlockStage, txIndex uint32) {
+	store := ctx.MultiStore().GetKVStore(s.tStoreKey)
+	iter := store.Iterator(types.GetStateChangeJournalKey(s.nextSeq), sdk.PrefixEndBytes(types.StateChangeJournalPrefix))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var c types.ContractStateChange
+		if err := c.Unmarshal(iter.Value()); err != nil { // should never happen
+			panic(err.Error())
+		}
+		c.Height, c.Stage, c.TxIndex = s.height, stage, txIndex
+		s.changes = append(s.changes, c)
+		s.nextSeq = sdk.BigEndianToUint64(iter.Key()[len(types.StateChangeJournalPrefix):]) + 1
+	}
+}
+
+// journalStateChange appends the contract state change to the journal in the transient store when state streaming
+// is enabled. The journal is not gas metered so that streaming does not affect the gas consumption.
+func (k Keeper) journalStateChange(ctx sdk.Context, change types.ContractStateChange) {
+	if k.stateChangeJournalKey == nil {
+		return
+	}
+	store := ctx.MultiStore().GetKVStore(k.stateChangeJournalKey)
+	var seq uint64
+	if bz := store.Get(types.StateChangeJournalSeqKey); bz != nil {
+		seq = sdk.BigEndianToUint64(bz)
+	}
+	bz, err := change.Marshal()
+	if err != nil { // should never happen
+		panic(err.Error())
+	}
+	store.Set(types.GetStateChangeJournalKey(seq), bz)
+	store.Set(types.StateChangeJournalSeqKey, sdk.Uint64ToBigEndian(seq+1))
+}
+
+var _ StateChangeSink = &FileStateChangeSink{}
+
+// FileStateChangeSink appends the contract state changes to a file. Each change is written as binary protobuf,
+// prefixed with its length as uvarint.
+type FileStateChangeSink struct {
+	file *os.File
+	w    *bufio.Writer
+	pw   protoio.WriteCloser
+}
+
+// NewFileStateChangeSink opens or creates the file at the given path to append to
+func NewFileStateChangeSink(path string) (*FileStateChangeSink, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "open file: %s", path)
+	}
+	w := bufio.NewWriter(f)
+	return &FileStateChangeSink{file: f, w: w, pw: protoio.NewDelimitedWriter(w)}, nil
+}
+
+// WriteBlock writes the changes and flushes them to the file
+func (f *FileStateChangeSink) WriteBlock(_ int64, changes []types.ContractStateChange) error {
+	for i := range changes {
+		if err := f.pw.WriteMsg(&changes[i]); err != nil {
+			return err
+		}
+	}
+	return f.w.Flush()
+}
+
+// Close flushes and closes the file
+func (f *FileStateChangeSink) Close() error {
+	if err := f.w.Flush(); err != nil {
+		return err
+	}
+	return f.file.Close()
+}
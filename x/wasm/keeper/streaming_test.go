@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestStateStreamingService(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	k.stateChangeJournalKey = keepers.WasmTStoreKey
+	example := StoreHackatomExampleContract(t, parentCtx, keepers)
+	initMsg := HackatomExampleInitMsg{Verifier: RandomAccountAddress(t), Beneficiary: RandomAccountAddress(t)}.GetBytes(t)
+
+	sink := &mockStateChangeSink{err: errors.New("testing")}
+	svc := NewStateStreamingService(keepers.WasmTStoreKey, sink)
+	deliverCtx := parentCtx.WithMultiStore(keepers.MultiStore.CacheMultiStore())
+	goCtx := sdk.WrapSDKContext(deliverCtx)
+	// runs the tx in a branch that is written on success only, like baseapp
+	deliverTx := func(fn func(ctx sdk.Context) error) {
+		txCtx, commit := deliverCtx.CacheContext()
+		if fn(txCtx) == nil {
+			commit()
+		}
+		require.NoError(t, svc.ListenDeliverTx(goCtx, abci.RequestDeliverTx{}, abci.ResponseDeliverTx{}))
+	}
+
+	// when
+	require.NoError(t, svc.ListenBeginBlock(goCtx, abci.RequestBeginBlock{Header: tmproto.Header{Height: 10}}, abci.ResponseBeginBlock{}))
+	// tx 0 creates a contract
+	var contractAddr sdk.AccAddress
+	deliverTx(func(ctx sdk.Context) (err error) {
+		contractAddr, _, err = keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, example.CreatorAddr, initMsg, "label", nil)
+		require.NoError(t, err)
+		return nil
+	})
+	contractInfoBz := k.cdc.MustMarshal(k.GetContractInfo(deliverCtx, contractAddr))
+	configBz := k.QueryRaw(deliverCtx, contractAddr, []byte("config"))
+	// tx 1 fails
+	deliverTx(func(ctx sdk.Context) error {
+		k.contractStore(ctx, contractAddr).Set([]byte("reverted"), []byte("value"))
+		return errors.New("testing")
+	})
+	// tx 2 writes the same key twice and deletes a key
+	deliverTx(func(ctx sdk.Context) error {
+		s := k.contractStore(ctx, contractAddr)
+		s.Set([]byte("foo"), []byte("bar"))
+		s.Set([]byte("foo"), []byte("baz"))
+		s.Delete([]byte("config"))
+		return nil
+	})
+	// end blocker deletes the contract
+	require.NoError(t, keepers.ContractKeeper.DeleteContract(deliverCtx, contractAddr, example.CreatorAddr, RandomAccountAddress(t)))
+	require.NoError(t, svc.ListenEndBlock(goCtx, abci.RequestEndBlock{}, abci.ResponseEndBlock{}))
+	deliverCtx.MultiStore().(sdk.CacheMultiStore).Write()
+	keepers.MultiStore.GetCommitKVStore(keepers.WasmTStoreKey).Commit() // reset transient store like on commit
+	require.Empty(t, sink.blocks)
+	// sink errors are not returned
+	require.NoError(t, svc.ListenCommit(goCtx, abci.ResponseCommit{}))
+
+	// then
+	require.Len(t, sink.blocks, 1)
+	assert.Equal(t, int64(10), sink.blocks[0].height)
+	addr := contractAddr.String()
+	storage, info := types.ContractStateChangeTypeStorage, types.ContractStateChangeTypeInfo
+	deliver, end := types.BlockStageDeliverTx, types.BlockStageEndBlock
+	exp := []types.ContractStateChange{
+		{Height: 10, Stage: deliver, ContractAddress: addr, Type: storage, Key: []byte("config"), Value: configBz},
+		{Height: 10, Stage: deliver, ContractAddress: addr, Type: info, Value: contractInfoBz},
+		{Height: 10, Stage: deliver, TxIndex: 2, ContractAddress: addr, Type: storage, Key: []byte("foo"), Value: []byte("bar")},
+		{Height: 10, Stage: deliver, TxIndex: 2, ContractAddress: addr, Type: storage, Key: []byte("foo"), Value: []byte("baz")},
+		{Height: 10, Stage: deliver, TxIndex: 2, ContractAddress: addr, Type: storage, Key: []byte("config"), Delete: true},
+		{Height: 10, Stage: end, ContractAddress: addr, Type: storage, Key: []byte("foo"), Delete: true},
+		{Height: 10, Stage: end, ContractAddress: addr, Type: info, Delete: true},
+	}
+	assert.Equal(t, exp, sink.blocks[0].changes)
+
+	// and next block without changes
+	require.NoError(t, svc.ListenBeginBlock(goCtx, abci.RequestBeginBlock{Header: tmproto.Header{Height: 11}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, svc.ListenCommit(goCtx, abci.ResponseCommit{}))
+	require.Len(t, sink.blocks, 2)
+	assert.Equal(t, int64(11), sink.blocks[1].height)
+	assert.Empty(t, sink.blocks[1].changes)
+}
+
+func TestJournalStateChangeWithoutGas(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	k.stateChangeJournalKey = keepers.WasmTStoreKey
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(1_000_000))
+	// when
+	k.journalStateChange(ctx, types.ContractStateChange{ContractAddress: RandomBech32AccountAddress(t), Key: []byte("foo")})
+	// then
+	assert.Equal(t, sdk.Gas(0), ctx.GasMeter().GasConsumed())
+}
+
+func TestFileStateChangeSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "changes.bin")
+	sink, err := NewFileStateChangeSink(path)
+	require.NoError(t, err)
+	changes := []types.ContractStateChange{
+		{Height: 1, ContractAddress: RandomBech32AccountAddress(t), Type: types.ContractStateChangeTypeStorage, Key: []byte("foo"), Value: []byte("bar\n")},
+		{Height: 3, ContractAddress: RandomBech32AccountAddress(t), Type: types.ContractStateChangeTypeInfo, Delete: true},
+	}
+	require.NoError(t, sink.WriteBlock(1, changes[:1]))
+	require.NoError(t, sink.WriteBlock(2, nil))
+	require.NoError(t, sink.WriteBlock(3, changes[1:]))
+	require.NoError(t, sink.Close())
+
+	// appends on reopen
+	sink, err = NewFileStateChangeSink(path)
+	require.NoError(t, err)
+	require.NoError(t, sink.WriteBlock(4, changes[:1]))
+	require.NoError(t, sink.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	r := protoio.NewDelimitedReader(f, 1<<20)
+	var got []types.ContractStateChange
+	for {
+		var c types.ContractStateChange
+		err := r.ReadMsg(&c)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		got = append(got, c)
+	}
+	assert.Equal(t, []types.ContractStateChange{changes[0], changes[1], changes[0]}, got)
+}
+
+type mockStateChangeSink struct {
+	err    error
+	blocks []struct {
+		height  int64
+		changes []types.ContractStateChange
+	}
+}
+
+func (m *mockStateChangeSink) WriteBlock(height int64, changes []types.ContractStateChange) error {
+	m.blocks = append(m.blocks, struct {
+		height  int64
+		changes []types.ContractStateChange
+	}{height: height, changes: changes})
+	return m.err
+}
+
+func (m *mockStateChangeSink) Close() error {
+	return nil
+}
@@ -183,6 +183,8 @@ type TestKeepers struct {
 	Faucet           *TestFaucet
 	MultiStore       sdk.CommitMultiStore
 	ScopedWasmKeeper capabilitykeeper.ScopedKeeper
+	ScopedICAKeeper  capabilitykeeper.ScopedKeeper
+	WasmTStoreKey    sdk.StoreKey
 }
 
 // CreateDefaultTestInput common settings for CreateTestInput
@@ -219,7 +221,7 @@ func createTestInput(
 	for _, v := range keys {
 		ms.MountStoreWithDB(v, sdk.StoreTypeIAVL, db)
 	}
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, types.TStoreKey)
 	for _, v := range tkeys {
 		ms.MountStoreWithDB(v, sdk.StoreTypeTransient, db)
 	}
@@ -346,6 +348,7 @@ func createTestInput(
 	)
 	scopedIBCKeeper := capabilityKeeper.ScopeToModule(ibchost.ModuleName)
 	scopedWasmKeeper := capabilityKeeper.ScopeToModule(types.ModuleName)
+	scopedICAKeeper := capabilityKeeper.ScopeToModule(types.ICAControllerModuleName)
 
 	ibcKeeper := ibckeeper.NewKeeper(
 		appCodec,
@@ -403,7 +406,7 @@ func createTestInput(
 	)
 	am.RegisterServices(module.NewConfigurator(appCodec, msgRouter, querier))
 	types.RegisterMsgServer(msgRouter, NewMsgServerImpl(NewDefaultPermissionKeeper(keeper)))
-	types.RegisterQueryServer(querier, NewGrpcQuerier(appCodec, keys[types.ModuleName], keeper, keeper.queryGasLimit))
+	types.RegisterQueryServer(querier, Querier(&keeper))
 
 	govRouter := govtypes.NewRouter().
 		AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
@@ -440,6 +443,8 @@ func createTestInput(
 		Faucet:           faucet,
 		MultiStore:       ms,
 		ScopedWasmKeeper: scopedWasmKeeper,
+		ScopedICAKeeper:  scopedICAKeeper,
+		WasmTStoreKey:    tkeys[types.TStoreKey],
 	}
 	return ctx, keepers
 }
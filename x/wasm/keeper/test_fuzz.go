@@ -10,7 +10,7 @@ import (
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
 
-var ModelFuzzers = []interface{}{FuzzAddr, FuzzAddrString, FuzzAbsoluteTxPosition, FuzzContractInfo, FuzzStateModel, FuzzAccessType, FuzzAccessConfig, FuzzContractCodeHistory}
+var ModelFuzzers = []interface{}{FuzzAddr, FuzzAddrString, FuzzAbsoluteTxPosition, FuzzContractInfo, FuzzStateModel, FuzzAccessType, FuzzAccessConfig, FuzzLabelIndexScope, FuzzContractCodeHistory, FuzzDec}
 
 func FuzzAddr(m *sdk.AccAddress, c fuzz.Continue) {
 	*m = make([]byte, 20)
@@ -39,14 +39,16 @@ func FuzzContractInfo(m *types.ContractInfo, c fuzz.Continue) {
 func FuzzContractCodeHistory(m *types.ContractCodeHistoryEntry, c fuzz.Continue) {
 	const maxMsgSize = 128
 	m.CodeID = c.RandUint64()
-	msg := make([]byte, c.RandUint64()%maxMsgSize)
-	c.Read(msg)
-	var err error
-	if m.Msg, err = json.Marshal(msg); err != nil {
-		panic(err)
+	m.Operation = types.AllCodeHistoryTypes[c.Int()%len(types.AllCodeHistoryTypes)]
+	if m.Operation.IsCodeUpdate() {
+		msg := make([]byte, c.RandUint64()%maxMsgSize)
+		c.Read(msg)
+		var err error
+		if m.Msg, err = json.Marshal(msg); err != nil {
+			panic(err)
+		}
 	}
 	c.Fuzz(&m.Updated)
-	m.Operation = types.AllCodeHistoryTypes[c.Int()%len(types.AllCodeHistoryTypes)]
 }
 
 func FuzzStateModel(m *types.Model, c fuzz.Continue) {
@@ -74,3 +76,11 @@ func FuzzAccessConfig(m *types.AccessConfig, c fuzz.Continue) {
 	FuzzAddr(&add, c)
 	*m = m.Permission.With(add)
 }
+
+func FuzzLabelIndexScope(m *types.LabelIndexScope, c fuzz.Continue) {
+	*m = types.LabelIndexScope(c.Intn(len(types.LabelIndexScope_name)))
+}
+
+func FuzzDec(m *sdk.Dec, c fuzz.Continue) {
+	*m = sdk.NewDecWithPrec(c.Int63n(1_000_000), sdk.Precision)
+}
@@ -3,6 +3,7 @@ package wasmtesting
 import (
 	"bytes"
 	"crypto/sha256"
+	"errors"
 
 	wasmvm "github.com/CosmWasm/wasmvm"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
@@ -26,6 +27,7 @@ type MockWasmer struct {
 	SudoFn              func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error)
 	ReplyFn             func(codeID wasmvm.Checksum, env wasmvmtypes.Env, reply wasmvmtypes.Reply, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error)
 	GetCodeFn           func(codeID wasmvm.Checksum) (wasmvm.WasmCode, error)
+	RemoveCodeFn        func(checksum wasmvm.Checksum) error
 	CleanupFn           func()
 	IBCChannelOpenFn    func(codeID wasmvm.Checksum, env wasmvmtypes.Env, msg wasmvmtypes.IBCChannelOpenMsg, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.IBC3ChannelOpenResponse, uint64, error)
 	IBCChannelConnectFn func(codeID wasmvm.Checksum, env wasmvmtypes.Env, msg wasmvmtypes.IBCChannelConnectMsg, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.IBCBasicResponse, uint64, error)
@@ -143,6 +145,13 @@ func (m *MockWasmer) GetCode(codeID wasmvm.Checksum) (wasmvm.WasmCode, error) {
 	return m.GetCodeFn(codeID)
 }
 
+func (m *MockWasmer) RemoveCode(checksum wasmvm.Checksum) error {
+	if m.RemoveCodeFn == nil {
+		panic("not supposed to be called!")
+	}
+	return m.RemoveCodeFn(checksum)
+}
+
 func (m *MockWasmer) Cleanup() {
 	if m.CleanupFn == nil {
 		panic("not supposed to be called!")
@@ -318,9 +327,33 @@ func NewIBCContractMockWasmer(c IBCContractCallbacks) *MockWasmer {
 	if e, ok := c.(contractExecutable); ok { // optional function
 		m.ExecuteFn = e.Execute
 	}
+	if s, ok := c.(contractSudoable); ok { // optional function
+		m.SudoFn = s.Sudo
+	} else {
+		m.SudoFn = NoSudoFn
+	}
 	return m
 }
 
+type contractSudoable interface {
+	Sudo(
+		codeID wasmvm.Checksum,
+		env wasmvmtypes.Env,
+		sudoMsg []byte,
+		store wasmvm.KVStore,
+		goapi wasmvm.GoAPI,
+		querier wasmvm.Querier,
+		gasMeter wasmvm.GasMeter,
+		gasLimit uint64,
+		deserCost wasmvmtypes.UFraction,
+	) (*wasmvmtypes.Response, uint64, error)
+}
+
+// NoSudoFn fails like a contract without a sudo entry point
+func NoSudoFn(wasmvm.Checksum, wasmvmtypes.Env, []byte, wasmvm.KVStore, wasmvm.GoAPI, wasmvm.Querier, wasmvm.GasMeter, uint64, wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+	return nil, 0, errors.New("missing export sudo")
+}
+
 func HashOnlyCreateFn(code wasmvm.WasmCode) (wasmvm.Checksum, error) {
 	if code == nil {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "wasm code must not be nil")
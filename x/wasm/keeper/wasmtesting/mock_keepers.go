@@ -10,13 +10,14 @@ import (
 )
 
 type MockChannelKeeper struct {
-	GetChannelFn          func(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
-	GetNextSequenceSendFn func(ctx sdk.Context, portID, channelID string) (uint64, bool)
-	SendPacketFn          func(ctx sdk.Context, channelCap *capabilitytypes.Capability, packet ibcexported.PacketI) error
-	ChanCloseInitFn       func(ctx sdk.Context, portID, channelID string, chanCap *capabilitytypes.Capability) error
-	GetAllChannelsFn      func(ctx sdk.Context) []channeltypes.IdentifiedChannel
-	IterateChannelsFn     func(ctx sdk.Context, cb func(channeltypes.IdentifiedChannel) bool)
-	SetChannelFn          func(ctx sdk.Context, portID, channelID string, channel channeltypes.Channel)
+	GetChannelFn           func(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
+	GetNextSequenceSendFn  func(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	SendPacketFn           func(ctx sdk.Context, channelCap *capabilitytypes.Capability, packet ibcexported.PacketI) error
+	ChanCloseInitFn        func(ctx sdk.Context, portID, channelID string, chanCap *capabilitytypes.Capability) error
+	GetAllChannelsFn       func(ctx sdk.Context) []channeltypes.IdentifiedChannel
+	IterateChannelsFn      func(ctx sdk.Context, cb func(channeltypes.IdentifiedChannel) bool)
+	SetChannelFn           func(ctx sdk.Context, portID, channelID string, channel channeltypes.Channel)
+	WriteAcknowledgementFn func(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, acknowledgement ibcexported.Acknowledgement) error
 }
 
 func (m *MockChannelKeeper) GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool) {
@@ -68,6 +69,13 @@ func (m *MockChannelKeeper) SetChannel(ctx sdk.Context, portID, channelID string
 	m.SetChannelFn(ctx, portID, channelID, channel)
 }
 
+func (m *MockChannelKeeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, acknowledgement ibcexported.Acknowledgement) error {
+	if m.WriteAcknowledgementFn == nil {
+		panic("not supposed to be called!")
+	}
+	return m.WriteAcknowledgementFn(ctx, chanCap, packet, acknowledgement)
+}
+
 func MockChannelKeeperIterator(s []channeltypes.IdentifiedChannel) func(ctx sdk.Context, cb func(channeltypes.IdentifiedChannel) bool) {
 	return func(ctx sdk.Context, cb func(channeltypes.IdentifiedChannel) bool) {
 		for _, channel := range s {
@@ -83,6 +91,7 @@ type MockCapabilityKeeper struct {
 	GetCapabilityFn          func(ctx sdk.Context, name string) (*capabilitytypes.Capability, bool)
 	ClaimCapabilityFn        func(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error
 	AuthenticateCapabilityFn func(ctx sdk.Context, capability *capabilitytypes.Capability, name string) bool
+	ReleaseCapabilityFn      func(ctx sdk.Context, cap *capabilitytypes.Capability) error
 }
 
 func (m MockCapabilityKeeper) GetCapability(ctx sdk.Context, name string) (*capabilitytypes.Capability, bool) {
@@ -106,6 +115,13 @@ func (m MockCapabilityKeeper) AuthenticateCapability(ctx sdk.Context, capability
 	return m.AuthenticateCapabilityFn(ctx, capability, name)
 }
 
+func (m MockCapabilityKeeper) ReleaseCapability(ctx sdk.Context, cap *capabilitytypes.Capability) error {
+	if m.ReleaseCapabilityFn == nil {
+		panic("not supposed to be called!")
+	}
+	return m.ReleaseCapabilityFn(ctx, cap)
+}
+
 var _ types.ICS20TransferPortSource = &MockIBCTransferKeeper{}
 
 type MockIBCTransferKeeper struct {
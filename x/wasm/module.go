@@ -40,6 +40,9 @@ const (
 	flagWasmMemoryCacheSize    = "wasm.memory_cache_size"
 	flagWasmQueryGasLimit      = "wasm.query_gas_limit"
 	flagWasmSimulationGasLimit = "wasm.simulation_gas_limit"
+	flagWasmEmitTypedEvents    = "wasm.emit_typed_events"
+	flagWasmStateStreamingFile = "wasm.state_streaming_file"
+	flagWasmMaxEventSubs       = "wasm.max_contract_event_subscriptions"
 )
 
 // AppModuleBasic defines the basic application module used by the wasm module.
@@ -115,7 +118,7 @@ type AppModule struct {
 // module. It should be incremented on each consensus-breaking change
 // introduced by the module. To avoid wrong/empty versions, the initial version
 // should be set to 1.
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
 
 // NewAppModule creates a new AppModule object
 func NewAppModule(
@@ -144,6 +147,10 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(err)
 	}
+	err = cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3)
+	if err != nil {
+		panic(err)
+	}
 }
 
 func (am AppModule) LegacyQuerierHandler(amino *codec.LegacyAmino) sdk.Querier { //nolint:staticcheck
@@ -182,12 +189,18 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
-// BeginBlock returns the begin blocker for the wasm module.
-func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+// BeginBlock returns the begin blocker for the wasm module. It rebuilds the index of contracts by label when its
+// scope was changed.
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	am.keeper.SyncContractLabelIndex(ctx)
+}
 
-// EndBlock returns the end blocker for the wasm module. It returns no validator
-// updates.
-func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+// EndBlock returns the end blocker for the wasm module. It executes the due contract callbacks and cron jobs, removes
+// the wasm code of removed codes from the wasmvm cache and returns no validator updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.ExecuteCallbacks(ctx)
+	am.keeper.ExecuteCronJobs(ctx)
+	am.keeper.PruneRemovedCodes(ctx)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -227,6 +240,9 @@ func AddModuleInitFlags(startCmd *cobra.Command) {
 	startCmd.Flags().Uint32(flagWasmMemoryCacheSize, defaults.MemoryCacheSize, "Sets the size in MiB (NOT bytes) of an in-memory cache for Wasm modules. Set to 0 to disable.")
 	startCmd.Flags().Uint64(flagWasmQueryGasLimit, defaults.SmartQueryGasLimit, "Set the max gas that can be spent on executing a query with a Wasm contract")
 	startCmd.Flags().String(flagWasmSimulationGasLimit, "", "Set the max gas that can be spent when executing a simulation TX")
+	startCmd.Flags().Bool(flagWasmEmitTypedEvents, defaults.EmitTypedEvents, "Emit proto typed events for wasm operations in addition to the legacy events")
+	startCmd.Flags().String(flagWasmStateStreamingFile, defaults.StateStreamingFile, "Set the file to stream the contract state changes of each block to as length-delimited protobuf. Disabled when empty")
+	startCmd.Flags().Uint32(flagWasmMaxEventSubs, defaults.MaxContractEventSubscriptions, "Set the max number of concurrent gRPC subscriptions to contract events. Set to 0 to disable")
 
 	startCmd.PreRunE = chainPreRuns(checkLibwasmVersion, startCmd.PreRunE)
 }
@@ -254,6 +270,21 @@ func ReadWasmConfig(opts servertypes.AppOptions) (types.WasmConfig, error) {
 			cfg.SimulationGasLimit = &limit
 		}
 	}
+	if v := opts.Get(flagWasmEmitTypedEvents); v != nil {
+		if cfg.EmitTypedEvents, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagWasmStateStreamingFile); v != nil {
+		if cfg.StateStreamingFile, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagWasmMaxEventSubs); v != nil {
+		if cfg.MaxContractEventSubscriptions, err = cast.ToUint32E(v); err != nil {
+			return cfg, err
+		}
+	}
 	// attach contract debugging to global "trace" flag
 	if v := opts.Get(server.FlagTrace); v != nil {
 		if cfg.ContractDebugMode, err = cast.ToBoolE(v); err != nil {
@@ -441,6 +441,36 @@ func TestReadWasmConfig(t *testing.T) {
 				ContractDebugMode:  true,
 			},
 		},
+		"set typed events via opts": {
+			src: AppOptionsMock{
+				"wasm.emit_typed_events": true,
+			},
+			exp: types.WasmConfig{
+				SmartQueryGasLimit: defaults.SmartQueryGasLimit,
+				MemoryCacheSize:    defaults.MemoryCacheSize,
+				EmitTypedEvents:    true,
+			},
+		},
+		"set state streaming file via opts": {
+			src: AppOptionsMock{
+				"wasm.state_streaming_file": "data/wasm-state.bin",
+			},
+			exp: types.WasmConfig{
+				SmartQueryGasLimit: defaults.SmartQueryGasLimit,
+				MemoryCacheSize:    defaults.MemoryCacheSize,
+				StateStreamingFile: "data/wasm-state.bin",
+			},
+		},
+		"set max contract event subscriptions via opts": {
+			src: AppOptionsMock{
+				"wasm.max_contract_event_subscriptions": 10,
+			},
+			exp: types.WasmConfig{
+				SmartQueryGasLimit:            defaults.SmartQueryGasLimit,
+				MemoryCacheSize:               defaults.MemoryCacheSize,
+				MaxContractEventSubscriptions: 10,
+			},
+		},
 		"all defaults when no options set": {
 			exp: defaults,
 		},
@@ -3,6 +3,7 @@ package wasm_test
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -10,13 +11,18 @@ import (
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	icahosttypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v4/modules/apps/27-interchain-accounts/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	ibctesting "github.com/cosmos/ibc-go/v4/testing"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/CosmWasm/wasmd/x/wasm"
 	wasmibctesting "github.com/CosmWasm/wasmd/x/wasm/ibctesting"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtesting "github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
@@ -129,6 +135,120 @@ func TestFromIBCTransferToContract(t *testing.T) {
 	}
 }
 
+func TestIBCHooksExecuteContract(t *testing.T) {
+	// scenario: given two chains,
+	//           with a contract on chain B
+	//           when an ics20 transfer with a wasm hook in the memo is sent from chain A to the contract
+	//           then the contract is executed with the received tokens
+	//           or the tokens are refunded when the contract fails
+
+	transferAmount := sdk.NewInt(100)
+	specs := map[string]struct {
+		contractErr          error
+		disableExecute       bool
+		memo                 func(contractAddr sdk.AccAddress) string
+		expExecuted          bool
+		expChainABalanceDiff sdk.Int
+		expContractBalance   sdk.Int
+	}{
+		"contract executed": {
+			memo: func(contractAddr sdk.AccAddress) string {
+				return fmt.Sprintf(`{"wasm":{"contract":%q,"msg":{"deposit":{}}}}`, contractAddr.String())
+			},
+			expExecuted:          true,
+			expChainABalanceDiff: transferAmount.Neg(),
+			expContractBalance:   transferAmount,
+		},
+		"contract fails": {
+			contractErr: errors.New("testing"),
+			memo: func(contractAddr sdk.AccAddress) string {
+				return fmt.Sprintf(`{"wasm":{"contract":%q,"msg":{"deposit":{}}}}`, contractAddr.String())
+			},
+			expExecuted:          true,
+			expChainABalanceDiff: sdk.ZeroInt(),
+			expContractBalance:   sdk.ZeroInt(),
+		},
+		"execute disabled by circuit breaker": {
+			disableExecute: true,
+			memo: func(contractAddr sdk.AccAddress) string {
+				return fmt.Sprintf(`{"wasm":{"contract":%q,"msg":{"deposit":{}}}}`, contractAddr.String())
+			},
+			expChainABalanceDiff: sdk.ZeroInt(),
+			expContractBalance:   sdk.ZeroInt(),
+		},
+		"contract not receiver": {
+			memo: func(_ sdk.AccAddress) string {
+				return fmt.Sprintf(`{"wasm":{"contract":%q,"msg":{"deposit":{}}}}`, wasmkeeper.RandomBech32AccountAddress(t))
+			},
+			expChainABalanceDiff: sdk.ZeroInt(),
+			expContractBalance:   sdk.ZeroInt(),
+		},
+		"memo without wasm hook": {
+			memo: func(_ sdk.AccAddress) string {
+				return `{"other":{}}`
+			},
+			expChainABalanceDiff: transferAmount.Neg(),
+			expContractBalance:   transferAmount,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var (
+				myContract  = &ibcHookReceiverContract{err: spec.contractErr}
+				chainBOpts  = []wasmkeeper.Option{wasmkeeper.WithWasmEngine(wasmtesting.NewIBCContractMockWasmer(myContract))}
+				coordinator = wasmibctesting.NewCoordinator(t, 2, []wasmkeeper.Option{}, chainBOpts)
+				chainA      = coordinator.GetChain(wasmibctesting.GetChainID(0))
+				chainB      = coordinator.GetChain(wasmibctesting.GetChainID(1))
+			)
+			coordinator.CommitBlock(chainA, chainB)
+			myContractAddr := chainB.SeedNewContractInstance()
+			if spec.disableExecute {
+				ops := []types.OperationType{types.OperationTypeExecute}
+				require.NoError(t, wasmkeeper.NewGovPermissionKeeper(chainB.App.WasmKeeper).DisableOperations(chainB.GetContext(), nil, ops))
+			}
+
+			path := wasmibctesting.NewPath(chainA, chainB)
+			path.EndpointA.ChannelConfig = &ibctesting.ChannelConfig{
+				PortID:  ibctransfertypes.PortID,
+				Version: ibctransfertypes.Version,
+				Order:   channeltypes.UNORDERED,
+			}
+			path.EndpointB.ChannelConfig = &ibctesting.ChannelConfig{
+				PortID:  ibctransfertypes.PortID,
+				Version: ibctransfertypes.Version,
+				Order:   channeltypes.UNORDERED,
+			}
+			coordinator.SetupConnections(path)
+			coordinator.CreateChannels(path)
+
+			originalChainABalance := chainA.Balance(chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+			// when transfer with memo from A -> B (contract)
+			coinToSendToB := sdk.NewCoin(sdk.DefaultBondDenom, transferAmount)
+			msg := ibctransfertypes.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coinToSendToB, chainA.SenderAccount.GetAddress().String(), myContractAddr.String(), clienttypes.NewHeight(1, 110), 0)
+			msg.Memo = spec.memo(myContractAddr)
+			_, err := chainA.SendMsgs(msg)
+			require.NoError(t, err)
+			require.NoError(t, path.EndpointB.UpdateClient())
+			require.NoError(t, coordinator.RelayAndAckPendingPackets(path))
+
+			// then
+			expVoucher := ibctransfertypes.GetTransferCoin(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coinToSendToB.Denom, spec.expContractBalance)
+			assert.Equal(t, expVoucher, chainB.Balance(myContractAddr, expVoucher.Denom))
+			newChainABalance := chainA.Balance(chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+			assert.Equal(t, originalChainABalance.Amount.Add(spec.expChainABalanceDiff), newChainABalance.Amount)
+			if !spec.expExecuted {
+				assert.Nil(t, myContract.executed)
+				return
+			}
+			require.NotNil(t, myContract.executed)
+			expSender := wasm.DeriveIBCHooksSender(path.EndpointB.ChannelID, chainA.SenderAccount.GetAddress().String())
+			assert.Equal(t, expSender.String(), myContract.executed.Sender)
+			assert.Equal(t, wasmvmtypes.Coins{wasmvmtypes.NewCoin(transferAmount.Uint64(), expVoucher.Denom)}, myContract.executed.Funds)
+			assert.Empty(t, chainB.AllBalances(expSender))
+		})
+	}
+}
+
 func TestContractCanInitiateIBCTransferMsg(t *testing.T) {
 	// scenario: given two chains,
 	//           with a contract on chain A
@@ -199,6 +319,384 @@ func TestContractCanInitiateIBCTransferMsg(t *testing.T) {
 	assert.Equal(t, expBalance, gotBalance, "got total balance: %s", bankKeeperB.GetAllBalances(chainB.GetContext(), chainB.SenderAccount.GetAddress()))
 }
 
+func TestContractReceivesIBCTransferLifecycleCallback(t *testing.T) {
+	// scenario: given two chains,
+	//           with a contract on chain A that starts an ibc transfer with the ibc_transfer_with_callback custom message
+	//           then the contract is called back via sudo when the transfer was acknowledged or timed out
+	//           or not called back for a transfer via IBCMsg::Transfer
+
+	specs := map[string]struct {
+		receiver        func(chainB *wasmibctesting.TestChain) string
+		timeout         bool
+		withoutCallback bool
+		expSuccess      bool
+	}{
+		"without callback": {
+			receiver:        func(chainB *wasmibctesting.TestChain) string { return chainB.SenderAccount.GetAddress().String() },
+			withoutCallback: true,
+			expSuccess:      true,
+		},
+		"success ack": {
+			receiver:   func(chainB *wasmibctesting.TestChain) string { return chainB.SenderAccount.GetAddress().String() },
+			expSuccess: true,
+		},
+		"error ack": {
+			receiver: func(_ *wasmibctesting.TestChain) string { return "invalid address" },
+		},
+		"timeout": {
+			receiver: func(chainB *wasmibctesting.TestChain) string { return chainB.SenderAccount.GetAddress().String() },
+			timeout:  true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			myContract := &ibcTransferCallbackContract{withoutCallback: spec.withoutCallback}
+			mockWasmer := wasmtesting.NewIBCContractMockWasmer(myContract)
+			var (
+				chainAOpts  = []wasmkeeper.Option{wasmkeeper.WithWasmEngine(mockWasmer)}
+				coordinator = wasmibctesting.NewCoordinator(t, 2, chainAOpts)
+				chainA      = coordinator.GetChain(wasmibctesting.GetChainID(0))
+				chainB      = coordinator.GetChain(wasmibctesting.GetChainID(1))
+			)
+			myContractAddr := chainA.SeedNewContractInstance()
+			coordinator.CommitBlock(chainA, chainB)
+
+			path := wasmibctesting.NewPath(chainA, chainB)
+			path.EndpointA.ChannelConfig = &ibctesting.ChannelConfig{
+				PortID:  ibctransfertypes.PortID,
+				Version: ibctransfertypes.Version,
+				Order:   channeltypes.UNORDERED,
+			}
+			path.EndpointB.ChannelConfig = &ibctesting.ChannelConfig{
+				PortID:  ibctransfertypes.PortID,
+				Version: ibctransfertypes.Version,
+				Order:   channeltypes.UNORDERED,
+			}
+			coordinator.SetupConnections(path)
+			coordinator.CreateChannels(path)
+			coordinator.UpdateTime()
+
+			timeout := uint64(chainB.LastHeader.Header.Time.Add(time.Hour).UnixNano())
+			if spec.timeout {
+				timeout = uint64(chainB.LastHeader.Header.Time.Add(time.Nanosecond).UnixNano())
+			}
+			// when contract is triggered to send IBCTransferMsg
+			coinToSendToB := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+			initialContractBalance := chainA.Balance(myContractAddr, sdk.DefaultBondDenom)
+			startMsg := &types.MsgExecuteContract{
+				Sender:   chainA.SenderAccount.GetAddress().String(),
+				Contract: myContractAddr.String(),
+				Msg: startTransfer{
+					ChannelID:    path.EndpointA.ChannelID,
+					CoinsToSend:  coinToSendToB,
+					ReceiverAddr: spec.receiver(chainB),
+					Timeout:      timeout,
+				}.GetBytes(),
+				Funds: sdk.NewCoins(coinToSendToB),
+			}
+			_, err := chainA.SendMsgs(startMsg)
+			require.NoError(t, err)
+			require.Len(t, chainA.PendingSendPackets, 1)
+			sequence := chainA.PendingSendPackets[0].Sequence
+			if spec.withoutCallback {
+				assert.Nil(t, chainA.App.WasmKeeper.GetIBCTransferCallback(chainA.GetContext(), path.EndpointA.ChannelID, sequence))
+			} else {
+				assert.Equal(t, myContractAddr, chainA.App.WasmKeeper.GetIBCTransferCallback(chainA.GetContext(), path.EndpointA.ChannelID, sequence))
+			}
+
+			// and when relayed
+			if spec.timeout {
+				coordinator.CommitBlock(chainA, chainB)
+				require.NoError(t, coordinator.TimeoutPendingPackets(path))
+			} else {
+				require.NoError(t, coordinator.RelayAndAckPendingPackets(path))
+			}
+
+			// then
+			if spec.withoutCallback {
+				assert.Empty(t, myContract.callbacks)
+				return
+			}
+			require.Len(t, myContract.callbacks, 1)
+			got := myContract.callbacks[0].IBCLifecycleComplete
+			if spec.timeout {
+				require.NotNil(t, got.IBCTimeout)
+				assert.Nil(t, got.IBCAck)
+				assert.Equal(t, path.EndpointA.ChannelID, got.IBCTimeout.Channel)
+				assert.Equal(t, sequence, got.IBCTimeout.Sequence)
+			} else {
+				require.NotNil(t, got.IBCAck)
+				assert.Nil(t, got.IBCTimeout)
+				assert.Equal(t, path.EndpointA.ChannelID, got.IBCAck.Channel)
+				assert.Equal(t, sequence, got.IBCAck.Sequence)
+				assert.Equal(t, spec.expSuccess, got.IBCAck.Success)
+				assert.NotEmpty(t, got.IBCAck.Ack)
+			}
+			assert.Nil(t, chainA.App.WasmKeeper.GetIBCTransferCallback(chainA.GetContext(), path.EndpointA.ChannelID, sequence))
+			// and tokens were refunded to the contract on failure
+			expBalance := initialContractBalance
+			if !spec.expSuccess {
+				expBalance = expBalance.Add(coinToSendToB)
+			}
+			assert.Equal(t, expBalance.String(), chainA.Balance(myContractAddr, sdk.DefaultBondDenom).String())
+		})
+	}
+}
+
+func TestContractControlsInterchainAccount(t *testing.T) {
+	// scenario: given two chains,
+	//           with a contract on chain A that registers an interchain account on chain B
+	//           and sends a bank transfer to be executed by the interchain account
+	//           then the contract is called back via sudo on registration and ack or timeout of the tx
+
+	specs := map[string]struct {
+		fundICA    bool
+		timeout    bool
+		expSuccess bool
+	}{
+		"executed": {
+			fundICA:    true,
+			expSuccess: true,
+		},
+		"execution fails": {
+			fundICA: false,
+		},
+		"timeout": {
+			fundICA: true,
+			timeout: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			myContract := &icaControllerContract{}
+			var (
+				chainAOpts  = []wasmkeeper.Option{wasmkeeper.WithWasmEngine(wasmtesting.NewIBCContractMockWasmer(myContract))}
+				coordinator = wasmibctesting.NewCoordinator(t, 2, chainAOpts)
+				chainA      = coordinator.GetChain(wasmibctesting.GetChainID(0))
+				chainB      = coordinator.GetChain(wasmibctesting.GetChainID(1))
+			)
+			myContractAddr := chainA.SeedNewContractInstance()
+			chainB.App.ICAHostKeeper.SetParams(chainB.GetContext(), icahosttypes.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}))
+			coordinator.CommitBlock(chainA, chainB)
+
+			path := wasmibctesting.NewPath(chainA, chainB)
+			coordinator.SetupConnections(path)
+
+			// when the contract registers an interchain account
+			res, err := chainA.SendMsgs(&types.MsgExecuteContract{
+				Sender:   chainA.SenderAccount.GetAddress().String(),
+				Contract: myContractAddr.String(),
+				Msg:      []byte(fmt.Sprintf(`{"register_interchain_account":{"connection_id":%q}}`, path.EndpointA.ConnectionID)),
+			})
+			require.NoError(t, err)
+			// and the channel handshake is relayed
+			portID, err := icatypes.NewControllerPortID(myContractAddr.String())
+			require.NoError(t, err)
+			path.EndpointA.ChannelID, err = ibctesting.ParseChannelIDFromEvents(res.GetEvents())
+			require.NoError(t, err)
+			path.EndpointA.ChannelConfig = &ibctesting.ChannelConfig{PortID: portID, Order: channeltypes.ORDERED}
+			path.EndpointA.ChannelConfig.Version = path.EndpointA.GetChannel().Version
+			path.EndpointB.ChannelConfig = &ibctesting.ChannelConfig{PortID: icatypes.PortID, Version: path.EndpointA.ChannelConfig.Version, Order: channeltypes.ORDERED}
+			require.NoError(t, path.EndpointB.ChanOpenTry())
+			require.NoError(t, path.EndpointA.ChanOpenAck())
+			require.NoError(t, path.EndpointB.ChanOpenConfirm())
+
+			// then
+			icaAddr, ok := chainB.App.ICAHostKeeper.GetInterchainAccountAddress(chainB.GetContext(), path.EndpointB.ConnectionID, portID)
+			require.True(t, ok)
+			require.Len(t, myContract.callbacks, 1)
+			registered := myContract.callbacks[0].ICARegistered
+			require.NotNil(t, registered)
+			assert.Equal(t, path.EndpointA.ConnectionID, registered.ConnectionID)
+			assert.Equal(t, portID, registered.PortID)
+			assert.Equal(t, path.EndpointA.ChannelID, registered.ChannelID)
+			assert.Equal(t, icaAddr, registered.Address)
+
+			// and when the contract submits a bank transfer to be executed by the interchain account
+			if spec.fundICA {
+				chainB.Fund(sdk.MustAccAddressFromBech32(icaAddr), sdk.NewInt(100))
+			}
+			receiverAddr := chainB.SenderAccount.GetAddress()
+			initialReceiverBalance := chainB.Balance(receiverAddr, sdk.DefaultBondDenom)
+			sendMsg, err := (&banktypes.MsgSend{
+				FromAddress: icaAddr,
+				ToAddress:   receiverAddr.String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			}).Marshal()
+			require.NoError(t, err)
+			timeoutSeconds := 3600
+			if spec.timeout {
+				timeoutSeconds = 1
+			}
+			submitMsg, err := json.Marshal(map[string]interface{}{"submit_interchain_tx": map[string]interface{}{
+				"connection_id":   path.EndpointA.ConnectionID,
+				"msgs":            []wasmvmtypes.StargateMsg{{TypeURL: sdk.MsgTypeURL(&banktypes.MsgSend{}), Value: sendMsg}},
+				"timeout_seconds": timeoutSeconds,
+			}})
+			require.NoError(t, err)
+			_, err = chainA.SendMsgs(&types.MsgExecuteContract{
+				Sender:   chainA.SenderAccount.GetAddress().String(),
+				Contract: myContractAddr.String(),
+				Msg:      submitMsg,
+			})
+			require.NoError(t, err)
+			require.Len(t, chainA.PendingSendPackets, 1)
+			packet := chainA.PendingSendPackets[0]
+
+			// and relayed
+			if spec.timeout {
+				coordinator.IncrementTimeBy(time.Minute)
+				coordinator.CommitBlock(chainA, chainB)
+				require.NoError(t, path.EndpointA.UpdateClient())
+				proof, proofHeight := chainB.QueryProof(host.NextSequenceRecvKey(packet.DestinationPort, packet.DestinationChannel))
+				nextSeqRecv, ok := chainB.App.IBCKeeper.ChannelKeeper.GetNextSequenceRecv(chainB.GetContext(), packet.DestinationPort, packet.DestinationChannel)
+				require.True(t, ok)
+				_, err = chainA.SendMsgs(channeltypes.NewMsgTimeout(packet, nextSeqRecv, proof, proofHeight, chainA.SenderAccount.GetAddress().String()))
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, coordinator.RelayAndAckPendingPackets(path))
+			}
+
+			// then
+			require.Len(t, myContract.callbacks, 2)
+			got := myContract.callbacks[1].ICATxComplete
+			require.NotNil(t, got)
+			if spec.timeout {
+				require.NotNil(t, got.IBCTimeout)
+				assert.Nil(t, got.IBCAck)
+				assert.Equal(t, path.EndpointA.ChannelID, got.IBCTimeout.Channel)
+				assert.Equal(t, packet.Sequence, got.IBCTimeout.Sequence)
+			} else {
+				require.NotNil(t, got.IBCAck)
+				assert.Nil(t, got.IBCTimeout)
+				assert.Equal(t, path.EndpointA.ChannelID, got.IBCAck.Channel)
+				assert.Equal(t, packet.Sequence, got.IBCAck.Sequence)
+				assert.Equal(t, spec.expSuccess, got.IBCAck.Success)
+			}
+			// and the transfer was executed on success only
+			expBalance := initialReceiverBalance
+			if spec.expSuccess {
+				expBalance = expBalance.Add(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+			}
+			assert.Equal(t, expBalance.String(), chainB.Balance(receiverAddr, sdk.DefaultBondDenom).String())
+		})
+	}
+}
+
+func TestContractAcknowledgesPacketAsynchronously(t *testing.T) {
+	// scenario: given two chains,
+	//           with a contract on chain B that does not acknowledge a received ics-20 packet in ibc_packet_receive
+	//           then the contract can write the acknowledgement later
+	//           and the packet is acknowledged on chain A
+
+	transferAmount := sdk.NewInt(1)
+	specs := map[string]struct {
+		recvAck              []byte
+		ack                  []byte
+		expChainABalanceDiff sdk.Int
+	}{
+		"result ack": {
+			ack:                  channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(),
+			expChainABalanceDiff: transferAmount.Neg(),
+		},
+		"result ack with empty non nil receive ack": {
+			recvAck:              []byte{},
+			ack:                  channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(),
+			expChainABalanceDiff: transferAmount.Neg(),
+		},
+		"error ack": {
+			ack:                  channeltypes.NewErrorAcknowledgement(errors.New("testing")).Acknowledgement(),
+			expChainABalanceDiff: sdk.ZeroInt(),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			myContract := &asyncAckReceiverContract{recvAck: spec.recvAck}
+			var (
+				chainBOpts  = []wasmkeeper.Option{wasmkeeper.WithWasmEngine(wasmtesting.NewIBCContractMockWasmer(myContract))}
+				coordinator = wasmibctesting.NewCoordinator(t, 2, []wasmkeeper.Option{}, chainBOpts)
+				chainA      = coordinator.GetChain(wasmibctesting.GetChainID(0))
+				chainB      = coordinator.GetChain(wasmibctesting.GetChainID(1))
+			)
+			coordinator.CommitBlock(chainA, chainB)
+			myContractAddr := chainB.SeedNewContractInstance()
+			contractBPortID := chainB.ContractInfo(myContractAddr).IBCPortID
+
+			path := wasmibctesting.NewPath(chainA, chainB)
+			path.EndpointA.ChannelConfig = &ibctesting.ChannelConfig{
+				PortID:  "transfer",
+				Version: ibctransfertypes.Version,
+				Order:   channeltypes.UNORDERED,
+			}
+			path.EndpointB.ChannelConfig = &ibctesting.ChannelConfig{
+				PortID:  contractBPortID,
+				Version: ibctransfertypes.Version,
+				Order:   channeltypes.UNORDERED,
+			}
+			coordinator.SetupConnections(path)
+			coordinator.CreateChannels(path)
+
+			originalChainABalance := chainA.Balance(chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+			coinToSendToB := sdk.NewCoin(sdk.DefaultBondDenom, transferAmount)
+			msg := ibctransfertypes.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coinToSendToB, chainA.SenderAccount.GetAddress().String(), chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(1, 110), 0)
+			_, err := chainA.SendMsgs(msg)
+			require.NoError(t, err)
+			require.Equal(t, 1, len(chainA.PendingSendPackets))
+			packet := chainA.PendingSendPackets[0]
+			chainA.PendingSendPackets = nil
+
+			// when the packet is received on chain B
+			require.NoError(t, path.EndpointB.UpdateClient())
+			require.NoError(t, path.EndpointB.RecvPacket(packet))
+
+			// then no ack was written
+			_, found := chainB.App.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(chainB.GetContext(), contractBPortID, path.EndpointB.ChannelID, packet.Sequence)
+			assert.False(t, found)
+			_, found = chainB.App.WasmKeeper.GetAsyncAckPacket(chainB.GetContext(), contractBPortID, path.EndpointB.ChannelID, packet.Sequence)
+			assert.True(t, found)
+
+			// and when the contract writes the ack
+			writeAckMsg, err := json.Marshal(map[string]interface{}{
+				"write_acknowledgement": map[string]interface{}{
+					"channel_id":      path.EndpointB.ChannelID,
+					"packet_sequence": packet.Sequence,
+					"ack":             wasmvmtypes.IBCAcknowledgement{Data: spec.ack},
+				},
+			})
+			require.NoError(t, err)
+			res, err := chainB.SendMsgs(&types.MsgExecuteContract{
+				Sender:   chainB.SenderAccount.GetAddress().String(),
+				Contract: myContractAddr.String(),
+				Msg:      writeAckMsg,
+			})
+			require.NoError(t, err)
+
+			// then the ack is written
+			gotAck, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+			require.NoError(t, err)
+			assert.Equal(t, spec.ack, gotAck)
+			_, found = chainB.App.WasmKeeper.GetAsyncAckPacket(chainB.GetContext(), contractBPortID, path.EndpointB.ChannelID, packet.Sequence)
+			assert.False(t, found)
+
+			// and when relayed to chain A
+			require.NoError(t, path.EndpointA.UpdateClient())
+			require.NoError(t, path.EndpointA.AcknowledgePacket(packet, gotAck))
+
+			// then the source chain balance reflects the ack
+			newChainABalance := chainA.Balance(chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+			assert.Equal(t, originalChainABalance.Amount.Add(spec.expChainABalanceDiff), newChainABalance.Amount)
+
+			// and the ack can not be written twice
+			_, err = chainB.SendMsgs(&types.MsgExecuteContract{
+				Sender:   chainB.SenderAccount.GetAddress().String(),
+				Contract: myContractAddr.String(),
+				Msg:      writeAckMsg,
+			})
+			require.Error(t, err)
+		})
+	}
+}
+
 func TestContractCanEmulateIBCTransferMessage(t *testing.T) {
 	// scenario: given two chains,
 	//           with a contract on chain A
@@ -675,6 +1173,137 @@ func (g startTransfer) GetBytes() types.RawContractMessage {
 	return b
 }
 
+var _ wasmtesting.IBCContractCallbacks = &ibcHookReceiverContract{}
+
+// contract that is executed by an ics-20 memo hook
+type ibcHookReceiverContract struct {
+	contractStub
+	err      error
+	executed *wasmvmtypes.MessageInfo
+}
+
+func (c *ibcHookReceiverContract) Execute(code wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+	c.executed = &info
+	if c.err != nil {
+		return nil, 0, c.err
+	}
+	return &wasmvmtypes.Response{}, 0, nil
+}
+
+var _ wasmtesting.IBCContractCallbacks = &ibcTransferCallbackContract{}
+
+// contract that initiates an ics-20 transfer on execute and records the lifecycle callbacks
+type ibcTransferCallbackContract struct {
+	contractStub
+	// withoutCallback sends the transfer via IBCMsg::Transfer instead of the ibc_transfer_with_callback custom message
+	withoutCallback bool
+	callbacks       []ibcLifecycleCompleteMsg
+}
+
+func (c *ibcTransferCallbackContract) Execute(code wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+	var in startTransfer
+	if err := json.Unmarshal(executeMsg, &in); err != nil {
+		return nil, 0, err
+	}
+	transfer := &wasmvmtypes.TransferMsg{
+		ToAddress: in.ReceiverAddr,
+		Amount:    wasmvmtypes.NewCoin(in.CoinsToSend.Amount.Uint64(), in.CoinsToSend.Denom),
+		ChannelID: in.ChannelID,
+		Timeout:   wasmvmtypes.IBCTimeout{Timestamp: in.Timeout},
+	}
+	msg := wasmvmtypes.CosmosMsg{IBC: &wasmvmtypes.IBCMsg{Transfer: transfer}}
+	if !c.withoutCallback {
+		bz, err := json.Marshal(map[string]interface{}{"ibc_transfer_with_callback": transfer})
+		if err != nil {
+			return nil, 0, err
+		}
+		msg = wasmvmtypes.CosmosMsg{Custom: bz}
+	}
+	return &wasmvmtypes.Response{Messages: []wasmvmtypes.SubMsg{{ReplyOn: wasmvmtypes.ReplyNever, Msg: msg}}}, 0, nil
+}
+
+func (c *ibcTransferCallbackContract) Sudo(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+	var msg ibcLifecycleCompleteMsg
+	if err := json.Unmarshal(sudoMsg, &msg); err != nil {
+		return nil, 0, err
+	}
+	c.callbacks = append(c.callbacks, msg)
+	return &wasmvmtypes.Response{}, 0, nil
+}
+
+// sudo message of the ibc transfer lifecycle callbacks
+type ibcLifecycleCompleteMsg struct {
+	IBCLifecycleComplete struct {
+		IBCAck *struct {
+			Channel  string `json:"channel"`
+			Sequence uint64 `json:"sequence"`
+			Ack      []byte `json:"ack"`
+			Success  bool   `json:"success"`
+		} `json:"ibc_ack"`
+		IBCTimeout *struct {
+			Channel  string `json:"channel"`
+			Sequence uint64 `json:"sequence"`
+		} `json:"ibc_timeout"`
+	} `json:"ibc_lifecycle_complete"`
+}
+
+// contract that forwards the execute message as custom message to control its interchain account
+type icaControllerContract struct {
+	contractStub
+	callbacks []icaCallbackMsg
+}
+
+func (c *icaControllerContract) Execute(code wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+	return &wasmvmtypes.Response{Messages: []wasmvmtypes.SubMsg{{ReplyOn: wasmvmtypes.ReplyNever, Msg: wasmvmtypes.CosmosMsg{Custom: executeMsg}}}}, 0, nil
+}
+
+func (c *icaControllerContract) Sudo(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+	var msg icaCallbackMsg
+	if err := json.Unmarshal(sudoMsg, &msg); err != nil {
+		return nil, 0, err
+	}
+	c.callbacks = append(c.callbacks, msg)
+	return &wasmvmtypes.Response{}, 0, nil
+}
+
+// sudo messages of the interchain account callbacks
+type icaCallbackMsg struct {
+	ICARegistered *struct {
+		ConnectionID string `json:"connection_id"`
+		PortID       string `json:"port_id"`
+		ChannelID    string `json:"channel_id"`
+		Address      string `json:"address"`
+	} `json:"ica_registered"`
+	ICATxComplete *struct {
+		IBCAck *struct {
+			Channel  string `json:"channel"`
+			Sequence uint64 `json:"sequence"`
+			Ack      []byte `json:"ack"`
+			Success  bool   `json:"success"`
+		} `json:"ibc_ack"`
+		IBCTimeout *struct {
+			Channel  string `json:"channel"`
+			Sequence uint64 `json:"sequence"`
+		} `json:"ibc_timeout"`
+	} `json:"ica_tx_complete"`
+}
+
+// contract that does not acknowledge received packets in ibc_packet_receive but forwards the execute message as
+// custom message to write the acknowledgement later
+type asyncAckReceiverContract struct {
+	contractStub
+	// recvAck is the empty acknowledgement returned in ibc_packet_receive. Either nil or empty bytes
+	recvAck []byte
+}
+
+func (c *asyncAckReceiverContract) IBCPacketReceive(codeID wasmvm.Checksum, env wasmvmtypes.Env, msg wasmvmtypes.IBCPacketReceiveMsg, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.IBCReceiveResult, uint64, error) {
+	return &wasmvmtypes.IBCReceiveResult{Ok: &wasmvmtypes.IBCReceiveResponse{Acknowledgement: c.recvAck}}, 0, nil
+}
+
+func (c *asyncAckReceiverContract) Execute(code wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+	return &wasmvmtypes.Response{Messages: []wasmvmtypes.SubMsg{{ReplyOn: wasmvmtypes.ReplyNever, Msg: wasmvmtypes.CosmosMsg{Custom: executeMsg}}}}, 0, nil
+}
+
 var _ wasmtesting.IBCContractCallbacks = &ackReceiverContract{}
 
 // contract that acts as the receiving side for an ics-20 transfer.
@@ -20,6 +20,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) { //nolint:staticcheck
 	cdc.RegisterConcrete(&MsgUpdateAdmin{}, "wasm/MsgUpdateAdmin", nil)
 	cdc.RegisterConcrete(&MsgClearAdmin{}, "wasm/MsgClearAdmin", nil)
 	cdc.RegisterConcrete(&MsgUpdateInstantiateConfig{}, "wasm/MsgUpdateInstantiateConfig", nil)
+	cdc.RegisterConcrete(&MsgDeleteContract{}, "wasm/MsgDeleteContract", nil)
+	cdc.RegisterConcrete(&MsgRemoveCode{}, "wasm/MsgRemoveCode", nil)
+	cdc.RegisterConcrete(&MsgFreezeContract{}, "wasm/MsgFreezeContract", nil)
+	cdc.RegisterConcrete(&MsgUnfreezeContract{}, "wasm/MsgUnfreezeContract", nil)
+	cdc.RegisterConcrete(&MsgDisableOperations{}, "wasm/MsgDisableOperations", nil)
+	cdc.RegisterConcrete(&MsgEnableOperations{}, "wasm/MsgEnableOperations", nil)
+	cdc.RegisterConcrete(&MsgUpdateCodeMetadata{}, "wasm/MsgUpdateCodeMetadata", nil)
 
 	cdc.RegisterConcrete(&PinCodesProposal{}, "wasm/PinCodesProposal", nil)
 	cdc.RegisterConcrete(&UnpinCodesProposal{}, "wasm/UnpinCodesProposal", nil)
@@ -48,6 +55,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) { //nolint:staticcheck
 	cdc.RegisterConcrete(&ContractExecutionAuthorization{}, "wasm/ContractExecutionAuthorization", nil)
 	cdc.RegisterConcrete(&ContractMigrationAuthorization{}, "wasm/ContractMigrationAuthorization", nil)
 	cdc.RegisterConcrete(&StoreAndInstantiateContractProposal{}, "wasm/StoreAndInstantiateContractProposal", nil)
+	cdc.RegisterConcrete(&DeleteContractProposal{}, "wasm/DeleteContractProposal", nil)
+	cdc.RegisterConcrete(&RemoveCodeProposal{}, "wasm/RemoveCodeProposal", nil)
+	cdc.RegisterConcrete(&FreezeContractProposal{}, "wasm/FreezeContractProposal", nil)
+	cdc.RegisterConcrete(&UnfreezeContractProposal{}, "wasm/UnfreezeContractProposal", nil)
+	cdc.RegisterConcrete(&RegisterCronJobProposal{}, "wasm/RegisterCronJobProposal", nil)
+	cdc.RegisterConcrete(&DeregisterCronJobProposal{}, "wasm/DeregisterCronJobProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -63,6 +76,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgIBCCloseChannel{},
 		&MsgIBCSend{},
 		&MsgUpdateInstantiateConfig{},
+		&MsgDeleteContract{},
+		&MsgRemoveCode{},
+		&MsgFreezeContract{},
+		&MsgUnfreezeContract{},
+		&MsgDisableOperations{},
+		&MsgEnableOperations{},
+		&MsgUpdateCodeMetadata{},
 	)
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
@@ -78,6 +98,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&UnpinCodesProposal{},
 		&UpdateInstantiateConfigProposal{},
 		&StoreAndInstantiateContractProposal{},
+		&DeleteContractProposal{},
+		&RemoveCodeProposal{},
+		&FreezeContractProposal{},
+		&UnfreezeContractProposal{},
+		&RegisterCronJobProposal{},
+		&DeregisterCronJobProposal{},
 	)
 
 	registry.RegisterInterface("ContractInfoExtension", (*ContractInfoExtension)(nil))
@@ -85,6 +85,18 @@ var (
 	ErrNoSuchCodeFn = WasmVMFlavouredErrorFactory(sdkErrors.Register(DefaultCodespace, 28, "no such code"),
 		func(id uint64) error { return wasmvmtypes.NoSuchCode{CodeID: id} },
 	)
+
+	// ErrContractStorageLimitExceeded error for when a contract stores more bytes than allowed by the params
+	ErrContractStorageLimitExceeded = sdkErrors.Register(DefaultCodespace, 29, "contract storage limit exceeded")
+
+	// ErrInsufficientStorageDeposit error for when the deposit for the contract storage can not be paid
+	ErrInsufficientStorageDeposit = sdkErrors.Register(DefaultCodespace, 30, "insufficient storage deposit")
+
+	// ErrContractFrozen error for when a frozen contract is called
+	ErrContractFrozen = sdkErrors.Register(DefaultCodespace, 31, "contract frozen")
+
+	// ErrOperationDisabled error for when an operation was disabled by the circuit breaker
+	ErrOperationDisabled = sdkErrors.Register(DefaultCodespace, 32, "operation disabled")
 )
 
 // WasmVMErrorable mapped error type in wasmvm and are not redacted
@@ -17,6 +17,21 @@ const (
 	EventTypeGovContractResult      = "gov_contract_result"
 	EventTypeUpdateContractAdmin    = "update_contract_admin"
 	EventTypeUpdateCodeAccessConfig = "update_code_access_config"
+	EventTypeDeleteContract         = "delete_contract"
+	EventTypeRemoveCode             = "remove_code"
+	EventTypeFreezeContract         = "freeze_contract"
+	EventTypeUnfreezeContract       = "unfreeze_contract"
+	EventTypeDisableOperations      = "disable_operations"
+	EventTypeEnableOperations       = "enable_operations"
+	EventTypeRegisterCronJob        = "register_cron_job"
+	EventTypeDeregisterCronJob      = "deregister_cron_job"
+	EventTypeCronJob                = "cron_job"
+	EventTypeScheduleCallback       = "schedule_callback"
+	EventTypeCancelCallback         = "cancel_callback"
+	EventTypeCallback               = "callback"
+	EventTypeUpdateCodeMetadata     = "update_code_metadata"
+	EventTypeIBCLifecycleComplete   = "ibc_lifecycle_complete"
+	EventTypeICACallback            = "ica_callback"
 )
 
 // event attributes returned from contract execution
@@ -31,4 +46,16 @@ const (
 	AttributeKeyNewAdmin            = "new_admin_address"
 	AttributeKeyCodePermission      = "code_permission"
 	AttributeKeyAuthorizedAddresses = "authorized_addresses"
+	AttributeKeyRecipient           = "recipient"
+	AttributeKeyOperations          = "operations"
+	AttributeKeyInterval            = "interval"
+	AttributeKeyGasUsed             = "gas_used"
+	AttributeKeySuccess             = "success"
+	AttributeKeyCallbackID          = "callback_id"
+	AttributeKeyHeight              = "height"
+	AttributeKeySource              = "source"
+	AttributeKeyBuilder             = "builder"
+	AttributeKeyChannelID           = "channel_id"
+	AttributeKeySequence            = "sequence"
+	AttributeKeyPortID              = "port_id"
 )
@@ -4,6 +4,7 @@ import (
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 )
 
 // ViewKeeper provides read only operations
@@ -15,8 +16,14 @@ type ViewKeeper interface {
 	GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *ContractInfo
 	IterateContractInfo(ctx sdk.Context, cb func(sdk.AccAddress, ContractInfo) bool)
 	IterateContractsByCreator(ctx sdk.Context, creator sdk.AccAddress, cb func(address sdk.AccAddress) bool)
+	IterateContractsByAdmin(ctx sdk.Context, admin sdk.AccAddress, cb func(address sdk.AccAddress) bool)
+	IterateContractsByLabel(ctx sdk.Context, creator sdk.AccAddress, labelPrefix string, cb func(label string, address sdk.AccAddress) bool)
+	GetLabelIndexScope(ctx sdk.Context) LabelIndexScope
 	IterateContractsByCode(ctx sdk.Context, codeID uint64, cb func(address sdk.AccAddress) bool)
 	IterateContractState(ctx sdk.Context, contractAddress sdk.AccAddress, cb func(key, value []byte) bool)
+	GetContractStorageStats(ctx sdk.Context, contractAddress sdk.AccAddress) ContractStorageStats
+	GetDisabledOperations(ctx sdk.Context) []OperationType
+	GetCallback(ctx sdk.Context, contractAddress sdk.AccAddress, callbackID uint64) *Callback
 	GetCodeInfo(ctx sdk.Context, codeID uint64) *CodeInfo
 	IterateCodeInfos(ctx sdk.Context, cb func(uint64, CodeInfo) bool)
 	GetByteCode(ctx sdk.Context, codeID uint64) ([]byte, error)
@@ -29,6 +36,10 @@ type ContractOpsKeeper interface {
 	// Create uploads and compiles a WASM contract, returning a short identifier for the contract
 	Create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *AccessConfig) (codeID uint64, checksum []byte, err error)
 
+	// CreateOrReuse returns the id of an existing code with the same checksum and instantiate permission or stores the
+	// wasm code as a new code when none exists.
+	CreateOrReuse(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *AccessConfig) (codeID uint64, checksum []byte, err error)
+
 	// Instantiate creates an instance of a WASM contract using the classic sequence based address generator
 	Instantiate(
 		ctx sdk.Context,
@@ -66,6 +77,33 @@ type ContractOpsKeeper interface {
 	// ClearContractAdmin sets the admin value on the ContractInfo to nil, to disable further migrations/ updates.
 	ClearContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error
 
+	// DeleteContract removes the contract with all its state and indexes and sends the remaining balance to the recipient.
+	DeleteContract(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, recipient sdk.AccAddress) error
+
+	// RemoveCode removes a code that is not used by any contract and not pinned.
+	RemoveCode(ctx sdk.Context, codeID uint64, caller sdk.AccAddress) error
+
+	// FreezeContract pauses the contract so that it rejects executions, migrations and IBC packets.
+	FreezeContract(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error
+
+	// UnfreezeContract resumes a frozen contract.
+	UnfreezeContract(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error
+
+	// DisableOperations trips the circuit breaker for the given operations.
+	DisableOperations(ctx sdk.Context, caller sdk.AccAddress, ops []OperationType) error
+
+	// EnableOperations resets the circuit breaker for the given operations.
+	EnableOperations(ctx sdk.Context, caller sdk.AccAddress, ops []OperationType) error
+
+	// IsOperationDisabled returns true when the operation was disabled by the circuit breaker.
+	IsOperationDisabled(ctx sdk.Context, op OperationType) bool
+
+	// RegisterCronJob schedules periodic sudo calls to a contract from the end blocker.
+	RegisterCronJob(ctx sdk.Context, job CronJob) error
+
+	// DeregisterCronJob removes the scheduled sudo calls of a contract.
+	DeregisterCronJob(ctx sdk.Context, contractAddress sdk.AccAddress) error
+
 	// PinCode pins the wasm contract in wasmvm cache
 	PinCode(ctx sdk.Context, codeID uint64) error
 
@@ -77,6 +115,13 @@ type ContractOpsKeeper interface {
 
 	// SetAccessConfig updates the access config of a code id.
 	SetAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, newConfig AccessConfig) error
+
+	// UpdateCodeMetadata sets the source and builder of a code id.
+	UpdateCodeMetadata(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, source, builder string) error
+
+	// InitCodeMetadata sets the source and builder of a code id that was stored by the caller and has no metadata, yet.
+	// Codes that were reused on upload are not modified.
+	InitCodeMetadata(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, source, builder string) error
 }
 
 // IBCContractKeeper IBC lifecycle event handler
@@ -101,6 +146,8 @@ type IBCContractKeeper interface {
 		contractAddr sdk.AccAddress,
 		msg wasmvmtypes.IBCPacketReceiveMsg,
 	) ([]byte, error)
+	// StoreAsyncAckPacket keeps the received packet until the contract writes the acknowledgement
+	StoreAsyncAckPacket(ctx sdk.Context, packet channeltypes.Packet) error
 	OnAckPacket(
 		ctx sdk.Context,
 		contractAddr sdk.AccAddress,
@@ -117,3 +164,19 @@ type IBCContractKeeper interface {
 	// AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
 	AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool
 }
+
+// IBCTransferCallbackKeeper calls back the contracts on the lifecycle completion of the ICS-20 transfers they sent
+type IBCTransferCallbackKeeper interface {
+	OnIBCTransferAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte)
+	OnIBCTransferTimeout(ctx sdk.Context, packet channeltypes.Packet)
+}
+
+// ICAControllerCallbackKeeper handles the ICS-27 controller callbacks for the interchain accounts owned by contracts
+type ICAControllerCallbackKeeper interface {
+	// IsContractICAControllerPort returns true when the ICS-27 controller port was registered by a contract
+	IsContractICAControllerPort(ctx sdk.Context, portID string) bool
+	OnICAChannelOpenInit(ctx sdk.Context, portID, channelID string, chanCap *capabilitytypes.Capability) error
+	OnICAChannelOpenAck(ctx sdk.Context, portID, channelID, connectionID, icaAddress string)
+	OnICAAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte)
+	OnICATimeout(ctx sdk.Context, packet channeltypes.Packet)
+}
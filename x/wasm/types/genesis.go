@@ -32,6 +32,51 @@ func (s GenesisState) ValidateBasic() error {
 			return sdkerrors.Wrapf(err, "sequence: %d", i)
 		}
 	}
+	if err := ValidateOperationTypes(s.DisabledOperations); err != nil {
+		return sdkerrors.Wrap(err, "disabled operations")
+	}
+	cronContracts := make(map[string]struct{}, len(s.CronJobs))
+	for i := range s.CronJobs {
+		if err := s.CronJobs[i].ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "cron job: %d", i)
+		}
+		if _, exists := cronContracts[s.CronJobs[i].Contract]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "cron job: %d", i)
+		}
+		cronContracts[s.CronJobs[i].Contract] = struct{}{}
+	}
+	callbackIDs := make(map[uint64]struct{}, len(s.Callbacks))
+	for i := range s.Callbacks {
+		if err := s.Callbacks[i].ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "callback: %d", i)
+		}
+		if _, exists := callbackIDs[s.Callbacks[i].ID]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "callback: %d", i)
+		}
+		callbackIDs[s.Callbacks[i].ID] = struct{}{}
+	}
+	transferPackets := make(map[string]struct{}, len(s.IBCTransferCallbacks))
+	for i, c := range s.IBCTransferCallbacks {
+		if err := c.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "ibc transfer callback: %d", i)
+		}
+		packetKey := string(GetIBCTransferCallbackKey(c.ChannelID, c.Sequence))
+		if _, exists := transferPackets[packetKey]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "ibc transfer callback: %d", i)
+		}
+		transferPackets[packetKey] = struct{}{}
+	}
+	asyncAckPackets := make(map[string]struct{}, len(s.AsyncAckPackets))
+	for i, p := range s.AsyncAckPackets {
+		if err := p.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "async ack packet: %d", i)
+		}
+		packetKey := string(GetAsyncAckPacketKey(p.DestinationPort, p.DestinationChannel, p.Sequence))
+		if _, exists := asyncAckPackets[packetKey]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "async ack packet: %d", i)
+		}
+		asyncAckPackets[packetKey] = struct{}{}
+	}
 
 	return nil
 }
@@ -68,6 +113,9 @@ func (c Contract) ValidateBasic() error {
 	if len(c.ContractCodeHistory) == 0 {
 		return ErrEmpty.Wrap("code history")
 	}
+	if err := c.StorageDeposit.Validate(); err != nil {
+		return sdkerrors.Wrap(err, "storage deposit")
+	}
 	for i, v := range c.ContractCodeHistory {
 		if err := v.ValidateBasic(); err != nil {
 			return sdkerrors.Wrapf(err, "code history element %d", i)
@@ -9,7 +9,10 @@ import (
 	math "math"
 	math_bits "math/bits"
 
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
+	types "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	proto "github.com/gogo/protobuf/proto"
 )
 
@@ -32,6 +35,18 @@ type GenesisState struct {
 	Codes     []Code     `protobuf:"bytes,2,rep,name=codes,proto3" json:"codes,omitempty"`
 	Contracts []Contract `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
 	Sequences []Sequence `protobuf:"bytes,4,rep,name=sequences,proto3" json:"sequences,omitempty"`
+	// disabled_operations are the operations disabled by the circuit breaker
+	DisabledOperations []OperationType `protobuf:"varint,5,rep,packed,name=disabled_operations,json=disabledOperations,proto3,enum=cosmwasm.wasm.v1.OperationType" json:"disabled_operations,omitempty"`
+	// cron_jobs are the contracts scheduled to receive periodic sudo calls
+	CronJobs []CronJob `protobuf:"bytes,6,rep,name=cron_jobs,json=cronJobs,proto3" json:"cron_jobs,omitempty"`
+	// callbacks are the pending one-shot callbacks scheduled by contracts
+	Callbacks []Callback `protobuf:"bytes,7,rep,name=callbacks,proto3" json:"callbacks,omitempty"`
+	// ibc_transfer_callbacks are the contracts to call back for pending ICS-20
+	// packets that they sent
+	IBCTransferCallbacks []IBCTransferCallback `protobuf:"bytes,8,rep,name=ibc_transfer_callbacks,json=ibcTransferCallbacks,proto3" json:"ibc_transfer_callbacks,omitempty"`
+	// async_ack_packets are the received packets that contracts did not
+	// acknowledge, yet
+	AsyncAckPackets []types.Packet `protobuf:"bytes,9,rep,name=async_ack_packets,json=asyncAckPackets,proto3" json:"async_ack_packets,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -100,6 +115,41 @@ func (m *GenesisState) GetSequences() []Sequence {
 	return nil
 }
 
+func (m *GenesisState) GetDisabledOperations() []OperationType {
+	if m != nil {
+		return m.DisabledOperations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCronJobs() []CronJob {
+	if m != nil {
+		return m.CronJobs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCallbacks() []Callback {
+	if m != nil {
+		return m.Callbacks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIBCTransferCallbacks() []IBCTransferCallback {
+	if m != nil {
+		return m.IBCTransferCallbacks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAsyncAckPackets() []types.Packet {
+	if m != nil {
+		return m.AsyncAckPackets
+	}
+	return nil
+}
+
 // Code struct encompasses CodeInfo and CodeBytes
 type Code struct {
 	CodeID    uint64   `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
@@ -181,6 +231,8 @@ type Contract struct {
 	ContractInfo        ContractInfo               `protobuf:"bytes,2,opt,name=contract_info,json=contractInfo,proto3" json:"contract_info"`
 	ContractState       []Model                    `protobuf:"bytes,3,rep,name=contract_state,json=contractState,proto3" json:"contract_state"`
 	ContractCodeHistory []ContractCodeHistoryEntry `protobuf:"bytes,4,rep,name=contract_code_history,json=contractCodeHistory,proto3" json:"contract_code_history"`
+	// storage_deposit is the amount locked for the contract storage
+	StorageDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=storage_deposit,json=storageDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"storage_deposit"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
@@ -249,6 +301,13 @@ func (m *Contract) GetContractCodeHistory() []ContractCodeHistoryEntry {
 	return nil
 }
 
+func (m *Contract) GetStorageDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.StorageDeposit
+	}
+	return nil
+}
+
 // Sequence key and value of an id generation counter
 type Sequence struct {
 	IDKey []byte `protobuf:"bytes,1,opt,name=id_key,json=idKey,proto3" json:"id_key,omitempty"`
@@ -317,42 +376,60 @@ func init() {
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/genesis.proto", fileDescriptor_2ab3f539b23472a6) }
 
 var fileDescriptor_2ab3f539b23472a6 = []byte{
-	// 546 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xe3, 0xd4, 0x31, 0xe9, 0x36, 0xd0, 0x6a, 0x29, 0xad, 0x15, 0xc0, 0x89, 0x82, 0x84,
-	0x02, 0x42, 0xb6, 0x5a, 0x24, 0x6e, 0x48, 0xe0, 0xa6, 0x02, 0xab, 0x42, 0x42, 0xae, 0x10, 0x12,
-	0x97, 0xc8, 0xf1, 0x6e, 0x53, 0x8b, 0xda, 0x6b, 0xbc, 0x9b, 0x80, 0xdf, 0x82, 0x57, 0xe0, 0xce,
-	0x1b, 0xf0, 0x02, 0x3d, 0xf6, 0xc8, 0x29, 0x42, 0xce, 0x8d, 0xa7, 0x40, 0xfb, 0xc7, 0xae, 0x45,
-	0x9a, 0x8b, 0xe5, 0x9d, 0xf9, 0xe6, 0xe7, 0xf5, 0x37, 0x33, 0xc0, 0x0a, 0x09, 0x8d, 0xbf, 0x06,
-	0x34, 0x76, 0xc4, 0x63, 0x7e, 0xe0, 0x4c, 0x71, 0x82, 0x69, 0x44, 0xed, 0x34, 0x23, 0x8c, 0xc0,
-	0x9d, 0x32, 0x6f, 0x8b, 0xc7, 0xfc, 0xa0, 0xbb, 0x3b, 0x25, 0x53, 0x22, 0x92, 0x0e, 0x7f, 0x93,
-	0xba, 0xee, 0x83, 0x15, 0x0e, 0xcb, 0x53, 0xac, 0x28, 0x83, 0x5f, 0x4d, 0xd0, 0x79, 0x23, 0xb9,
-	0xa7, 0x2c, 0x60, 0x18, 0xbe, 0x00, 0x46, 0x1a, 0x64, 0x41, 0x4c, 0x4d, 0xad, 0xaf, 0x0d, 0xb7,
-	0x0e, 0x4d, 0xfb, 0xff, 0xef, 0xd8, 0xef, 0x45, 0xde, 0xd5, 0x2f, 0x17, 0xbd, 0x86, 0xaf, 0xd4,
-	0xf0, 0x18, 0xb4, 0x42, 0x82, 0x30, 0x35, 0x9b, 0xfd, 0x8d, 0xe1, 0xd6, 0xe1, 0xde, 0x6a, 0xd9,
-	0x11, 0x41, 0xd8, 0xdd, 0xe7, 0x45, 0x7f, 0x17, 0xbd, 0x6d, 0x21, 0x7e, 0x46, 0xe2, 0x88, 0xe1,
-	0x38, 0x65, 0xb9, 0x2f, 0xab, 0xe1, 0x07, 0xb0, 0x19, 0x92, 0x84, 0x65, 0x41, 0xc8, 0xa8, 0xb9,
-	0x21, 0x50, 0xdd, 0x9b, 0x50, 0x52, 0xe2, 0xde, 0x57, 0xb8, 0xbb, 0x55, 0x51, 0x0d, 0x79, 0x4d,
-	0xe2, 0x58, 0x8a, 0xbf, 0xcc, 0x70, 0x12, 0x62, 0x6a, 0xea, 0xeb, 0xb0, 0xa7, 0x4a, 0x72, 0x8d,
-	0xad, 0x8a, 0xea, 0xd8, 0x2a, 0x38, 0xf8, 0xa1, 0x01, 0x9d, 0xff, 0x16, 0x7c, 0x04, 0x6e, 0xf1,
-	0xfb, 0x8f, 0x23, 0x24, 0x6c, 0xd3, 0x5d, 0x50, 0x2c, 0x7a, 0x06, 0x4f, 0x79, 0x23, 0xdf, 0xe0,
-	0x29, 0x0f, 0xc1, 0x97, 0xfc, 0xdf, 0xb8, 0x28, 0x39, 0x23, 0x66, 0x53, 0xb8, 0xdb, 0xbd, 0xd9,
-	0x26, 0x2f, 0x39, 0x23, 0xca, 0xdf, 0x76, 0xa8, 0xce, 0xf0, 0x21, 0x00, 0xa2, 0x7c, 0x92, 0x33,
-	0xcc, 0xbd, 0xd1, 0x86, 0x1d, 0x5f, 0x00, 0x5d, 0x1e, 0x80, 0x7b, 0xc0, 0x48, 0xa3, 0x24, 0xc1,
-	0xc8, 0xd4, 0xfb, 0xda, 0xb0, 0xed, 0xab, 0xd3, 0xe0, 0x67, 0x13, 0xb4, 0x4b, 0xbf, 0xe0, 0x13,
-	0xb0, 0x53, 0x9a, 0x32, 0x0e, 0x10, 0xca, 0x30, 0x95, 0x7d, 0xde, 0xf4, 0xb7, 0xcb, 0xf8, 0x6b,
-	0x19, 0x86, 0x1e, 0xb8, 0x5d, 0x49, 0x6b, 0x37, 0xb6, 0xd6, 0x77, 0xa3, 0x76, 0xeb, 0x4e, 0x58,
-	0x8b, 0xc1, 0x11, 0xb8, 0x53, 0xa1, 0x28, 0x9f, 0x32, 0xd5, 0xd9, 0xfd, 0x55, 0xd6, 0x3b, 0x82,
-	0xf0, 0x85, 0x82, 0x54, 0xdf, 0x97, 0x93, 0x89, 0xc0, 0xbd, 0x8a, 0x22, 0x8c, 0x38, 0x8f, 0x28,
-	0x23, 0x59, 0xae, 0xfa, 0xf9, 0x74, 0xfd, 0xc5, 0xb8, 0xa5, 0x6f, 0xa5, 0xf8, 0x38, 0x61, 0x59,
-	0xae, 0xf8, 0xd5, 0xd0, 0xd4, 0xf2, 0x03, 0x17, 0xb4, 0xcb, 0x31, 0x80, 0x7d, 0x60, 0x44, 0x68,
-	0xfc, 0x19, 0xe7, 0xc2, 0xa3, 0x8e, 0xbb, 0x59, 0x2c, 0x7a, 0x2d, 0x6f, 0x74, 0x82, 0x73, 0xbf,
-	0x15, 0xa1, 0x13, 0x9c, 0xc3, 0x5d, 0xd0, 0x9a, 0x07, 0x17, 0x33, 0x2c, 0xcc, 0xd1, 0x7d, 0x79,
-	0x70, 0x5f, 0x5d, 0x16, 0x96, 0x76, 0x55, 0x58, 0xda, 0x9f, 0xc2, 0xd2, 0xbe, 0x2f, 0xad, 0xc6,
-	0xd5, 0xd2, 0x6a, 0xfc, 0x5e, 0x5a, 0x8d, 0x4f, 0x8f, 0xa7, 0x11, 0x3b, 0x9f, 0x4d, 0xec, 0x90,
-	0xc4, 0xce, 0x11, 0xa1, 0xf1, 0xc7, 0x72, 0x2f, 0x91, 0xf3, 0x4d, 0xee, 0xa7, 0x58, 0xce, 0x89,
-	0x21, 0xb6, 0xf3, 0xf9, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x14, 0x33, 0x7b, 0x9f, 0x05, 0x04,
-	0x00, 0x00,
+	// 840 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0xb7, 0x49, 0x36, 0x99, 0x2d, 0xed, 0x32, 0x2d, 0x5d, 0xd3, 0xb2, 0x71, 0xb6, 0x2b,
+	0x50, 0x40, 0x60, 0xd3, 0x22, 0x71, 0x43, 0xda, 0x75, 0xbb, 0x82, 0xb0, 0x42, 0x20, 0x77, 0x11,
+	0x12, 0x17, 0x6b, 0x3c, 0x9e, 0xa6, 0x43, 0x92, 0x19, 0xe3, 0x99, 0x16, 0xfc, 0x2d, 0xf8, 0x02,
+	0x1c, 0xe0, 0xc8, 0x27, 0xd9, 0x63, 0x8f, 0x9c, 0x02, 0x4a, 0x6f, 0xf9, 0x14, 0x68, 0xfe, 0xd8,
+	0x35, 0xeb, 0xe4, 0xe2, 0x3f, 0xef, 0xfd, 0xfe, 0x3c, 0x3f, 0xfb, 0x3d, 0x83, 0x3e, 0xe6, 0x62,
+	0xf6, 0x0b, 0x12, 0xb3, 0x40, 0x1f, 0xae, 0x8f, 0x83, 0x31, 0x61, 0x44, 0x50, 0xe1, 0x67, 0x39,
+	0x97, 0x1c, 0x3e, 0x2c, 0xf3, 0xbe, 0x3e, 0x5c, 0x1f, 0x1f, 0xec, 0x8d, 0xf9, 0x98, 0xeb, 0x64,
+	0xa0, 0xae, 0x0c, 0xee, 0x40, 0xeb, 0x70, 0x11, 0x24, 0x48, 0x90, 0xe0, 0xfa, 0x38, 0x21, 0x12,
+	0x1d, 0x07, 0x98, 0x53, 0x66, 0xf3, 0xef, 0x35, 0x7c, 0x64, 0x91, 0x11, 0xeb, 0x72, 0xf0, 0x84,
+	0x26, 0x38, 0xc0, 0x3c, 0x27, 0x01, 0xbe, 0x44, 0x8c, 0x91, 0xa9, 0x02, 0xd8, 0x4b, 0x03, 0x39,
+	0x5a, 0x76, 0xc0, 0xd6, 0x97, 0xa6, 0xb4, 0x73, 0x89, 0x24, 0x81, 0x9f, 0x83, 0x4e, 0x86, 0x72,
+	0x34, 0x13, 0xae, 0x33, 0x70, 0x86, 0x0f, 0x4e, 0x5c, 0xff, 0xcd, 0x52, 0xfd, 0xef, 0x74, 0x3e,
+	0x6c, 0xbd, 0x9e, 0x7b, 0x1b, 0x91, 0x45, 0xc3, 0x17, 0xa0, 0x8d, 0x79, 0x4a, 0x84, 0x7b, 0x6f,
+	0xb0, 0x39, 0x7c, 0x70, 0xb2, 0xdf, 0xa4, 0x9d, 0xf2, 0x94, 0x84, 0x8f, 0x14, 0x69, 0x39, 0xf7,
+	0x76, 0x34, 0xf8, 0x63, 0x3e, 0xa3, 0x92, 0xcc, 0x32, 0x59, 0x44, 0x86, 0x0d, 0xbf, 0x07, 0x3d,
+	0xcc, 0x99, 0xcc, 0x11, 0x96, 0xc2, 0xdd, 0xd4, 0x52, 0x07, 0xab, 0xa4, 0x0c, 0x24, 0x3c, 0xb4,
+	0x72, 0xbb, 0x15, 0xa9, 0x26, 0x79, 0xa7, 0xa4, 0x64, 0x05, 0xf9, 0xf9, 0x8a, 0x30, 0x4c, 0x84,
+	0xdb, 0x5a, 0x27, 0x7b, 0x6e, 0x21, 0x77, 0xb2, 0x15, 0xa9, 0x2e, 0x5b, 0x05, 0xa1, 0x00, 0xbb,
+	0x29, 0x15, 0x28, 0x99, 0x92, 0x34, 0xe6, 0x19, 0xc9, 0x91, 0xa4, 0x9c, 0x09, 0xb7, 0x3d, 0xd8,
+	0x1c, 0x6e, 0x9f, 0x78, 0x4d, 0x83, 0x6f, 0x4b, 0xcc, 0xab, 0x22, 0x23, 0xe1, 0x93, 0xe5, 0xdc,
+	0x7b, 0xbc, 0x82, 0x5f, 0xf3, 0x82, 0x65, 0xba, 0x62, 0x0a, 0x78, 0x0e, 0x7a, 0x38, 0xe7, 0x2c,
+	0xfe, 0x89, 0x27, 0xc2, 0xed, 0xe8, 0x67, 0x79, 0x77, 0x45, 0x8b, 0x72, 0xce, 0xbe, 0xe6, 0x49,
+	0xad, 0x43, 0x25, 0xa7, 0x26, 0xdf, 0xc5, 0x06, 0x65, 0xfa, 0x8e, 0xa6, 0xd3, 0x04, 0xe1, 0x89,
+	0x70, 0xef, 0xaf, 0xed, 0xbb, 0x85, 0xd4, 0x54, 0x4b, 0xd2, 0xff, 0xfa, 0x5e, 0x06, 0xe1, 0xef,
+	0x0e, 0xd8, 0xa7, 0x09, 0x8e, 0x65, 0x8e, 0x98, 0xb8, 0x20, 0x79, 0x7c, 0x67, 0xd2, 0xd5, 0x26,
+	0xef, 0x37, 0x4d, 0x46, 0xe1, 0xe9, 0x2b, 0x0b, 0xaf, 0xfc, 0x9e, 0x29, 0xbf, 0xc5, 0xdc, 0xdb,
+	0x5b, 0x91, 0x14, 0xcb, 0xb9, 0x37, 0x58, 0x6d, 0x52, 0x2b, 0x6a, 0x8f, 0x26, 0xb8, 0xc1, 0x84,
+	0x53, 0xf0, 0x36, 0x12, 0x05, 0xc3, 0x31, 0xc2, 0x93, 0x38, 0x43, 0x78, 0x42, 0xa4, 0x70, 0x7b,
+	0xba, 0xb2, 0x43, 0x9f, 0x26, 0xd8, 0x57, 0xd3, 0xe3, 0x97, 0x23, 0xa3, 0xbf, 0x7d, 0x85, 0x09,
+	0x9f, 0xda, 0xe7, 0x3f, 0x6c, 0xb0, 0x6b, 0x96, 0x3b, 0x3a, 0xf9, 0x1c, 0x4f, 0x0c, 0x49, 0x1c,
+	0xfd, 0xe1, 0x80, 0x96, 0x9a, 0x02, 0xf8, 0x14, 0xdc, 0x57, 0x9f, 0x7b, 0x4c, 0x53, 0x3d, 0x65,
+	0xad, 0x10, 0x2c, 0xe6, 0x5e, 0x47, 0xa5, 0x46, 0x67, 0x51, 0x47, 0xa5, 0x46, 0x29, 0xfc, 0x02,
+	0xf4, 0x0c, 0x88, 0x5d, 0x70, 0xf7, 0xde, 0xc0, 0x59, 0xf3, 0x4a, 0x14, 0x98, 0x5d, 0x70, 0x3b,
+	0x8e, 0x5d, 0x6c, 0xef, 0xe1, 0x63, 0x00, 0x34, 0x3d, 0x29, 0x24, 0x51, 0xa3, 0xe4, 0x0c, 0xb7,
+	0x22, 0x2d, 0x18, 0xaa, 0x00, 0xdc, 0x07, 0x9d, 0x8c, 0x32, 0x46, 0x52, 0xb7, 0x35, 0x70, 0x86,
+	0xdd, 0xc8, 0xde, 0x1d, 0xfd, 0xb9, 0x09, 0xba, 0xe5, 0x78, 0xc1, 0x0f, 0xc1, 0xc3, 0x72, 0x86,
+	0x62, 0x94, 0xa6, 0x39, 0x11, 0x66, 0x2d, 0xf4, 0xa2, 0x9d, 0x32, 0xfe, 0xdc, 0x84, 0xe1, 0x08,
+	0xbc, 0x55, 0x41, 0x6b, 0x15, 0xf7, 0xd7, 0x0f, 0x6f, 0xad, 0xea, 0x2d, 0x5c, 0x8b, 0xc1, 0x33,
+	0xb0, 0x5d, 0x49, 0x09, 0x89, 0x24, 0xb1, 0x8b, 0xe0, 0x51, 0x53, 0xeb, 0x1b, 0x9e, 0x92, 0xa9,
+	0x15, 0xa9, 0xfc, 0xcd, 0x22, 0x4b, 0xc1, 0x3b, 0x95, 0x8a, 0x6e, 0xc4, 0x25, 0x15, 0x92, 0xe7,
+	0x85, 0x1d, 0xff, 0x8f, 0xd6, 0x17, 0xa6, 0x5a, 0xfa, 0x95, 0x01, 0xbf, 0x60, 0x32, 0x2f, 0xac,
+	0xfe, 0x2e, 0x6e, 0xe6, 0xa1, 0x04, 0x3b, 0xea, 0x02, 0x8d, 0x49, 0x9c, 0x92, 0x8c, 0x0b, 0x2a,
+	0xdd, 0x76, 0x6d, 0x24, 0xb9, 0xf0, 0xd5, 0xea, 0xf6, 0xed, 0xea, 0xf6, 0x4f, 0x39, 0x65, 0xe1,
+	0xa7, 0x4a, 0xee, 0xaf, 0x7f, 0xbc, 0xe1, 0x98, 0xca, 0xcb, 0xab, 0xc4, 0xc7, 0x7c, 0x16, 0xd8,
+	0x3d, 0x6f, 0x4e, 0x9f, 0x88, 0x74, 0x62, 0x17, 0xb9, 0x22, 0x88, 0x68, 0xdb, 0x7a, 0x9c, 0x19,
+	0x8b, 0xa3, 0x10, 0x74, 0xcb, 0x5d, 0x05, 0x07, 0xa0, 0x43, 0xd3, 0x78, 0x42, 0x0a, 0xfd, 0x66,
+	0xb6, 0xc2, 0xde, 0x62, 0xee, 0xb5, 0x47, 0x67, 0x2f, 0x49, 0x11, 0xb5, 0x69, 0xfa, 0x92, 0x14,
+	0x70, 0x0f, 0xb4, 0xaf, 0xd1, 0xf4, 0x8a, 0xe8, 0x57, 0xd2, 0x8a, 0xcc, 0x4d, 0xf8, 0xec, 0xf5,
+	0xa2, 0xef, 0xdc, 0x2c, 0xfa, 0xce, 0xbf, 0x8b, 0xbe, 0xf3, 0xdb, 0x6d, 0x7f, 0xe3, 0xe6, 0xb6,
+	0xbf, 0xf1, 0xf7, 0x6d, 0x7f, 0xe3, 0xc7, 0x0f, 0x6a, 0x75, 0x9d, 0x72, 0x31, 0xfb, 0xa1, 0xfc,
+	0xbf, 0xa4, 0xc1, 0xaf, 0xfa, 0x6c, 0x6a, 0x4b, 0x3a, 0xfa, 0x17, 0xf2, 0xd9, 0x7f, 0x03, 0x00,
+	0x77, 0xe4, 0xc7, 0x9c, 0xed, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -375,6 +452,80 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AsyncAckPackets) > 0 {
+		for iNdEx := len(m.AsyncAckPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AsyncAckPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.IBCTransferCallbacks) > 0 {
+		for iNdEx := len(m.IBCTransferCallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IBCTransferCallbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Callbacks) > 0 {
+		for iNdEx := len(m.Callbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Callbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.CronJobs) > 0 {
+		for iNdEx := len(m.CronJobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CronJobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DisabledOperations) > 0 {
+		dAtA2 := make([]byte, len(m.DisabledOperations)*10)
+		var j1 int
+		for _, num := range m.DisabledOperations {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Sequences) > 0 {
 		for iNdEx := len(m.Sequences) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -505,6 +656,20 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StorageDeposit) > 0 {
+		for iNdEx := len(m.StorageDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.ContractCodeHistory) > 0 {
 		for iNdEx := len(m.ContractCodeHistory) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -626,6 +791,37 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DisabledOperations) > 0 {
+		l = 0
+		for _, e := range m.DisabledOperations {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.CronJobs) > 0 {
+		for _, e := range m.CronJobs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Callbacks) > 0 {
+		for _, e := range m.Callbacks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IBCTransferCallbacks) > 0 {
+		for _, e := range m.IBCTransferCallbacks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AsyncAckPackets) > 0 {
+		for _, e := range m.AsyncAckPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -674,6 +870,12 @@ func (m *Contract) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.StorageDeposit) > 0 {
+		for _, e := range m.StorageDeposit {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -865,6 +1067,211 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v OperationType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= OperationType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DisabledOperations = append(m.DisabledOperations, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.DisabledOperations) == 0 {
+					m.DisabledOperations = make([]OperationType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v OperationType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= OperationType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DisabledOperations = append(m.DisabledOperations, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledOperations", wireType)
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronJobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronJobs = append(m.CronJobs, CronJob{})
+			if err := m.CronJobs[len(m.CronJobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callbacks = append(m.Callbacks, Callback{})
+			if err := m.Callbacks[len(m.Callbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCTransferCallbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IBCTransferCallbacks = append(m.IBCTransferCallbacks, IBCTransferCallback{})
+			if err := m.IBCTransferCallbacks[len(m.IBCTransferCallbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsyncAckPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AsyncAckPackets = append(m.AsyncAckPackets, types.Packet{})
+			if err := m.AsyncAckPackets[len(m.AsyncAckPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1206,6 +1613,40 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageDeposit = append(m.StorageDeposit, types1.Coin{})
+			if err := m.StorageDeposit[len(m.StorageDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/tendermint/tendermint/libs/rand"
 
@@ -47,6 +48,111 @@ func TestValidateGenesisState(t *testing.T) {
 			},
 			expError: true,
 		},
+		"disabled operations": {
+			srcMutator: func(s *GenesisState) {
+				s.DisabledOperations = []OperationType{OperationTypeExecute, OperationTypeIBCSend}
+			},
+		},
+		"disabled operation invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.DisabledOperations = []OperationType{OperationTypeUnspecified}
+			},
+			expError: true,
+		},
+		"cron jobs": {
+			srcMutator: func(s *GenesisState) {
+				s.CronJobs = []CronJob{CronJobFixture()}
+			},
+		},
+		"cron job invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.CronJobs = []CronJob{CronJobFixture(func(j *CronJob) { j.Interval = 0 })}
+			},
+			expError: true,
+		},
+		"cron job duplicate": {
+			srcMutator: func(s *GenesisState) {
+				s.CronJobs = []CronJob{CronJobFixture(), CronJobFixture()}
+			},
+			expError: true,
+		},
+		"callbacks": {
+			srcMutator: func(s *GenesisState) {
+				s.Callbacks = []Callback{CallbackFixture(), CallbackFixture(func(c *Callback) { c.ID = 2 })}
+			},
+		},
+		"callback invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.Callbacks = []Callback{CallbackFixture(func(c *Callback) { c.GasLimit = 0 })}
+			},
+			expError: true,
+		},
+		"callback duplicate id": {
+			srcMutator: func(s *GenesisState) {
+				s.Callbacks = []Callback{CallbackFixture(), CallbackFixture()}
+			},
+			expError: true,
+		},
+		"ibc transfer callbacks": {
+			srcMutator: func(s *GenesisState) {
+				s.IBCTransferCallbacks = []IBCTransferCallback{
+					IBCTransferCallbackFixture(),
+					IBCTransferCallbackFixture(func(c *IBCTransferCallback) { c.Sequence = 8 }),
+					IBCTransferCallbackFixture(func(c *IBCTransferCallback) { c.ChannelID = "channel-2" }),
+				}
+			},
+		},
+		"ibc transfer callback invalid channel": {
+			srcMutator: func(s *GenesisState) {
+				s.IBCTransferCallbacks = []IBCTransferCallback{IBCTransferCallbackFixture(func(c *IBCTransferCallback) { c.ChannelID = "" })}
+			},
+			expError: true,
+		},
+		"ibc transfer callback empty sequence": {
+			srcMutator: func(s *GenesisState) {
+				s.IBCTransferCallbacks = []IBCTransferCallback{IBCTransferCallbackFixture(func(c *IBCTransferCallback) { c.Sequence = 0 })}
+			},
+			expError: true,
+		},
+		"ibc transfer callback invalid contract": {
+			srcMutator: func(s *GenesisState) {
+				s.IBCTransferCallbacks = []IBCTransferCallback{IBCTransferCallbackFixture(func(c *IBCTransferCallback) { c.Contract = "invalid" })}
+			},
+			expError: true,
+		},
+		"ibc transfer callback duplicate packet": {
+			srcMutator: func(s *GenesisState) {
+				s.IBCTransferCallbacks = []IBCTransferCallback{IBCTransferCallbackFixture(), IBCTransferCallbackFixture()}
+			},
+			expError: true,
+		},
+		"async ack packets": {
+			srcMutator: func(s *GenesisState) {
+				s.AsyncAckPackets = []channeltypes.Packet{
+					AsyncAckPacketFixture(),
+					AsyncAckPacketFixture(func(p *channeltypes.Packet) { p.Sequence = 8 }),
+					AsyncAckPacketFixture(func(p *channeltypes.Packet) { p.DestinationChannel = "channel-2" }),
+				}
+			},
+		},
+		"async ack packet invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.AsyncAckPackets = []channeltypes.Packet{AsyncAckPacketFixture(func(p *channeltypes.Packet) { p.Sequence = 0 })}
+			},
+			expError: true,
+		},
+		"async ack packet duplicate": {
+			srcMutator: func(s *GenesisState) {
+				s.AsyncAckPackets = []channeltypes.Packet{AsyncAckPacketFixture(), AsyncAckPacketFixture()}
+			},
+			expError: true,
+		},
+		"disabled operation duplicate": {
+			srcMutator: func(s *GenesisState) {
+				s.DisabledOperations = []OperationType{OperationTypeExecute, OperationTypeExecute}
+			},
+			expError: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -147,6 +253,17 @@ func TestContractValidateBasic(t *testing.T) {
 			},
 			expError: true,
 		},
+		"contract with storage deposit": {
+			srcMutator: func(c *Contract) {
+				c.StorageDeposit = sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+			},
+		},
+		"storage deposit invalid": {
+			srcMutator: func(c *Contract) {
+				c.StorageDeposit = sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}
+			},
+			expError: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -20,6 +20,10 @@ const (
 
 	// RouterKey is the msg router key for the wasm module
 	RouterKey = ModuleName
+
+	// ICAControllerModuleName is the capability scope and ibc route of the interchain account channels owned by
+	// contracts
+	ICAControllerModuleName = "wasmica"
 )
 
 // nolint
@@ -33,17 +37,50 @@ var (
 	PinnedCodeIndexPrefix                          = []byte{0x07}
 	TXCounterPrefix                                = []byte{0x08}
 	ContractsByCreatorPrefix                       = []byte{0x09}
+	PendingCodeRemovalPrefix                       = []byte{0x0a}
+	ContractStorageStatsPrefix                     = []byte{0x0b}
+	DisabledOperationPrefix                        = []byte{0x0c}
+	CronJobPrefix                                  = []byte{0x0d}
+	CronSchedulePrefix                             = []byte{0x0e}
+	CallbackQueuePrefix                            = []byte{0x0f}
+	ContractCallbacksPrefix                        = []byte{0x10}
+	CodeIDsByChecksumPrefix                        = []byte{0x11}
+	ContractsByAdminPrefix                         = []byte{0x12}
+	ContractsByLabelPrefix                         = []byte{0x13}
+	ContractsByCreatorLabelPrefix                  = []byte{0x14}
+	IBCTransferCallbackPrefix                      = []byte{0x15}
+	AsyncAckPacketPrefix                           = []byte{0x16}
+	LabelIndexScopeKey                             = []byte{0x17}
+	ContractIBCTransferCallbacksPrefix             = []byte{0x18}
 
 	KeyLastCodeID     = append(SequenceKeyPrefix, []byte("lastCodeId")...)
 	KeyLastInstanceID = append(SequenceKeyPrefix, []byte("lastContractId")...)
+	KeyLastCallbackID = append(SequenceKeyPrefix, []byte("lastCallbackId")...)
+)
+
+// keys of the transient store
+var (
+	StateChangeJournalSeqKey = []byte{0x01}
+	StateChangeJournalPrefix = []byte{0x02}
 )
 
+// GetStateChangeJournalKey returns the key of a journaled contract state change in the transient store
+func GetStateChangeJournalKey(seq uint64) []byte {
+	return append(StateChangeJournalPrefix, sdk.Uint64ToBigEndian(seq)...)
+}
+
 // GetCodeKey constructs the key for retreiving the ID for the WASM code
 func GetCodeKey(codeID uint64) []byte {
 	contractIDBz := sdk.Uint64ToBigEndian(codeID)
 	return append(CodeKeyPrefix, contractIDBz...)
 }
 
+// GetPendingCodeRemovalKey constructs the key for a checksum of a removed code that is pending to be dropped
+// from the wasmvm cache
+func GetPendingCodeRemovalKey(checksum []byte) []byte {
+	return append(PendingCodeRemovalPrefix, checksum...)
+}
+
 // GetContractAddressKey returns the key for the WASM contract instance
 func GetContractAddressKey(addr sdk.AccAddress) []byte {
 	return append(ContractKeyPrefix, addr...)
@@ -60,6 +97,155 @@ func GetContractStorePrefix(addr sdk.AccAddress) []byte {
 	return append(ContractStorePrefix, addr...)
 }
 
+// GetContractStorageStatsKey returns the key for the storage stats of the contract
+func GetContractStorageStatsKey(addr sdk.AccAddress) []byte {
+	return append(ContractStorageStatsPrefix, addr...)
+}
+
+// GetDisabledOperationKey returns the key for an operation disabled by the circuit breaker
+func GetDisabledOperationKey(op OperationType) []byte {
+	return append(DisabledOperationPrefix, byte(op))
+}
+
+// GetCronJobKey returns the key for the cron job of a contract
+func GetCronJobKey(contractAddr sdk.AccAddress) []byte {
+	return append(CronJobPrefix, contractAddr...)
+}
+
+// GetCronScheduleKey returns the key for the cron schedule index. The block height is stored big endian so that
+// due jobs can be iterated in order of their height.
+func GetCronScheduleKey(height int64, contractAddr sdk.AccAddress) []byte {
+	return append(GetCronSchedulePrefix(height), contractAddr...)
+}
+
+// GetCronSchedulePrefix returns the cron schedule index prefix for a block height
+func GetCronSchedulePrefix(height int64) []byte {
+	return append(CronSchedulePrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetCallbackQueueKey returns the key for a callback in the queue. The block height is stored big endian so that
+// due callbacks can be iterated in order of their height and id.
+func GetCallbackQueueKey(height int64, callbackID uint64) []byte {
+	return append(append(CallbackQueuePrefix, sdk.Uint64ToBigEndian(uint64(height))...), sdk.Uint64ToBigEndian(callbackID)...)
+}
+
+// GetContractCallbacksPrefix returns the prefix of the callbacks scheduled by a contract
+func GetContractCallbacksPrefix(contractAddr sdk.AccAddress) []byte {
+	return append(ContractCallbacksPrefix, address.MustLengthPrefix(contractAddr)...)
+}
+
+// GetContractCallbackKey returns the key for the secondary index: `<prefix><contractAddr><callbackID>`
+func GetContractCallbackKey(contractAddr sdk.AccAddress, callbackID uint64) []byte {
+	return append(GetContractCallbacksPrefix(contractAddr), sdk.Uint64ToBigEndian(callbackID)...)
+}
+
+// GetIBCTransferCallbackKey returns the key for the contract that sent the ICS-20 packet: `<prefix><channelID><sequence>`
+func GetIBCTransferCallbackKey(channelID string, sequence uint64) []byte {
+	return append(append(IBCTransferCallbackPrefix, address.MustLengthPrefix([]byte(channelID))...), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetContractIBCTransferCallbacksPrefix returns the prefix of the ICS-20 packets sent by a contract that are
+// pending to be called back
+func GetContractIBCTransferCallbacksPrefix(contractAddr sdk.AccAddress) []byte {
+	return append(ContractIBCTransferCallbacksPrefix, address.MustLengthPrefix(contractAddr)...)
+}
+
+// GetContractIBCTransferCallbackKey returns the key for the secondary index: `<prefix><contractAddr><channelID><sequence>`
+func GetContractIBCTransferCallbackKey(contractAddr sdk.AccAddress, channelID string, sequence uint64) []byte {
+	return append(append(GetContractIBCTransferCallbacksPrefix(contractAddr), address.MustLengthPrefix([]byte(channelID))...), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// ParseIBCTransferCallbackKey returns the channel id and sequence of an ICS-20 packet from a transfer callback key
+// without the prefix and contract address: `<channelID><sequence>`
+func ParseIBCTransferCallbackKey(key []byte) (string, uint64, error) {
+	if len(key) == 0 {
+		return "", 0, ErrInvalid.Wrap("empty transfer callback key")
+	}
+	channelEnd := 1 + int(key[0])
+	if len(key) != channelEnd+8 {
+		return "", 0, ErrInvalid.Wrap("transfer callback key length")
+	}
+	return string(key[1:channelEnd]), sdk.BigEndianToUint64(key[channelEnd:]), nil
+}
+
+// GetAsyncAckPacketsPrefix returns the prefix for the received packets on the port that are not acknowledged, yet
+func GetAsyncAckPacketsPrefix(portID string) []byte {
+	return append(AsyncAckPacketPrefix, address.MustLengthPrefix([]byte(portID))...)
+}
+
+// GetAsyncAckPacketKey returns the key for a received packet that is not acknowledged, yet:
+// `<prefix><portID><channelID><sequence>`
+func GetAsyncAckPacketKey(portID, channelID string, sequence uint64) []byte {
+	return append(append(GetAsyncAckPacketsPrefix(portID), address.MustLengthPrefix([]byte(channelID))...), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetContractsByAdminPrefix returns the prefix for the secondary index of contracts by admin
+func GetContractsByAdminPrefix(admin sdk.AccAddress) []byte {
+	return append(ContractsByAdminPrefix, address.MustLengthPrefix(admin)...)
+}
+
+// GetContractByAdminSecondaryIndexKey returns the key for the secondary index: `<prefix><adminAddress length><adminAddress><contractAddr>`
+func GetContractByAdminSecondaryIndexKey(admin, contractAddr sdk.AccAddress) []byte {
+	return append(GetContractsByAdminPrefix(admin), contractAddr...)
+}
+
+// GetContractsByLabelPrefix returns the prefix for the secondary index of contracts by label. The label is not length
+// prefixed so that all labels starting with the given label prefix share the same store prefix.
+func GetContractsByLabelPrefix(labelPrefix string) []byte {
+	return append(ContractsByLabelPrefix, labelPrefix...)
+}
+
+// GetContractByLabelSecondaryIndexKey returns the key for the secondary index:
+// `<prefix><label><0x00><contractAddr><contractAddr length>`
+func GetContractByLabelSecondaryIndexKey(label string, contractAddr sdk.AccAddress) []byte {
+	return appendLabelIndexSuffix(GetContractsByLabelPrefix(label), contractAddr)
+}
+
+// GetContractsByCreatorLabelPrefix returns the prefix for the secondary index of contracts by creator and label. The
+// label is not length prefixed so that all labels starting with the given label prefix share the same store prefix.
+func GetContractsByCreatorLabelPrefix(creator sdk.AccAddress, labelPrefix string) []byte {
+	return append(append(ContractsByCreatorLabelPrefix, address.MustLengthPrefix(creator)...), labelPrefix...)
+}
+
+// GetContractByCreatorLabelSecondaryIndexKey returns the key for the secondary index:
+// `<prefix><creatorAddress length><creatorAddress><label><0x00><contractAddr><contractAddr length>`
+func GetContractByCreatorLabelSecondaryIndexKey(creator sdk.AccAddress, label string, contractAddr sdk.AccAddress) []byte {
+	return appendLabelIndexSuffix(GetContractsByCreatorLabelPrefix(creator, label), contractAddr)
+}
+
+// appendLabelIndexSuffix terminates the label with a zero byte so that keys are sorted by label before address
+func appendLabelIndexSuffix(prefix []byte, contractAddr sdk.AccAddress) []byte {
+	return append(append(append(prefix, 0), contractAddr...), byte(len(contractAddr)))
+}
+
+// ParseLabelIndexKey splits the key of a label secondary index into the label suffix and the contract address. The
+// key must be relative to a label prefix, for example when iterating a prefix store. The label suffix is the part of
+// the label that follows that label prefix.
+func ParseLabelIndexKey(key []byte) (string, sdk.AccAddress, error) {
+	if len(key) == 0 {
+		return "", nil, ErrInvalid.Wrap("empty label index key")
+	}
+	addrLen := int(key[len(key)-1])
+	if addrLen == 0 || addrLen > len(key)-2 {
+		return "", nil, ErrInvalid.Wrap("label index key address length")
+	}
+	labelEnd := len(key) - 2 - addrLen
+	if key[labelEnd] != 0 {
+		return "", nil, ErrInvalid.Wrap("label index key terminator")
+	}
+	return string(key[:labelEnd]), sdk.AccAddress(key[labelEnd+1 : len(key)-1]), nil
+}
+
+// GetCodeIDsByChecksumPrefix returns the prefix for the secondary index of code ids by checksum
+func GetCodeIDsByChecksumPrefix(checksum []byte) []byte {
+	return append(CodeIDsByChecksumPrefix, address.MustLengthPrefix(checksum)...)
+}
+
+// GetCodeIDByChecksumKey returns the key for the secondary index: `<prefix><checksum><codeID>`
+func GetCodeIDByChecksumKey(checksum []byte, codeID uint64) []byte {
+	return append(GetCodeIDsByChecksumPrefix(checksum), sdk.Uint64ToBigEndian(codeID)...)
+}
+
 // GetContractByCreatedSecondaryIndexKey returns the key for the secondary index:
 // `<prefix><codeID><created/last-migrated><contractAddr>`
 func GetContractByCreatedSecondaryIndexKey(contractAddr sdk.AccAddress, c ContractCodeHistoryEntry) []byte {
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetContractByCodeIDSecondaryIndexPrefix(t *testing.T) {
@@ -145,3 +147,136 @@ func TestGetContractByCreatorSecondaryIndexKey(t *testing.T) {
 	}
 	assert.Equal(t, exp, got)
 }
+
+func TestGetContractByAdminSecondaryIndexKey(t *testing.T) {
+	adminAddr := bytes.Repeat([]byte{4}, 20)
+	contractAddr := bytes.Repeat([]byte{5}, 32)
+	got := GetContractByAdminSecondaryIndexKey(adminAddr, contractAddr)
+	exp := []byte{
+		0x12,                         // prefix
+		20,                           // admin address length
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, // admin address with fixed length prefix
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, // address 32 bytes
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5,
+	}
+	assert.Equal(t, exp, got)
+}
+
+func TestGetContractByCreatorLabelSecondaryIndexKey(t *testing.T) {
+	creatorAddr := bytes.Repeat([]byte{4}, 20)
+	contractAddr := bytes.Repeat([]byte{5}, 32)
+	got := GetContractByCreatorLabelSecondaryIndexKey(creatorAddr, "foo", contractAddr)
+	exp := []byte{
+		0x14,                         // prefix
+		20,                           // creator address length
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, // creator address with fixed length prefix
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		'f', 'o', 'o', // label
+		0,                            // label terminator
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5, // address 32 bytes
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
+		5, 5,
+		32, // address length
+	}
+	assert.Equal(t, exp, got)
+}
+
+func TestParseLabelIndexKey(t *testing.T) {
+	contractAddr := sdk.AccAddress(bytes.Repeat([]byte{5}, 32))
+	specs := map[string]struct {
+		src            []byte
+		expLabelSuffix string
+		expAddr        sdk.AccAddress
+		expErr         bool
+	}{
+		"full label": {
+			src:            GetContractByLabelSecondaryIndexKey("foo", contractAddr)[len(ContractsByLabelPrefix):],
+			expLabelSuffix: "foo",
+			expAddr:        contractAddr,
+		},
+		"relative to label prefix": {
+			src:            GetContractByLabelSecondaryIndexKey("foo", contractAddr)[len(GetContractsByLabelPrefix("fo")):],
+			expLabelSuffix: "o",
+			expAddr:        contractAddr,
+		},
+		"relative to full label": {
+			src:     GetContractByLabelSecondaryIndexKey("foo", contractAddr)[len(GetContractsByLabelPrefix("foo")):],
+			expAddr: contractAddr,
+		},
+		"empty": {
+			expErr: true,
+		},
+		"address length exceeds key": {
+			src:    []byte{0, 1, 2, 3},
+			expErr: true,
+		},
+		"zero address length": {
+			src:    []byte{0, 1, 0},
+			expErr: true,
+		},
+		"missing terminator": {
+			src:    []byte{'a', 1, 1},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotLabelSuffix, gotAddr, gotErr := ParseLabelIndexKey(spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expLabelSuffix, gotLabelSuffix)
+			assert.Equal(t, spec.expAddr, gotAddr)
+		})
+	}
+}
+
+func TestParseIBCTransferCallbackKey(t *testing.T) {
+	contractAddr := sdk.AccAddress(bytes.Repeat([]byte{5}, 32))
+	specs := map[string]struct {
+		src          []byte
+		expChannelID string
+		expSequence  uint64
+		expErr       bool
+	}{
+		"transfer callback key": {
+			src:          GetIBCTransferCallbackKey("channel-1", 7)[len(IBCTransferCallbackPrefix):],
+			expChannelID: "channel-1",
+			expSequence:  7,
+		},
+		"contract index key": {
+			src:          GetContractIBCTransferCallbackKey(contractAddr, "channel-1", 7)[len(GetContractIBCTransferCallbacksPrefix(contractAddr)):],
+			expChannelID: "channel-1",
+			expSequence:  7,
+		},
+		"empty": {
+			expErr: true,
+		},
+		"channel length exceeds key": {
+			src:    []byte{9, 'a', 0, 0, 0, 0, 0, 0, 0, 7},
+			expErr: true,
+		},
+		"sequence too short": {
+			src:    []byte{1, 'a', 0, 7},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotChannelID, gotSequence, gotErr := ParseIBCTransferCallbackKey(spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expChannelID, gotChannelID)
+			assert.Equal(t, spec.expSequence, gotSequence)
+		})
+	}
+}
@@ -13,8 +13,20 @@ import (
 )
 
 var (
-	ParamStoreKeyUploadAccess      = []byte("uploadAccess")
-	ParamStoreKeyInstantiateAccess = []byte("instantiateAccess")
+	ParamStoreKeyUploadAccess         = []byte("uploadAccess")
+	ParamStoreKeyInstantiateAccess    = []byte("instantiateAccess")
+	ParamStoreKeyMaxContractStorage   = []byte("maxContractStorageBytes")
+	ParamStoreKeyStorageDepositDenom  = []byte("storageDepositDenom")
+	ParamStoreKeyStorageDepositPrice  = []byte("storageDepositPrice")
+	ParamStoreKeyEmergencyAuthority   = []byte("emergencyAuthority")
+	ParamStoreKeyCronBlockGasLimit    = []byte("cronBlockGasLimit")
+	ParamStoreKeyMaxCallbacksPerBlock = []byte("maxCallbacksPerBlock")
+	ParamStoreKeyMaxCallbackGasLimit  = []byte("maxCallbackGasLimit")
+	ParamStoreKeyCallbackFeeDenom     = []byte("callbackFeeDenom")
+	ParamStoreKeyCallbackGasPrice     = []byte("callbackGasPrice")
+	ParamStoreKeyEnforceCodeReuse     = []byte("enforceCodeReuse")
+	ParamStoreKeyEnforceUniqueLabels  = []byte("enforceUniqueLabels")
+	ParamStoreKeyLabelIndexScope      = []byte("labelIndexScope")
 )
 
 var AllAccessTypes = []AccessType{
@@ -124,6 +136,18 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyUploadAccess, &p.CodeUploadAccess, validateAccessConfig),
 		paramtypes.NewParamSetPair(ParamStoreKeyInstantiateAccess, &p.InstantiateDefaultPermission, validateAccessType),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxContractStorage, &p.MaxContractStorageBytes, validateMaxContractStorageBytes),
+		paramtypes.NewParamSetPair(ParamStoreKeyStorageDepositDenom, &p.StorageDepositDenom, validateStorageDepositDenom),
+		paramtypes.NewParamSetPair(ParamStoreKeyStorageDepositPrice, &p.StorageDepositPrice, validateStorageDepositPrice),
+		paramtypes.NewParamSetPair(ParamStoreKeyEmergencyAuthority, &p.EmergencyAuthority, validateEmergencyAuthority),
+		paramtypes.NewParamSetPair(ParamStoreKeyCronBlockGasLimit, &p.CronBlockGasLimit, validateCronBlockGasLimit),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxCallbacksPerBlock, &p.MaxCallbacksPerBlock, validateMaxCallbacksPerBlock),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxCallbackGasLimit, &p.MaxCallbackGasLimit, validateMaxCallbackGasLimit),
+		paramtypes.NewParamSetPair(ParamStoreKeyCallbackFeeDenom, &p.CallbackFeeDenom, validateStorageDepositDenom),
+		paramtypes.NewParamSetPair(ParamStoreKeyCallbackGasPrice, &p.CallbackGasPrice, validateStorageDepositPrice),
+		paramtypes.NewParamSetPair(ParamStoreKeyEnforceCodeReuse, &p.EnforceCodeReuse, validateEnforceCodeReuse),
+		paramtypes.NewParamSetPair(ParamStoreKeyEnforceUniqueLabels, &p.EnforceUniqueLabels, validateEnforceUniqueLabels),
+		paramtypes.NewParamSetPair(ParamStoreKeyLabelIndexScope, &p.LabelIndexScope, validateLabelIndexScope),
 	}
 }
 
@@ -135,6 +159,42 @@ func (p Params) ValidateBasic() error {
 	if err := validateAccessConfig(p.CodeUploadAccess); err != nil {
 		return errors.Wrap(err, "upload access")
 	}
+	if err := validateMaxContractStorageBytes(p.MaxContractStorageBytes); err != nil {
+		return errors.Wrap(err, "max contract storage bytes")
+	}
+	if err := validateStorageDepositDenom(p.StorageDepositDenom); err != nil {
+		return errors.Wrap(err, "storage deposit denom")
+	}
+	if err := validateStorageDepositPrice(p.StorageDepositPrice); err != nil {
+		return errors.Wrap(err, "storage deposit price")
+	}
+	if err := validateEmergencyAuthority(p.EmergencyAuthority); err != nil {
+		return errors.Wrap(err, "emergency authority")
+	}
+	if err := validateCronBlockGasLimit(p.CronBlockGasLimit); err != nil {
+		return errors.Wrap(err, "cron block gas limit")
+	}
+	if err := validateMaxCallbacksPerBlock(p.MaxCallbacksPerBlock); err != nil {
+		return errors.Wrap(err, "max callbacks per block")
+	}
+	if err := validateMaxCallbackGasLimit(p.MaxCallbackGasLimit); err != nil {
+		return errors.Wrap(err, "max callback gas limit")
+	}
+	if err := validateStorageDepositDenom(p.CallbackFeeDenom); err != nil {
+		return errors.Wrap(err, "callback fee denom")
+	}
+	if err := validateStorageDepositPrice(p.CallbackGasPrice); err != nil {
+		return errors.Wrap(err, "callback gas price")
+	}
+	if err := validateEnforceCodeReuse(p.EnforceCodeReuse); err != nil {
+		return errors.Wrap(err, "enforce code reuse")
+	}
+	if err := validateEnforceUniqueLabels(p.EnforceUniqueLabels); err != nil {
+		return errors.Wrap(err, "enforce unique labels")
+	}
+	if err := validateLabelIndexScope(p.LabelIndexScope); err != nil {
+		return errors.Wrap(err, "label index scope")
+	}
 	return nil
 }
 
@@ -146,6 +206,112 @@ func validateAccessConfig(i interface{}) error {
 	return v.ValidateBasic()
 }
 
+func validateMaxContractStorageBytes(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateStorageDepositDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == "" {
+		return nil
+	}
+	return sdk.ValidateDenom(v)
+}
+
+func validateStorageDepositPrice(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !v.IsNil() && v.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalid, "must not be negative")
+	}
+	return nil
+}
+
+func validateEmergencyAuthority(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == "" {
+		return nil
+	}
+	_, err := sdk.AccAddressFromBech32(v)
+	return err
+}
+
+func validateCronBlockGasLimit(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxCallbacksPerBlock(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxCallbackGasLimit(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateEnforceCodeReuse(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateEnforceUniqueLabels(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateLabelIndexScope(i interface{}) error {
+	v, ok := i.(LabelIndexScope)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if _, ok := LabelIndexScope_name[int32(v)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalid, "unknown label index scope: %d", v)
+	}
+	return nil
+}
+
+// EffectiveLabelIndexScope returns the scope of the index of contracts by label. Contracts are indexed by label per
+// creator at least when unique labels are enforced.
+func (p Params) EffectiveLabelIndexScope() LabelIndexScope {
+	if p.EnforceUniqueLabels && p.LabelIndexScope == LabelIndexScopeNone {
+		return LabelIndexScopeCreator
+	}
+	return p.LabelIndexScope
+}
+
+// StorageDepositEnabled returns true when a deposit is locked for the bytes stored by contracts
+func (p Params) StorageDepositEnabled() bool {
+	return p.StorageDepositDenom != "" && !p.StorageDepositPrice.IsNil() && p.StorageDepositPrice.IsPositive()
+}
+
+// CallbackFeeEnabled returns true when contracts prepay a fee for the gas limit of scheduled callbacks
+func (p Params) CallbackFeeEnabled() bool {
+	return p.CallbackFeeDenom != "" && !p.CallbackGasPrice.IsNil() && p.CallbackGasPrice.IsPositive()
+}
+
 func validateAccessType(i interface{}) error {
 	a, ok := i.(AccessType)
 	if !ok {
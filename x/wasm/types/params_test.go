@@ -138,6 +138,88 @@ func TestValidateParams(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"all good with storage deposit": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				StorageDepositDenom:          "stake",
+				StorageDepositPrice:          sdk.NewDecWithPrec(1, 3),
+			},
+		},
+		"all good with emergency authority": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				EmergencyAuthority:           anyAddress.String(),
+			},
+		},
+		"reject invalid emergency authority": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				EmergencyAuthority:           invalidAddress,
+			},
+			expErr: true,
+		},
+		"reject invalid storage deposit denom": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				StorageDepositDenom:          "1",
+			},
+			expErr: true,
+		},
+		"reject negative storage deposit price": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				StorageDepositDenom:          "stake",
+				StorageDepositPrice:          sdk.NewDec(-1),
+			},
+			expErr: true,
+		},
+		"all good with callbacks": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				MaxCallbacksPerBlock:         10,
+				MaxCallbackGasLimit:          1_000_000,
+				CallbackFeeDenom:             "stake",
+				CallbackGasPrice:             sdk.NewDecWithPrec(1, 3),
+			},
+		},
+		"reject invalid callback fee denom": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				CallbackFeeDenom:             "1",
+			},
+			expErr: true,
+		},
+		"reject negative callback gas price": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				CallbackFeeDenom:             "stake",
+				CallbackGasPrice:             sdk.NewDec(-1),
+			},
+			expErr: true,
+		},
+		"all good with global label index": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				LabelIndexScope:              LabelIndexScopeGlobal,
+			},
+		},
+		"reject unknown label index scope": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				LabelIndexScope:              3,
+			},
+			expErr: true,
+		},
 		"reject wrong field address in any of  addresses": {
 			src: Params{
 				CodeUploadAccess:             AccessConfig{Permission: AccessTypeAnyOfAddresses, Address: anyAddress.String(), Addresses: []string{anyAddress.String()}},
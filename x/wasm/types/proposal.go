@@ -26,6 +26,12 @@ const (
 	ProposalTypeUnpinCodes                          ProposalType = "UnpinCodes"
 	ProposalTypeUpdateInstantiateConfig             ProposalType = "UpdateInstantiateConfig"
 	ProposalTypeStoreAndInstantiateContractProposal ProposalType = "StoreAndInstantiateContract"
+	ProposalTypeDeleteContract                      ProposalType = "DeleteContract"
+	ProposalTypeRemoveCode                          ProposalType = "RemoveCode"
+	ProposalTypeFreezeContract                      ProposalType = "FreezeContract"
+	ProposalTypeUnfreezeContract                    ProposalType = "UnfreezeContract"
+	ProposalTypeRegisterCronJob                     ProposalType = "RegisterCronJob"
+	ProposalTypeDeregisterCronJob                   ProposalType = "DeregisterCronJob"
 )
 
 // DisableAllProposals contains no wasm gov types.
@@ -45,6 +51,12 @@ var EnableAllProposals = []ProposalType{
 	ProposalTypeUnpinCodes,
 	ProposalTypeUpdateInstantiateConfig,
 	ProposalTypeStoreAndInstantiateContractProposal,
+	ProposalTypeDeleteContract,
+	ProposalTypeRemoveCode,
+	ProposalTypeFreezeContract,
+	ProposalTypeUnfreezeContract,
+	ProposalTypeRegisterCronJob,
+	ProposalTypeDeregisterCronJob,
 }
 
 // ConvertToProposals maps each key to a ProposalType and returns a typed list.
@@ -78,6 +90,12 @@ func init() { // register new content types with the sdk
 	govtypes.RegisterProposalType(string(ProposalTypeUnpinCodes))
 	govtypes.RegisterProposalType(string(ProposalTypeUpdateInstantiateConfig))
 	govtypes.RegisterProposalType(string(ProposalTypeStoreAndInstantiateContractProposal))
+	govtypes.RegisterProposalType(string(ProposalTypeDeleteContract))
+	govtypes.RegisterProposalType(string(ProposalTypeRemoveCode))
+	govtypes.RegisterProposalType(string(ProposalTypeFreezeContract))
+	govtypes.RegisterProposalType(string(ProposalTypeUnfreezeContract))
+	govtypes.RegisterProposalType(string(ProposalTypeRegisterCronJob))
+	govtypes.RegisterProposalType(string(ProposalTypeDeregisterCronJob))
 	govtypes.RegisterProposalTypeCodec(&StoreCodeProposal{}, "wasm/StoreCodeProposal")
 	govtypes.RegisterProposalTypeCodec(&InstantiateContractProposal{}, "wasm/InstantiateContractProposal")
 	govtypes.RegisterProposalTypeCodec(&InstantiateContract2Proposal{}, "wasm/InstantiateContract2Proposal")
@@ -90,6 +108,12 @@ func init() { // register new content types with the sdk
 	govtypes.RegisterProposalTypeCodec(&UnpinCodesProposal{}, "wasm/UnpinCodesProposal")
 	govtypes.RegisterProposalTypeCodec(&UpdateInstantiateConfigProposal{}, "wasm/UpdateInstantiateConfigProposal")
 	govtypes.RegisterProposalTypeCodec(&StoreAndInstantiateContractProposal{}, "wasm/StoreAndInstantiateContractProposal")
+	govtypes.RegisterProposalTypeCodec(&DeleteContractProposal{}, "wasm/DeleteContractProposal")
+	govtypes.RegisterProposalTypeCodec(&RemoveCodeProposal{}, "wasm/RemoveCodeProposal")
+	govtypes.RegisterProposalTypeCodec(&FreezeContractProposal{}, "wasm/FreezeContractProposal")
+	govtypes.RegisterProposalTypeCodec(&UnfreezeContractProposal{}, "wasm/UnfreezeContractProposal")
+	govtypes.RegisterProposalTypeCodec(&RegisterCronJobProposal{}, "wasm/RegisterCronJobProposal")
+	govtypes.RegisterProposalTypeCodec(&DeregisterCronJobProposal{}, "wasm/DeregisterCronJobProposal")
 }
 
 func NewStoreCodeProposal(
@@ -820,6 +844,171 @@ func (p ClearAdminProposal) String() string {
 `, p.Title, p.Description, p.Contract)
 }
 
+func NewDeleteContractProposal(
+	title string,
+	description string,
+	contract string,
+	recipient string,
+) *DeleteContractProposal {
+	return &DeleteContractProposal{title, description, contract, recipient}
+}
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p DeleteContractProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *DeleteContractProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p DeleteContractProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p DeleteContractProposal) ProposalType() string { return string(ProposalTypeDeleteContract) }
+
+// ValidateBasic validates the proposal
+func (p DeleteContractProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Recipient); err != nil {
+		return sdkerrors.Wrap(err, "recipient")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p DeleteContractProposal) String() string {
+	return fmt.Sprintf(`Delete Contract Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  Recipient:   %s
+`, p.Title, p.Description, p.Contract, p.Recipient)
+}
+
+func NewRemoveCodeProposal(
+	title string,
+	description string,
+	codeID uint64,
+) *RemoveCodeProposal {
+	return &RemoveCodeProposal{title, description, codeID}
+}
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p RemoveCodeProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *RemoveCodeProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p RemoveCodeProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p RemoveCodeProposal) ProposalType() string { return string(ProposalTypeRemoveCode) }
+
+// ValidateBasic validates the proposal
+func (p RemoveCodeProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if p.CodeID == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "code id")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p RemoveCodeProposal) String() string {
+	return fmt.Sprintf(`Remove Code Proposal:
+  Title:       %s
+  Description: %s
+  Code ID:     %d
+`, p.Title, p.Description, p.CodeID)
+}
+
+func NewFreezeContractProposal(
+	title string,
+	description string,
+	contract string,
+) *FreezeContractProposal {
+	return &FreezeContractProposal{title, description, contract}
+}
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p FreezeContractProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *FreezeContractProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p FreezeContractProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p FreezeContractProposal) ProposalType() string { return string(ProposalTypeFreezeContract) }
+
+// ValidateBasic validates the proposal
+func (p FreezeContractProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p FreezeContractProposal) String() string {
+	return fmt.Sprintf(`Freeze Contract Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+`, p.Title, p.Description, p.Contract)
+}
+
+func NewUnfreezeContractProposal(
+	title string,
+	description string,
+	contract string,
+) *UnfreezeContractProposal {
+	return &UnfreezeContractProposal{title, description, contract}
+}
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p UnfreezeContractProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *UnfreezeContractProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p UnfreezeContractProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p UnfreezeContractProposal) ProposalType() string { return string(ProposalTypeUnfreezeContract) }
+
+// ValidateBasic validates the proposal
+func (p UnfreezeContractProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p UnfreezeContractProposal) String() string {
+	return fmt.Sprintf(`Unfreeze Contract Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+`, p.Title, p.Description, p.Contract)
+}
+
 func NewPinCodesProposal(
 	title string,
 	description string,
@@ -994,3 +1183,130 @@ func (c AccessConfigUpdate) String() string {
   AccessConfig: %v
 `, c.CodeID, c.InstantiatePermission)
 }
+
+func NewRegisterCronJobProposal(
+	title string,
+	description string,
+	contract string,
+	interval uint64,
+	gasLimit uint64,
+	msg RawContractMessage,
+	maxFailures uint32,
+) *RegisterCronJobProposal {
+	return &RegisterCronJobProposal{
+		Title:       title,
+		Description: description,
+		Contract:    contract,
+		Interval:    interval,
+		GasLimit:    gasLimit,
+		Msg:         msg,
+		MaxFailures: maxFailures,
+	}
+}
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p RegisterCronJobProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *RegisterCronJobProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p RegisterCronJobProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p RegisterCronJobProposal) ProposalType() string { return string(ProposalTypeRegisterCronJob) }
+
+// ValidateBasic validates the proposal
+func (p RegisterCronJobProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	return p.CronJob().ValidateBasic()
+}
+
+// CronJob returns the cron job to register
+func (p RegisterCronJobProposal) CronJob() CronJob {
+	return CronJob{
+		Contract:    p.Contract,
+		Interval:    p.Interval,
+		GasLimit:    p.GasLimit,
+		Msg:         p.Msg,
+		MaxFailures: p.MaxFailures,
+	}
+}
+
+// String implements the Stringer interface.
+func (p RegisterCronJobProposal) String() string {
+	return fmt.Sprintf(`Register Cron Job Proposal:
+  Title:        %s
+  Description:  %s
+  Contract:     %s
+  Interval:     %d
+  Gas Limit:    %d
+  Msg:          %q
+  Max Failures: %d
+`, p.Title, p.Description, p.Contract, p.Interval, p.GasLimit, p.Msg, p.MaxFailures)
+}
+
+// MarshalYAML pretty prints the sudo message
+func (p RegisterCronJobProposal) MarshalYAML() (interface{}, error) {
+	return struct {
+		Title       string `yaml:"title"`
+		Description string `yaml:"description"`
+		Contract    string `yaml:"contract"`
+		Interval    uint64 `yaml:"interval"`
+		GasLimit    uint64 `yaml:"gas_limit"`
+		Msg         string `yaml:"msg"`
+		MaxFailures uint32 `yaml:"max_failures"`
+	}{
+		Title:       p.Title,
+		Description: p.Description,
+		Contract:    p.Contract,
+		Interval:    p.Interval,
+		GasLimit:    p.GasLimit,
+		Msg:         string(p.Msg),
+		MaxFailures: p.MaxFailures,
+	}, nil
+}
+
+func NewDeregisterCronJobProposal(
+	title string,
+	description string,
+	contract string,
+) *DeregisterCronJobProposal {
+	return &DeregisterCronJobProposal{title, description, contract}
+}
+
+// ProposalRoute returns the routing key of a parameter change proposal.
+func (p DeregisterCronJobProposal) ProposalRoute() string { return RouterKey }
+
+// GetTitle returns the title of the proposal
+func (p *DeregisterCronJobProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the human readable description of the proposal
+func (p DeregisterCronJobProposal) GetDescription() string { return p.Description }
+
+// ProposalType returns the type
+func (p DeregisterCronJobProposal) ProposalType() string {
+	return string(ProposalTypeDeregisterCronJob)
+}
+
+// ValidateBasic validates the proposal
+func (p DeregisterCronJobProposal) ValidateBasic() error {
+	if err := validateProposalCommons(p.Title, p.Description); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p DeregisterCronJobProposal) String() string {
+	return fmt.Sprintf(`Deregister Cron Job Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+`, p.Title, p.Description, p.Contract)
+}
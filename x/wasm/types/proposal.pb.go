@@ -156,7 +156,7 @@ type InstantiateContract2Proposal struct {
 	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
 	// Description is a human readable text
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	// RunAs is the address that is passed to the contract's environment as sender
+	// RunAs is the address that is passed to the contract's enviroment as sender
 	RunAs string `protobuf:"bytes,3,opt,name=run_as,json=runAs,proto3" json:"run_as,omitempty"`
 	// Admin is an optional address that can execute migrations
 	Admin string `protobuf:"bytes,4,opt,name=admin,proto3" json:"admin,omitempty"`
@@ -463,6 +463,104 @@ func (m *ClearAdminProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClearAdminProposal proto.InternalMessageInfo
 
+// DeleteContractProposal gov proposal content type to remove a smart contract
+// with all its state and send the remaining balance to the recipient.
+type DeleteContractProposal struct {
+	// Title is a short summary
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Description is a human readable text
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Recipient is the address that receives the remaining contract balance
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *DeleteContractProposal) Reset()      { *m = DeleteContractProposal{} }
+func (*DeleteContractProposal) ProtoMessage() {}
+func (*DeleteContractProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be6422d717c730cb, []int{8}
+}
+
+func (m *DeleteContractProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *DeleteContractProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteContractProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *DeleteContractProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteContractProposal.Merge(m, src)
+}
+
+func (m *DeleteContractProposal) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *DeleteContractProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteContractProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteContractProposal proto.InternalMessageInfo
+
+// RemoveCodeProposal gov proposal content type to remove a code that is not
+// used by any contract.
+type RemoveCodeProposal struct {
+	// Title is a short summary
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Description is a human readable text
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// CodeID references the stored WASM code
+	CodeID uint64 `protobuf:"varint,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+}
+
+func (m *RemoveCodeProposal) Reset()      { *m = RemoveCodeProposal{} }
+func (*RemoveCodeProposal) ProtoMessage() {}
+func (*RemoveCodeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be6422d717c730cb, []int{9}
+}
+
+func (m *RemoveCodeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *RemoveCodeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveCodeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *RemoveCodeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveCodeProposal.Merge(m, src)
+}
+
+func (m *RemoveCodeProposal) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *RemoveCodeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveCodeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveCodeProposal proto.InternalMessageInfo
+
 // PinCodesProposal gov proposal content type to pin a set of code ids in the
 // wasmvm cache.
 type PinCodesProposal struct {
@@ -477,7 +575,7 @@ type PinCodesProposal struct {
 func (m *PinCodesProposal) Reset()      { *m = PinCodesProposal{} }
 func (*PinCodesProposal) ProtoMessage() {}
 func (*PinCodesProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_be6422d717c730cb, []int{8}
+	return fileDescriptor_be6422d717c730cb, []int{10}
 }
 
 func (m *PinCodesProposal) XXX_Unmarshal(b []byte) error {
@@ -525,7 +623,7 @@ type UnpinCodesProposal struct {
 func (m *UnpinCodesProposal) Reset()      { *m = UnpinCodesProposal{} }
 func (*UnpinCodesProposal) ProtoMessage() {}
 func (*UnpinCodesProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_be6422d717c730cb, []int{9}
+	return fileDescriptor_be6422d717c730cb, []int{11}
 }
 
 func (m *UnpinCodesProposal) XXX_Unmarshal(b []byte) error {
@@ -571,7 +669,7 @@ type AccessConfigUpdate struct {
 func (m *AccessConfigUpdate) Reset()      { *m = AccessConfigUpdate{} }
 func (*AccessConfigUpdate) ProtoMessage() {}
 func (*AccessConfigUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_be6422d717c730cb, []int{10}
+	return fileDescriptor_be6422d717c730cb, []int{12}
 }
 
 func (m *AccessConfigUpdate) XXX_Unmarshal(b []byte) error {
@@ -620,7 +718,7 @@ type UpdateInstantiateConfigProposal struct {
 func (m *UpdateInstantiateConfigProposal) Reset()      { *m = UpdateInstantiateConfigProposal{} }
 func (*UpdateInstantiateConfigProposal) ProtoMessage() {}
 func (*UpdateInstantiateConfigProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_be6422d717c730cb, []int{11}
+	return fileDescriptor_be6422d717c730cb, []int{13}
 }
 
 func (m *UpdateInstantiateConfigProposal) XXX_Unmarshal(b []byte) error {
@@ -690,7 +788,7 @@ type StoreAndInstantiateContractProposal struct {
 func (m *StoreAndInstantiateContractProposal) Reset()      { *m = StoreAndInstantiateContractProposal{} }
 func (*StoreAndInstantiateContractProposal) ProtoMessage() {}
 func (*StoreAndInstantiateContractProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_be6422d717c730cb, []int{12}
+	return fileDescriptor_be6422d717c730cb, []int{14}
 }
 
 func (m *StoreAndInstantiateContractProposal) XXX_Unmarshal(b []byte) error {
@@ -724,6 +822,207 @@ func (m *StoreAndInstantiateContractProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StoreAndInstantiateContractProposal proto.InternalMessageInfo
 
+// FreezeContractProposal gov proposal content type to pause a smart contract so
+// that it rejects executions, migrations and IBC packets.
+type FreezeContractProposal struct {
+	// Title is a short summary
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Description is a human readable text
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *FreezeContractProposal) Reset()      { *m = FreezeContractProposal{} }
+func (*FreezeContractProposal) ProtoMessage() {}
+func (*FreezeContractProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be6422d717c730cb, []int{15}
+}
+
+func (m *FreezeContractProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *FreezeContractProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FreezeContractProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *FreezeContractProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreezeContractProposal.Merge(m, src)
+}
+
+func (m *FreezeContractProposal) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *FreezeContractProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreezeContractProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FreezeContractProposal proto.InternalMessageInfo
+
+// UnfreezeContractProposal gov proposal content type to resume a frozen smart
+// contract.
+type UnfreezeContractProposal struct {
+	// Title is a short summary
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Description is a human readable text
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *UnfreezeContractProposal) Reset()      { *m = UnfreezeContractProposal{} }
+func (*UnfreezeContractProposal) ProtoMessage() {}
+func (*UnfreezeContractProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be6422d717c730cb, []int{16}
+}
+
+func (m *UnfreezeContractProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *UnfreezeContractProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnfreezeContractProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *UnfreezeContractProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnfreezeContractProposal.Merge(m, src)
+}
+
+func (m *UnfreezeContractProposal) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *UnfreezeContractProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnfreezeContractProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnfreezeContractProposal proto.InternalMessageInfo
+
+// RegisterCronJobProposal gov proposal content type to schedule periodic sudo
+// calls to a smart contract from the end blocker.
+type RegisterCronJobProposal struct {
+	// Title is a short summary
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Description is a human readable text
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Interval is the number of blocks between two executions
+	Interval uint64 `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	// GasLimit is the max gas that can be consumed by a single execution
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// Msg json encoded message to be passed to the contract as sudo
+	Msg RawContractMessage `protobuf:"bytes,6,opt,name=msg,proto3,casttype=RawContractMessage" json:"msg,omitempty"`
+	// MaxFailures is the number of consecutive failed executions after which the
+	// job is deregistered. Failed executions are skipped only when zero.
+	MaxFailures uint32 `protobuf:"varint,7,opt,name=max_failures,json=maxFailures,proto3" json:"max_failures,omitempty"`
+}
+
+func (m *RegisterCronJobProposal) Reset()      { *m = RegisterCronJobProposal{} }
+func (*RegisterCronJobProposal) ProtoMessage() {}
+func (*RegisterCronJobProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be6422d717c730cb, []int{17}
+}
+
+func (m *RegisterCronJobProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *RegisterCronJobProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterCronJobProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *RegisterCronJobProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterCronJobProposal.Merge(m, src)
+}
+
+func (m *RegisterCronJobProposal) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *RegisterCronJobProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterCronJobProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterCronJobProposal proto.InternalMessageInfo
+
+// DeregisterCronJobProposal gov proposal content type to remove the scheduled
+// sudo calls of a smart contract.
+type DeregisterCronJobProposal struct {
+	// Title is a short summary
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Description is a human readable text
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *DeregisterCronJobProposal) Reset()      { *m = DeregisterCronJobProposal{} }
+func (*DeregisterCronJobProposal) ProtoMessage() {}
+func (*DeregisterCronJobProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be6422d717c730cb, []int{18}
+}
+
+func (m *DeregisterCronJobProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *DeregisterCronJobProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeregisterCronJobProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *DeregisterCronJobProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeregisterCronJobProposal.Merge(m, src)
+}
+
+func (m *DeregisterCronJobProposal) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *DeregisterCronJobProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeregisterCronJobProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeregisterCronJobProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*StoreCodeProposal)(nil), "cosmwasm.wasm.v1.StoreCodeProposal")
 	proto.RegisterType((*InstantiateContractProposal)(nil), "cosmwasm.wasm.v1.InstantiateContractProposal")
@@ -733,82 +1032,97 @@ func init() {
 	proto.RegisterType((*ExecuteContractProposal)(nil), "cosmwasm.wasm.v1.ExecuteContractProposal")
 	proto.RegisterType((*UpdateAdminProposal)(nil), "cosmwasm.wasm.v1.UpdateAdminProposal")
 	proto.RegisterType((*ClearAdminProposal)(nil), "cosmwasm.wasm.v1.ClearAdminProposal")
+	proto.RegisterType((*DeleteContractProposal)(nil), "cosmwasm.wasm.v1.DeleteContractProposal")
+	proto.RegisterType((*RemoveCodeProposal)(nil), "cosmwasm.wasm.v1.RemoveCodeProposal")
 	proto.RegisterType((*PinCodesProposal)(nil), "cosmwasm.wasm.v1.PinCodesProposal")
 	proto.RegisterType((*UnpinCodesProposal)(nil), "cosmwasm.wasm.v1.UnpinCodesProposal")
 	proto.RegisterType((*AccessConfigUpdate)(nil), "cosmwasm.wasm.v1.AccessConfigUpdate")
 	proto.RegisterType((*UpdateInstantiateConfigProposal)(nil), "cosmwasm.wasm.v1.UpdateInstantiateConfigProposal")
 	proto.RegisterType((*StoreAndInstantiateContractProposal)(nil), "cosmwasm.wasm.v1.StoreAndInstantiateContractProposal")
+	proto.RegisterType((*FreezeContractProposal)(nil), "cosmwasm.wasm.v1.FreezeContractProposal")
+	proto.RegisterType((*UnfreezeContractProposal)(nil), "cosmwasm.wasm.v1.UnfreezeContractProposal")
+	proto.RegisterType((*RegisterCronJobProposal)(nil), "cosmwasm.wasm.v1.RegisterCronJobProposal")
+	proto.RegisterType((*DeregisterCronJobProposal)(nil), "cosmwasm.wasm.v1.DeregisterCronJobProposal")
 }
 
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/proposal.proto", fileDescriptor_be6422d717c730cb) }
 
 var fileDescriptor_be6422d717c730cb = []byte{
-	// 1025 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x8f, 0xf3, 0xc7, 0x71, 0x5e, 0x02, 0x84, 0xd9, 0xb4, 0xf5, 0x76, 0x17, 0x3b, 0xca, 0xa2,
-	0x55, 0x2e, 0x9b, 0x90, 0x22, 0x21, 0xd8, 0x5b, 0x1c, 0x90, 0xe8, 0x8a, 0x4a, 0x95, 0xab, 0x6a,
-	0x25, 0x90, 0xb0, 0x26, 0xf6, 0xc4, 0xb5, 0x48, 0x3c, 0x91, 0xc7, 0xee, 0x9f, 0x33, 0x17, 0x24,
-	0x2e, 0x9c, 0x10, 0x1f, 0x01, 0x71, 0x43, 0xda, 0x23, 0x1f, 0xa0, 0xda, 0x0b, 0xcb, 0x6d, 0x0f,
-	0x28, 0xb0, 0xe9, 0x8d, 0x63, 0x8f, 0x9c, 0x90, 0x67, 0x9c, 0x6c, 0xda, 0x6d, 0x93, 0x2d, 0xdb,
-	0x16, 0x09, 0xed, 0x25, 0xf1, 0xf3, 0x7b, 0x33, 0xf3, 0x7b, 0xbf, 0xf7, 0xe6, 0xf9, 0x3d, 0xd0,
-	0x6d, 0xca, 0x06, 0x7b, 0x98, 0x0d, 0x9a, 0xfc, 0x67, 0xb7, 0xd5, 0x1c, 0x06, 0x74, 0x48, 0x19,
-	0xee, 0x37, 0x86, 0x01, 0x0d, 0x29, 0x2a, 0x4f, 0x0c, 0x1a, 0xfc, 0x67, 0xb7, 0xb5, 0x5a, 0x71,
-	0xa9, 0x4b, 0xb9, 0xb2, 0x19, 0x3f, 0x09, 0xbb, 0xd5, 0x9b, 0xb1, 0x1d, 0x65, 0x96, 0x50, 0x08,
-	0x21, 0x51, 0x69, 0x42, 0x6a, 0x76, 0x31, 0x23, 0xcd, 0xdd, 0x56, 0x97, 0x84, 0xb8, 0xd5, 0xb4,
-	0xa9, 0xe7, 0x27, 0xfa, 0xdb, 0x2f, 0x60, 0x08, 0x0f, 0x86, 0x24, 0x59, 0x5d, 0xfb, 0x36, 0x03,
-	0x6f, 0x6f, 0x85, 0x34, 0x20, 0x1d, 0xea, 0x90, 0xcd, 0x04, 0x1c, 0xaa, 0x40, 0x2e, 0xf4, 0xc2,
-	0x3e, 0x51, 0xa5, 0xaa, 0x54, 0x2f, 0x98, 0x42, 0x40, 0x55, 0x28, 0x3a, 0x84, 0xd9, 0x81, 0x37,
-	0x0c, 0x3d, 0xea, 0xab, 0x69, 0xae, 0x9b, 0x7d, 0x85, 0x96, 0x40, 0x0e, 0x22, 0xdf, 0xc2, 0x4c,
-	0xcd, 0x88, 0x85, 0x41, 0xe4, 0xb7, 0x19, 0xfa, 0x00, 0xde, 0x8c, 0xcf, 0xb6, 0xba, 0x07, 0x21,
-	0xb1, 0x6c, 0xea, 0x10, 0x35, 0x5b, 0x95, 0xea, 0x25, 0xa3, 0x3c, 0x1e, 0xe9, 0xa5, 0x87, 0xed,
-	0xad, 0x0d, 0xe3, 0x20, 0xe4, 0x00, 0xcc, 0x52, 0x6c, 0x37, 0x91, 0xd0, 0x36, 0x2c, 0x7b, 0x3e,
-	0x0b, 0xb1, 0x1f, 0x7a, 0x38, 0x24, 0xd6, 0x90, 0x04, 0x03, 0x8f, 0xb1, 0xf8, 0xec, 0x7c, 0x55,
-	0xaa, 0x17, 0xd7, 0xb4, 0xc6, 0x69, 0xfa, 0x1a, 0x6d, 0xdb, 0x26, 0x8c, 0x75, 0xa8, 0xdf, 0xf3,
-	0x5c, 0x73, 0x69, 0x66, 0xf5, 0xe6, 0x74, 0x31, 0x7a, 0x07, 0x20, 0xf2, 0x87, 0x9e, 0x2f, 0xa0,
-	0x28, 0x55, 0xa9, 0xae, 0x98, 0x05, 0xfe, 0x86, 0x9f, 0xba, 0x0c, 0x32, 0xa3, 0x51, 0x60, 0x13,
-	0xb5, 0xc0, 0x9d, 0x48, 0x24, 0xa4, 0x42, 0xbe, 0x1b, 0x79, 0x7d, 0x87, 0x04, 0x2a, 0x70, 0xc5,
-	0x44, 0x44, 0xb7, 0xa0, 0x10, 0x6f, 0x65, 0xed, 0x60, 0xb6, 0xa3, 0x16, 0x63, 0xd7, 0x4c, 0x25,
-	0x7e, 0xf1, 0x29, 0x66, 0x3b, 0xf7, 0xb5, 0xc7, 0x8f, 0xee, 0xad, 0x26, 0x11, 0x73, 0xe9, 0x6e,
-	0x23, 0x09, 0x51, 0xa3, 0x43, 0xfd, 0x90, 0xf8, 0xe1, 0x83, 0xac, 0x92, 0x2b, 0xcb, 0x0f, 0xb2,
-	0x8a, 0x5c, 0xce, 0xd7, 0xfe, 0x4a, 0xc3, 0xad, 0xf5, 0xe7, 0x98, 0x63, 0x93, 0x00, 0xdb, 0xe1,
-	0x55, 0xc5, 0xa5, 0x02, 0x39, 0xec, 0x0c, 0x3c, 0x9f, 0x87, 0xa3, 0x60, 0x0a, 0x01, 0xdd, 0x81,
-	0x3c, 0xf7, 0xc6, 0x73, 0xd4, 0x5c, 0x55, 0xaa, 0x67, 0x0d, 0x18, 0x8f, 0x74, 0x39, 0xa6, 0x66,
-	0xfd, 0x63, 0x53, 0x8e, 0x55, 0xeb, 0x4e, 0xbc, 0xb4, 0x8f, 0xbb, 0xa4, 0xaf, 0xca, 0x62, 0x29,
-	0x17, 0x50, 0x1d, 0x32, 0x03, 0xe6, 0xf2, 0xe8, 0x94, 0x8c, 0xe5, 0xbf, 0x47, 0x3a, 0x32, 0xf1,
-	0xde, 0xc4, 0x8b, 0x0d, 0xc2, 0x18, 0x76, 0x89, 0x19, 0x9b, 0x20, 0x0c, 0xb9, 0x5e, 0xe4, 0x3b,
-	0x4c, 0x55, 0xaa, 0x99, 0x7a, 0x71, 0xed, 0x66, 0x23, 0x61, 0x28, 0xce, 0xe2, 0x19, 0x8a, 0x3c,
-	0xdf, 0x78, 0xef, 0x70, 0xa4, 0xa7, 0x7e, 0xfa, 0x43, 0xaf, 0xbb, 0x5e, 0xb8, 0x13, 0x75, 0x1b,
-	0x36, 0x1d, 0x24, 0x17, 0x20, 0xf9, 0xbb, 0xc7, 0x9c, 0xaf, 0x92, 0x9c, 0x8e, 0x17, 0x30, 0x53,
-	0xec, 0xbc, 0x88, 0xf8, 0xda, 0x0f, 0x19, 0xb8, 0x7d, 0x06, 0xd9, 0x6b, 0xaf, 0xd9, 0xfe, 0x17,
-	0x6c, 0x23, 0x04, 0x59, 0x86, 0xfb, 0x21, 0xbf, 0x33, 0x25, 0x93, 0x3f, 0xa3, 0x15, 0xc8, 0xf7,
-	0xbc, 0x7d, 0x2b, 0x06, 0x09, 0xfc, 0x96, 0xc9, 0x3d, 0x6f, 0x7f, 0x83, 0xb9, 0x0b, 0x43, 0xf3,
-	0xbb, 0x04, 0x2b, 0x1b, 0x9e, 0x1b, 0x5c, 0xe6, 0x1d, 0x58, 0x05, 0xc5, 0x4e, 0xf6, 0x4a, 0x22,
-	0x30, 0x95, 0x5f, 0x2e, 0x08, 0x09, 0xdd, 0xf2, 0x42, 0xba, 0x17, 0xba, 0xf7, 0x48, 0x82, 0xca,
-	0x56, 0xe4, 0xd0, 0x2b, 0xf1, 0x2d, 0x73, 0xca, 0xb7, 0x04, 0x76, 0xf6, 0xd5, 0x61, 0xff, 0x9c,
-	0x86, 0x95, 0x4f, 0xf6, 0x89, 0x1d, 0x5d, 0x7d, 0x65, 0x9a, 0x17, 0xac, 0xc4, 0xa1, 0xdc, 0x05,
-	0xd2, 0x5e, 0xfe, 0xcf, 0x8a, 0xcc, 0x2f, 0x12, 0xdc, 0xd8, 0x1e, 0x3a, 0x38, 0x24, 0xed, 0xf8,
-	0xba, 0xbf, 0x32, 0x5f, 0x2d, 0x28, 0xf8, 0x64, 0xcf, 0x12, 0x85, 0x84, 0x53, 0x66, 0x54, 0x8e,
-	0x47, 0x7a, 0xf9, 0x00, 0x0f, 0xfa, 0xf7, 0x6b, 0x53, 0x55, 0xcd, 0x54, 0x7c, 0xb2, 0xc7, 0x8f,
-	0x9c, 0xc7, 0xe5, 0x42, 0xf8, 0xdf, 0x48, 0x80, 0x3a, 0x7d, 0x82, 0x83, 0xcb, 0x41, 0x3f, 0x27,
-	0x4f, 0x17, 0x42, 0xf9, 0x55, 0x82, 0xf2, 0xa6, 0xf8, 0x44, 0xb3, 0x29, 0x90, 0xbb, 0x27, 0x80,
-	0x18, 0xe5, 0xe3, 0x91, 0x5e, 0x12, 0x54, 0xf0, 0xd7, 0xb5, 0x09, 0xb4, 0x0f, 0xcf, 0x80, 0x66,
-	0x2c, 0x1f, 0x8f, 0x74, 0x24, 0xac, 0x67, 0x94, 0xb5, 0x93, 0x90, 0x3f, 0x02, 0x25, 0x29, 0x0d,
-	0x71, 0x8a, 0x66, 0xea, 0x59, 0x43, 0x1b, 0x8f, 0xf4, 0xbc, 0xa8, 0x0d, 0xec, 0x78, 0xa4, 0xbf,
-	0x25, 0x76, 0x98, 0x18, 0xd5, 0xcc, 0xbc, 0xa8, 0x17, 0x8b, 0x73, 0xe3, 0x37, 0x09, 0xd0, 0xf6,
-	0xa4, 0xed, 0xf8, 0x9f, 0xf8, 0xf4, 0xbd, 0x04, 0x68, 0xb6, 0x07, 0x13, 0xb9, 0x3f, 0x5b, 0x60,
-	0xa5, 0x73, 0x0b, 0xec, 0x17, 0xe7, 0xb6, 0x7b, 0xe9, 0x97, 0x69, 0xf7, 0x8c, 0x6c, 0x7c, 0x89,
-	0xcf, 0x69, 0xfa, 0x6a, 0x5f, 0xa7, 0x41, 0x17, 0x60, 0x4e, 0x7e, 0xf3, 0x7b, 0x9e, 0x7b, 0x8d,
-	0xcc, 0x7f, 0x09, 0x4b, 0x98, 0x43, 0xb6, 0x6c, 0x7e, 0xb4, 0x15, 0x71, 0x48, 0x22, 0x0c, 0xc5,
-	0xb5, 0x77, 0xe7, 0x7b, 0x28, 0xf0, 0x27, 0x7e, 0xde, 0xc0, 0x2f, 0x68, 0x16, 0x87, 0xe7, 0x71,
-	0x16, 0xee, 0xf0, 0x76, 0xbf, 0xed, 0x3b, 0xd7, 0xd8, 0x68, 0x5e, 0xfe, 0x00, 0x90, 0xbb, 0xbc,
-	0x01, 0x40, 0x3e, 0x3d, 0x00, 0x4c, 0x1b, 0xb5, 0xfc, 0x6c, 0xa3, 0x36, 0xed, 0xc1, 0x94, 0x33,
-	0x7a, 0xb0, 0xc2, 0x05, 0x3e, 0x46, 0x70, 0x65, 0x3d, 0xd8, 0xf3, 0xc9, 0xa5, 0x78, 0xde, 0xe4,
-	0x52, 0x9a, 0x33, 0xb9, 0xbc, 0x71, 0xb1, 0xc9, 0xc5, 0xf8, 0xec, 0xf0, 0x99, 0x96, 0x7a, 0xfa,
-	0x4c, 0x4b, 0xfd, 0x38, 0xd6, 0xa4, 0xc3, 0xb1, 0x26, 0x3d, 0x19, 0x6b, 0xd2, 0x9f, 0x63, 0x4d,
-	0xfa, 0xee, 0x48, 0x4b, 0x3d, 0x39, 0xd2, 0x52, 0x4f, 0x8f, 0xb4, 0xd4, 0xe7, 0x77, 0x67, 0xbc,
-	0xe8, 0x50, 0x36, 0x78, 0x38, 0x19, 0x45, 0x9d, 0xe6, 0xbe, 0x18, 0x49, 0xb9, 0x27, 0x5d, 0x99,
-	0x0f, 0xa4, 0xef, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0xfe, 0x4b, 0x25, 0x36, 0x34, 0x0f, 0x00,
-	0x00,
+	// 1183 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcf, 0x6f, 0xe3, 0xd4,
+	0x13, 0x8f, 0xf3, 0xc3, 0x71, 0x26, 0xd9, 0xef, 0x37, 0x78, 0xdb, 0xd4, 0xed, 0x16, 0x3b, 0x64,
+	0xd1, 0x2a, 0x97, 0x4d, 0x68, 0x91, 0x10, 0xec, 0xad, 0x49, 0x59, 0xd1, 0x6a, 0x2b, 0x55, 0xae,
+	0xaa, 0x95, 0x40, 0xc2, 0x7a, 0xb1, 0x5f, 0xdc, 0x27, 0xfc, 0x23, 0xf2, 0x73, 0xd2, 0x94, 0x2b,
+	0x17, 0x24, 0x10, 0x3f, 0x84, 0x84, 0xf8, 0x13, 0xd0, 0xde, 0x90, 0xf6, 0xc8, 0x1f, 0x50, 0xed,
+	0x85, 0xe5, 0xb6, 0x07, 0x14, 0xd8, 0xf4, 0xc6, 0xb1, 0x47, 0x4e, 0xc8, 0xcf, 0x4e, 0x9a, 0x76,
+	0xdb, 0xba, 0x65, 0x9b, 0x82, 0x10, 0x97, 0x24, 0xf3, 0x66, 0x9e, 0xdf, 0x67, 0x3e, 0xf3, 0x66,
+	0x3c, 0x13, 0x50, 0x74, 0x97, 0xda, 0xbb, 0x88, 0xda, 0x75, 0xf6, 0xd1, 0x5b, 0xaa, 0x77, 0x3c,
+	0xb7, 0xe3, 0x52, 0x64, 0xd5, 0x3a, 0x9e, 0xeb, 0xbb, 0x62, 0x71, 0x64, 0x50, 0x63, 0x1f, 0xbd,
+	0xa5, 0x85, 0x19, 0xd3, 0x35, 0x5d, 0xa6, 0xac, 0x07, 0xbf, 0x42, 0xbb, 0x85, 0xf9, 0xc0, 0xce,
+	0xa5, 0x5a, 0xa8, 0x08, 0x85, 0x48, 0x25, 0x87, 0x52, 0xbd, 0x85, 0x28, 0xae, 0xf7, 0x96, 0x5a,
+	0xd8, 0x47, 0x4b, 0x75, 0xdd, 0x25, 0x4e, 0xa4, 0x5f, 0x7c, 0x01, 0x83, 0xbf, 0xd7, 0xc1, 0xd1,
+	0xee, 0xca, 0x67, 0x29, 0x78, 0x65, 0xcb, 0x77, 0x3d, 0xdc, 0x74, 0x0d, 0xbc, 0x19, 0x81, 0x13,
+	0x67, 0x20, 0xe3, 0x13, 0xdf, 0xc2, 0x12, 0x57, 0xe6, 0xaa, 0x39, 0x35, 0x14, 0xc4, 0x32, 0xe4,
+	0x0d, 0x4c, 0x75, 0x8f, 0x74, 0x7c, 0xe2, 0x3a, 0x52, 0x92, 0xe9, 0x26, 0x97, 0xc4, 0x59, 0xe0,
+	0xbd, 0xae, 0xa3, 0x21, 0x2a, 0xa5, 0xc2, 0x8d, 0x5e, 0xd7, 0x59, 0xa1, 0xe2, 0x5b, 0xf0, 0xbf,
+	0xe0, 0x6c, 0xad, 0xb5, 0xe7, 0x63, 0x4d, 0x77, 0x0d, 0x2c, 0xa5, 0xcb, 0x5c, 0xb5, 0xd0, 0x28,
+	0x0e, 0x07, 0x4a, 0xe1, 0xe1, 0xca, 0xd6, 0x46, 0x63, 0xcf, 0x67, 0x00, 0xd4, 0x42, 0x60, 0x37,
+	0x92, 0xc4, 0x6d, 0x28, 0x11, 0x87, 0xfa, 0xc8, 0xf1, 0x09, 0xf2, 0xb1, 0xd6, 0xc1, 0x9e, 0x4d,
+	0x28, 0x0d, 0xce, 0xce, 0x96, 0xb9, 0x6a, 0x7e, 0x59, 0xae, 0x9d, 0xa4, 0xaf, 0xb6, 0xa2, 0xeb,
+	0x98, 0xd2, 0xa6, 0xeb, 0xb4, 0x89, 0xa9, 0xce, 0x4e, 0xec, 0xde, 0x1c, 0x6f, 0x16, 0x5f, 0x05,
+	0xe8, 0x3a, 0x1d, 0xe2, 0x84, 0x50, 0x84, 0x32, 0x57, 0x15, 0xd4, 0x1c, 0x5b, 0x61, 0xa7, 0x96,
+	0x80, 0xa7, 0x6e, 0xd7, 0xd3, 0xb1, 0x94, 0x63, 0x4e, 0x44, 0x92, 0x28, 0x41, 0xb6, 0xd5, 0x25,
+	0x96, 0x81, 0x3d, 0x09, 0x98, 0x62, 0x24, 0x8a, 0xb7, 0x20, 0x17, 0x3c, 0x4a, 0xdb, 0x41, 0x74,
+	0x47, 0xca, 0x07, 0xae, 0xa9, 0x42, 0xb0, 0xf0, 0x1e, 0xa2, 0x3b, 0xf7, 0xe4, 0x27, 0x8f, 0xef,
+	0x2e, 0x44, 0x11, 0x33, 0xdd, 0x5e, 0x2d, 0x0a, 0x51, 0xad, 0xe9, 0x3a, 0x3e, 0x76, 0xfc, 0xf5,
+	0xb4, 0x90, 0x29, 0xf2, 0xeb, 0x69, 0x81, 0x2f, 0x66, 0x2b, 0xbf, 0x27, 0xe1, 0xd6, 0xda, 0x11,
+	0xe6, 0xc0, 0xc4, 0x43, 0xba, 0x3f, 0xad, 0xb8, 0xcc, 0x40, 0x06, 0x19, 0x36, 0x71, 0x58, 0x38,
+	0x72, 0x6a, 0x28, 0x88, 0xb7, 0x21, 0xcb, 0xbc, 0x21, 0x86, 0x94, 0x29, 0x73, 0xd5, 0x74, 0x03,
+	0x86, 0x03, 0x85, 0x0f, 0xa8, 0x59, 0x5b, 0x55, 0xf9, 0x40, 0xb5, 0x66, 0x04, 0x5b, 0x2d, 0xd4,
+	0xc2, 0x96, 0xc4, 0x87, 0x5b, 0x99, 0x20, 0x56, 0x21, 0x65, 0x53, 0x93, 0x45, 0xa7, 0xd0, 0x28,
+	0xfd, 0x31, 0x50, 0x44, 0x15, 0xed, 0x8e, 0xbc, 0xd8, 0xc0, 0x94, 0x22, 0x13, 0xab, 0x81, 0x89,
+	0x88, 0x20, 0xd3, 0xee, 0x3a, 0x06, 0x95, 0x84, 0x72, 0xaa, 0x9a, 0x5f, 0x9e, 0xaf, 0x45, 0x0c,
+	0x05, 0xb7, 0x78, 0x82, 0x22, 0xe2, 0x34, 0xde, 0xd8, 0x1f, 0x28, 0x89, 0x47, 0xbf, 0x2a, 0x55,
+	0x93, 0xf8, 0x3b, 0xdd, 0x56, 0x4d, 0x77, 0xed, 0x28, 0x01, 0xa2, 0xaf, 0xbb, 0xd4, 0xf8, 0x28,
+	0xba, 0xd3, 0xc1, 0x06, 0xaa, 0x86, 0x4f, 0x8e, 0x23, 0xbe, 0xf2, 0x5d, 0x0a, 0x16, 0x4f, 0x21,
+	0x7b, 0xf9, 0x3f, 0xb6, 0xff, 0x02, 0xdb, 0xa2, 0x08, 0x69, 0x8a, 0x2c, 0x9f, 0xe5, 0x4c, 0x41,
+	0x65, 0xbf, 0xc5, 0x39, 0xc8, 0xb6, 0x49, 0x5f, 0x0b, 0x40, 0x02, 0xcb, 0x32, 0xbe, 0x4d, 0xfa,
+	0x1b, 0xd4, 0x8c, 0x0d, 0xcd, 0x2f, 0x1c, 0xcc, 0x6d, 0x10, 0xd3, 0xbb, 0xca, 0x1c, 0x58, 0x00,
+	0x41, 0x8f, 0x9e, 0x15, 0x45, 0x60, 0x2c, 0x5f, 0x2c, 0x08, 0x11, 0xdd, 0x7c, 0x2c, 0xdd, 0xb1,
+	0xee, 0x3d, 0xe6, 0x60, 0x66, 0xab, 0x6b, 0xb8, 0x53, 0xf1, 0x2d, 0x75, 0xc2, 0xb7, 0x08, 0x76,
+	0xfa, 0xe5, 0x61, 0xff, 0x90, 0x84, 0xb9, 0x77, 0xfb, 0x58, 0xef, 0x4e, 0xbf, 0x32, 0x9d, 0x17,
+	0xac, 0xc8, 0xa1, 0xcc, 0x25, 0xae, 0x3d, 0xff, 0xb7, 0x15, 0x99, 0x1f, 0x39, 0xb8, 0xb9, 0xdd,
+	0x31, 0x90, 0x8f, 0x57, 0x82, 0x74, 0x7f, 0x69, 0xbe, 0x96, 0x20, 0xe7, 0xe0, 0x5d, 0x2d, 0x2c,
+	0x24, 0x8c, 0xb2, 0xc6, 0xcc, 0xe1, 0x40, 0x29, 0xee, 0x21, 0xdb, 0xba, 0x57, 0x19, 0xab, 0x2a,
+	0xaa, 0xe0, 0xe0, 0x5d, 0x76, 0xe4, 0x79, 0x5c, 0xc6, 0xc2, 0xff, 0x94, 0x03, 0xb1, 0x69, 0x61,
+	0xe4, 0x5d, 0x0d, 0xfa, 0x73, 0xee, 0x69, 0x2c, 0x94, 0x47, 0x1c, 0x94, 0x56, 0xb1, 0x85, 0xa7,
+	0x54, 0x12, 0x4e, 0xa6, 0xcd, 0x22, 0xe4, 0x3c, 0xac, 0x93, 0x0e, 0xc1, 0xce, 0x88, 0xb6, 0xa3,
+	0x85, 0x58, 0xb0, 0x5f, 0x73, 0x20, 0xaa, 0xd8, 0x76, 0x7b, 0x57, 0xd3, 0x57, 0x4d, 0xd4, 0xa7,
+	0xd4, 0x59, 0xf5, 0x29, 0x16, 0xd3, 0x4f, 0x1c, 0x14, 0x37, 0xc3, 0x1e, 0x87, 0x8e, 0x11, 0xdd,
+	0x39, 0x86, 0xa8, 0x51, 0x3c, 0x1c, 0x28, 0x85, 0xf0, 0x2e, 0xb1, 0xe5, 0xca, 0x08, 0xe3, 0xdb,
+	0xa7, 0x60, 0x6c, 0x94, 0x0e, 0x07, 0x8a, 0x18, 0x5a, 0x4f, 0x28, 0x2b, 0xc7, 0xb1, 0xbf, 0x03,
+	0x42, 0x84, 0x3d, 0xc8, 0xf1, 0x54, 0x35, 0xdd, 0x90, 0x87, 0x03, 0x25, 0x1b, 0x82, 0xa7, 0x87,
+	0x03, 0xe5, 0xff, 0xe1, 0x13, 0x46, 0x46, 0x15, 0x35, 0x1b, 0x3a, 0x14, 0x9f, 0x5c, 0x3f, 0x73,
+	0x20, 0x6e, 0x3b, 0x9d, 0x7f, 0x95, 0x4f, 0xdf, 0x72, 0x20, 0x4e, 0x36, 0xb1, 0x61, 0xf1, 0x98,
+	0xbc, 0x01, 0xdc, 0x99, 0x6f, 0xa8, 0x0f, 0xce, 0xec, 0x97, 0x93, 0x17, 0xe9, 0x97, 0x1b, 0xe9,
+	0xa0, 0x0a, 0x9e, 0xd1, 0x35, 0x57, 0x3e, 0x49, 0x82, 0x12, 0x82, 0x39, 0xde, 0x34, 0xb5, 0x89,
+	0x79, 0x8d, 0xcc, 0x7f, 0x08, 0xb3, 0x88, 0x41, 0xd6, 0x74, 0x76, 0xb4, 0xd6, 0x65, 0x90, 0xc2,
+	0x30, 0xe4, 0x97, 0x5f, 0x3f, 0xdf, 0xc3, 0x10, 0x7f, 0xe4, 0xe7, 0x4d, 0xf4, 0x82, 0x26, 0x3e,
+	0x3c, 0x4f, 0xd2, 0x70, 0x9b, 0xcd, 0x4b, 0x2b, 0x8e, 0x71, 0x8d, 0x9d, 0xfa, 0xd5, 0x4f, 0x50,
+	0x99, 0xab, 0x9b, 0xa0, 0xf8, 0x93, 0x13, 0xd4, 0xb8, 0xd3, 0xcd, 0x4e, 0x76, 0xba, 0xe3, 0x26,
+	0x56, 0x38, 0xa5, 0x89, 0xcd, 0x5d, 0xe2, 0x6d, 0x0e, 0x53, 0x6b, 0x62, 0x8f, 0x46, 0xbf, 0xfc,
+	0x59, 0xa3, 0x5f, 0xe1, 0x9c, 0xd1, 0xef, 0xc6, 0xe5, 0x46, 0xbf, 0xca, 0xe7, 0x1c, 0x94, 0xee,
+	0x7b, 0x18, 0x7f, 0x7c, 0x2d, 0xaf, 0xb4, 0x58, 0x38, 0x5f, 0x70, 0x20, 0x6d, 0x3b, 0xed, 0x7f,
+	0x0e, 0xa0, 0x6f, 0x92, 0x30, 0xa7, 0x62, 0x93, 0x50, 0x1f, 0x7b, 0x4d, 0xcf, 0x75, 0xd6, 0xdd,
+	0xd6, 0x54, 0xdf, 0xf9, 0x0b, 0x20, 0x10, 0xc7, 0xc7, 0x5e, 0x0f, 0x59, 0x2c, 0xbf, 0xd2, 0xea,
+	0x58, 0x0e, 0x02, 0x6d, 0x22, 0xaa, 0x59, 0xc4, 0x26, 0x7e, 0x38, 0x24, 0xa8, 0x82, 0x89, 0xe8,
+	0x83, 0x40, 0xbe, 0xf8, 0x68, 0x20, 0xbe, 0x06, 0x05, 0x1b, 0xf5, 0xb5, 0x36, 0x22, 0x56, 0xd7,
+	0xc3, 0x94, 0x65, 0xc8, 0x0d, 0x35, 0x6f, 0xa3, 0xfe, 0xfd, 0x68, 0x29, 0x96, 0x95, 0x2f, 0x39,
+	0x98, 0x5f, 0xc5, 0xde, 0xf5, 0xf1, 0x12, 0x87, 0xa8, 0xf1, 0x60, 0xff, 0xb9, 0x9c, 0x78, 0xf6,
+	0x5c, 0x4e, 0x7c, 0x3f, 0x94, 0xb9, 0xfd, 0xa1, 0xcc, 0x3d, 0x1d, 0xca, 0xdc, 0x6f, 0x43, 0x99,
+	0xfb, 0xea, 0x40, 0x4e, 0x3c, 0x3d, 0x90, 0x13, 0xcf, 0x0e, 0xe4, 0xc4, 0xfb, 0x77, 0x26, 0xb2,
+	0xb1, 0xe9, 0x52, 0xfb, 0xe1, 0xe8, 0x3f, 0x29, 0xa3, 0xde, 0x67, 0xdf, 0x61, 0x46, 0xb6, 0x78,
+	0xf6, 0xcf, 0xd4, 0x9b, 0x7f, 0x0e, 0x00, 0x77, 0x34, 0xd7, 0x6b, 0x3d, 0x13, 0x00, 0x00,
 }
 
 func (this *StoreCodeProposal) Equal(that interface{}) bool {
@@ -1149,14 +1463,14 @@ func (this *ClearAdminProposal) Equal(that interface{}) bool {
 	return true
 }
 
-func (this *PinCodesProposal) Equal(that interface{}) bool {
+func (this *DeleteContractProposal) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PinCodesProposal)
+	that1, ok := that.(*DeleteContractProposal)
 	if !ok {
-		that2, ok := that.(PinCodesProposal)
+		that2, ok := that.(DeleteContractProposal)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1174,25 +1488,23 @@ func (this *PinCodesProposal) Equal(that interface{}) bool {
 	if this.Description != that1.Description {
 		return false
 	}
-	if len(this.CodeIDs) != len(that1.CodeIDs) {
+	if this.Contract != that1.Contract {
 		return false
 	}
-	for i := range this.CodeIDs {
-		if this.CodeIDs[i] != that1.CodeIDs[i] {
-			return false
-		}
+	if this.Recipient != that1.Recipient {
+		return false
 	}
 	return true
 }
 
-func (this *UnpinCodesProposal) Equal(that interface{}) bool {
+func (this *RemoveCodeProposal) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*UnpinCodesProposal)
+	that1, ok := that.(*RemoveCodeProposal)
 	if !ok {
-		that2, ok := that.(UnpinCodesProposal)
+		that2, ok := that.(RemoveCodeProposal)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1210,25 +1522,20 @@ func (this *UnpinCodesProposal) Equal(that interface{}) bool {
 	if this.Description != that1.Description {
 		return false
 	}
-	if len(this.CodeIDs) != len(that1.CodeIDs) {
+	if this.CodeID != that1.CodeID {
 		return false
 	}
-	for i := range this.CodeIDs {
-		if this.CodeIDs[i] != that1.CodeIDs[i] {
-			return false
-		}
-	}
 	return true
 }
 
-func (this *AccessConfigUpdate) Equal(that interface{}) bool {
+func (this *PinCodesProposal) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*AccessConfigUpdate)
+	that1, ok := that.(*PinCodesProposal)
 	if !ok {
-		that2, ok := that.(AccessConfigUpdate)
+		that2, ok := that.(PinCodesProposal)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1240,23 +1547,31 @@ func (this *AccessConfigUpdate) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.CodeID != that1.CodeID {
+	if this.Title != that1.Title {
 		return false
 	}
-	if !this.InstantiatePermission.Equal(&that1.InstantiatePermission) {
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.CodeIDs) != len(that1.CodeIDs) {
 		return false
 	}
+	for i := range this.CodeIDs {
+		if this.CodeIDs[i] != that1.CodeIDs[i] {
+			return false
+		}
+	}
 	return true
 }
 
-func (this *UpdateInstantiateConfigProposal) Equal(that interface{}) bool {
+func (this *UnpinCodesProposal) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*UpdateInstantiateConfigProposal)
+	that1, ok := that.(*UnpinCodesProposal)
 	if !ok {
-		that2, ok := that.(UpdateInstantiateConfigProposal)
+		that2, ok := that.(UnpinCodesProposal)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1274,18 +1589,82 @@ func (this *UpdateInstantiateConfigProposal) Equal(that interface{}) bool {
 	if this.Description != that1.Description {
 		return false
 	}
-	if len(this.AccessConfigUpdates) != len(that1.AccessConfigUpdates) {
+	if len(this.CodeIDs) != len(that1.CodeIDs) {
 		return false
 	}
-	for i := range this.AccessConfigUpdates {
-		if !this.AccessConfigUpdates[i].Equal(&that1.AccessConfigUpdates[i]) {
+	for i := range this.CodeIDs {
+		if this.CodeIDs[i] != that1.CodeIDs[i] {
 			return false
 		}
 	}
 	return true
 }
 
-func (this *StoreAndInstantiateContractProposal) Equal(that interface{}) bool {
+func (this *AccessConfigUpdate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccessConfigUpdate)
+	if !ok {
+		that2, ok := that.(AccessConfigUpdate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CodeID != that1.CodeID {
+		return false
+	}
+	if !this.InstantiatePermission.Equal(&that1.InstantiatePermission) {
+		return false
+	}
+	return true
+}
+
+func (this *UpdateInstantiateConfigProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateInstantiateConfigProposal)
+	if !ok {
+		that2, ok := that.(UpdateInstantiateConfigProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.AccessConfigUpdates) != len(that1.AccessConfigUpdates) {
+		return false
+	}
+	for i := range this.AccessConfigUpdates {
+		if !this.AccessConfigUpdates[i].Equal(&that1.AccessConfigUpdates[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *StoreAndInstantiateContractProposal) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
@@ -1351,6 +1730,142 @@ func (this *StoreAndInstantiateContractProposal) Equal(that interface{}) bool {
 	return true
 }
 
+func (this *FreezeContractProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FreezeContractProposal)
+	if !ok {
+		that2, ok := that.(FreezeContractProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	return true
+}
+
+func (this *UnfreezeContractProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UnfreezeContractProposal)
+	if !ok {
+		that2, ok := that.(UnfreezeContractProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	return true
+}
+
+func (this *RegisterCronJobProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RegisterCronJobProposal)
+	if !ok {
+		that2, ok := that.(RegisterCronJobProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if !bytes.Equal(this.Msg, that1.Msg) {
+		return false
+	}
+	if this.MaxFailures != that1.MaxFailures {
+		return false
+	}
+	return true
+}
+
+func (this *DeregisterCronJobProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeregisterCronJobProposal)
+	if !ok {
+		that2, ok := that.(DeregisterCronJobProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	return true
+}
+
 func (m *StoreCodeProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1904,7 +2419,7 @@ func (m *ClearAdminProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PinCodesProposal) Marshal() (dAtA []byte, err error) {
+func (m *DeleteContractProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1914,31 +2429,27 @@ func (m *PinCodesProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PinCodesProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteContractProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PinCodesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeleteContractProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.CodeIDs) > 0 {
-		dAtA3 := make([]byte, len(m.CodeIDs)*10)
-		var j2 int
-		for _, num := range m.CodeIDs {
-			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j2++
-			}
-			dAtA3[j2] = uint8(num)
-			j2++
-		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintProposal(dAtA, i, uint64(j2))
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Contract)))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1959,7 +2470,7 @@ func (m *PinCodesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *UnpinCodesProposal) Marshal() (dAtA []byte, err error) {
+func (m *RemoveCodeProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1969,33 +2480,20 @@ func (m *UnpinCodesProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UnpinCodesProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *RemoveCodeProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UnpinCodesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RemoveCodeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.CodeIDs) > 0 {
-		dAtA5 := make([]byte, len(m.CodeIDs)*10)
-		var j4 int
-		for _, num := range m.CodeIDs {
-			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j4++
-			}
-			dAtA5[j4] = uint8(num)
-			j4++
-		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintProposal(dAtA, i, uint64(j4))
+	if m.CodeID != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.CodeID))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
 	if len(m.Description) > 0 {
 		i -= len(m.Description)
@@ -2014,7 +2512,7 @@ func (m *UnpinCodesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AccessConfigUpdate) Marshal() (dAtA []byte, err error) {
+func (m *PinCodesProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2024,25 +2522,135 @@ func (m *AccessConfigUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccessConfigUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *PinCodesProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccessConfigUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PinCodesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.InstantiatePermission.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.CodeIDs) > 0 {
+		dAtA3 := make([]byte, len(m.CodeIDs)*10)
+		var j2 int
+		for _, num := range m.CodeIDs {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
-		i -= size
-		i = encodeVarintProposal(dAtA, i, uint64(size))
-	}
-	i--
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintProposal(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnpinCodesProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpinCodesProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnpinCodesProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeIDs) > 0 {
+		dAtA5 := make([]byte, len(m.CodeIDs)*10)
+		var j4 int
+		for _, num := range m.CodeIDs {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintProposal(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessConfigUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessConfigUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessConfigUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.InstantiatePermission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProposal(dAtA, i, uint64(size))
+	}
+	i--
 	dAtA[i] = 0x12
 	if m.CodeID != 0 {
 		i = encodeVarintProposal(dAtA, i, uint64(m.CodeID))
@@ -2232,83 +2840,281 @@ func (m *StoreAndInstantiateContractProposal) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProposal(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *FreezeContractProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
 
-func (m *StoreCodeProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *FreezeContractProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FreezeContractProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
-	}
-	l = len(m.RunAs)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.WASMByteCode)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.InstantiatePermission != nil {
-		l = m.InstantiatePermission.Size()
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.UnpinCode {
-		n += 2
+	return len(dAtA) - i, nil
+}
+
+func (m *UnfreezeContractProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *UnfreezeContractProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnfreezeContractProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Builder)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.CodeHash)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *InstantiateContractProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RegisterCronJobProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterCronJobProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterCronJobProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if m.MaxFailures != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.MaxFailures))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.RunAs)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if m.GasLimit != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovProposal(uint64(l))
+	if m.Interval != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeregisterCronJobProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeregisterCronJobProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeregisterCronJobProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *StoreCodeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.RunAs)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.WASMByteCode)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.InstantiatePermission != nil {
+		l = m.InstantiatePermission.Size()
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.UnpinCode {
+		n += 2
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Builder)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *InstantiateContractProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.RunAs)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
 	}
 	if m.CodeID != 0 {
 		n += 1 + sovProposal(uint64(m.CodeID))
@@ -2513,6 +3319,51 @@ func (m *ClearAdminProposal) Size() (n int) {
 	return n
 }
 
+func (m *DeleteContractProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveCodeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovProposal(uint64(m.CodeID))
+	}
+	return n
+}
+
 func (m *PinCodesProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2660,45 +3511,142 @@ func (m *StoreAndInstantiateContractProposal) Size() (n int) {
 	return n
 }
 
-func sovProposal(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *FreezeContractProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
 }
 
-func sozProposal(x uint64) (n int) {
-	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *UnfreezeContractProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
 }
 
-func (m *StoreCodeProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProposal
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StoreCodeProposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StoreCodeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
+func (m *RegisterCronJobProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovProposal(uint64(m.Interval))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovProposal(uint64(m.GasLimit))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.MaxFailures != 0 {
+		n += 1 + sovProposal(uint64(m.MaxFailures))
+	}
+	return n
+}
+
+func (m *DeregisterCronJobProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func sovProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozProposal(x uint64) (n int) {
+	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *StoreCodeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreCodeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreCodeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
@@ -4607,7 +5555,7 @@ func (m *ClearAdminProposal) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *PinCodesProposal) Unmarshal(dAtA []byte) error {
+func (m *DeleteContractProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4630,10 +5578,10 @@ func (m *PinCodesProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PinCodesProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteContractProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PinCodesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteContractProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4701,81 +5649,69 @@ func (m *PinCodesProposal) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowProposal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
 				}
-				m.CodeIDs = append(m.CodeIDs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowProposal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthProposal
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthProposal
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.CodeIDs) == 0 {
-					m.CodeIDs = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowProposal
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.CodeIDs = append(m.CodeIDs, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeIDs", wireType)
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposal(dAtA[iNdEx:])
@@ -4798,7 +5734,7 @@ func (m *PinCodesProposal) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *UnpinCodesProposal) Unmarshal(dAtA []byte) error {
+func (m *RemoveCodeProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4821,10 +5757,10 @@ func (m *UnpinCodesProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnpinCodesProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveCodeProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnpinCodesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveCodeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4892,43 +5828,177 @@ func (m *UnpinCodesProposal) Unmarshal(dAtA []byte) error {
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowProposal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.CodeIDs = append(m.CodeIDs, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowProposal
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthProposal
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				postIndex := iNdEx + packedLen
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *PinCodesProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PinCodesProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PinCodesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProposal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CodeIDs = append(m.CodeIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProposal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProposal
+				}
+				postIndex := iNdEx + packedLen
 				if postIndex < 0 {
 					return ErrInvalidLengthProposal
 				}
@@ -4989,7 +6059,7 @@ func (m *UnpinCodesProposal) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *AccessConfigUpdate) Unmarshal(dAtA []byte) error {
+func (m *UnpinCodesProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5012,17 +6082,17 @@ func (m *AccessConfigUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccessConfigUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnpinCodesProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessConfigUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnpinCodesProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			m.CodeID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -5032,16 +6102,971 @@ func (m *AccessConfigUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstantiatePermission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProposal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CodeIDs = append(m.CodeIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProposal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProposal
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProposal
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CodeIDs) == 0 {
+					m.CodeIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProposal
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CodeIDs = append(m.CodeIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeIDs", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *AccessConfigUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessConfigUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessConfigUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiatePermission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantiatePermission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *UpdateInstantiateConfigProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateInstantiateConfigProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateInstantiateConfigProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessConfigUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessConfigUpdates = append(m.AccessConfigUpdates, AccessConfigUpdate{})
+			if err := m.AccessConfigUpdates[len(m.AccessConfigUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *StoreAndInstantiateContractProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreAndInstantiateContractProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreAndInstantiateContractProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunAs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunAs = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WASMByteCode", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WASMByteCode = append(m.WASMByteCode[:0], dAtA[iNdEx:postIndex]...)
+			if m.WASMByteCode == nil {
+				m.WASMByteCode = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiatePermission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InstantiatePermission == nil {
+				m.InstantiatePermission = &AccessConfig{}
+			}
+			if err := m.InstantiatePermission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpinCode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnpinCode = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funds = append(m.Funds, types.Coin{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Builder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Builder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *FreezeContractProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreezeContractProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreezeContractProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -5051,24 +7076,23 @@ func (m *AccessConfigUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProposal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InstantiatePermission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5092,7 +7116,7 @@ func (m *AccessConfigUpdate) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *UpdateInstantiateConfigProposal) Unmarshal(dAtA []byte) error {
+func (m *UnfreezeContractProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5115,10 +7139,10 @@ func (m *UpdateInstantiateConfigProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateInstantiateConfigProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnfreezeContractProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateInstantiateConfigProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnfreezeContractProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5187,9 +7211,9 @@ func (m *UpdateInstantiateConfigProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessConfigUpdates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -5199,25 +7223,23 @@ func (m *UpdateInstantiateConfigProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProposal
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccessConfigUpdates = append(m.AccessConfigUpdates, AccessConfigUpdate{})
-			if err := m.AccessConfigUpdates[len(m.AccessConfigUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5241,7 +7263,7 @@ func (m *UpdateInstantiateConfigProposal) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *StoreAndInstantiateContractProposal) Unmarshal(dAtA []byte) error {
+func (m *RegisterCronJobProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5264,10 +7286,10 @@ func (m *StoreAndInstantiateContractProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StoreAndInstantiateContractProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisterCronJobProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StoreAndInstantiateContractProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisterCronJobProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5336,7 +7358,7 @@ func (m *StoreAndInstantiateContractProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunAs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5364,13 +7386,13 @@ func (m *StoreAndInstantiateContractProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunAs = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WASMByteCode", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			var byteLen int
+			m.Interval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -5380,119 +7402,16 @@ func (m *StoreAndInstantiateContractProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Interval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthProposal
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WASMByteCode = append(m.WASMByteCode[:0], dAtA[iNdEx:postIndex]...)
-			if m.WASMByteCode == nil {
-				m.WASMByteCode = []byte{}
-			}
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstantiatePermission", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthProposal
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.InstantiatePermission == nil {
-				m.InstantiatePermission = &AccessConfig{}
-			}
-			if err := m.InstantiatePermission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnpinCode", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.UnpinCode = bool(v != 0)
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProposal
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProposal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
-			var stringLen uint64
+			m.GasLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -5502,25 +7421,12 @@ func (m *StoreAndInstantiateContractProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GasLimit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProposal
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProposal
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Label = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
@@ -5554,11 +7460,11 @@ func (m *StoreAndInstantiateContractProposal) Unmarshal(dAtA []byte) error {
 				m.Msg = []byte{}
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFailures", wireType)
 			}
-			var msglen int
+			m.MaxFailures = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -5568,29 +7474,65 @@ func (m *StoreAndInstantiateContractProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxFailures |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProposal
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthProposal
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Funds = append(m.Funds, types.Coin{})
-			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *DeregisterCronJobProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
 			}
-			iNdEx = postIndex
-		case 11:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeregisterCronJobProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeregisterCronJobProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5618,11 +7560,11 @@ func (m *StoreAndInstantiateContractProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Builder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5650,13 +7592,13 @@ func (m *StoreAndInstantiateContractProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Builder = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProposal
@@ -5666,25 +7608,23 @@ func (m *StoreAndInstantiateContractProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProposal
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProposal
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.CodeHash == nil {
-				m.CodeHash = []byte{}
-			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -782,6 +782,273 @@ func TestValidateClearAdminProposal(t *testing.T) {
 	}
 }
 
+func TestValidateDeleteContractProposal(t *testing.T) {
+	invalidAddress := "invalid address"
+
+	specs := map[string]struct {
+		src    *DeleteContractProposal
+		expErr bool
+	}{
+		"all good": {
+			src: DeleteContractProposalFixture(),
+		},
+		"base data missing": {
+			src: DeleteContractProposalFixture(func(p *DeleteContractProposal) {
+				p.Title = ""
+			}),
+			expErr: true,
+		},
+		"contract missing": {
+			src: DeleteContractProposalFixture(func(p *DeleteContractProposal) {
+				p.Contract = ""
+			}),
+			expErr: true,
+		},
+		"contract invalid": {
+			src: DeleteContractProposalFixture(func(p *DeleteContractProposal) {
+				p.Contract = invalidAddress
+			}),
+			expErr: true,
+		},
+		"recipient missing": {
+			src: DeleteContractProposalFixture(func(p *DeleteContractProposal) {
+				p.Recipient = ""
+			}),
+			expErr: true,
+		},
+		"recipient invalid": {
+			src: DeleteContractProposalFixture(func(p *DeleteContractProposal) {
+				p.Recipient = invalidAddress
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRemoveCodeProposal(t *testing.T) {
+	specs := map[string]struct {
+		src    *RemoveCodeProposal
+		expErr bool
+	}{
+		"all good": {
+			src: RemoveCodeProposalFixture(),
+		},
+		"base data missing": {
+			src: RemoveCodeProposalFixture(func(p *RemoveCodeProposal) {
+				p.Title = ""
+			}),
+			expErr: true,
+		},
+		"code id missing": {
+			src: RemoveCodeProposalFixture(func(p *RemoveCodeProposal) {
+				p.CodeID = 0
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateFreezeContractProposal(t *testing.T) {
+	invalidAddress := "invalid address"
+
+	specs := map[string]struct {
+		src    *FreezeContractProposal
+		expErr bool
+	}{
+		"all good": {
+			src: FreezeContractProposalFixture(),
+		},
+		"base data missing": {
+			src: FreezeContractProposalFixture(func(p *FreezeContractProposal) {
+				p.Title = ""
+			}),
+			expErr: true,
+		},
+		"contract missing": {
+			src: FreezeContractProposalFixture(func(p *FreezeContractProposal) {
+				p.Contract = ""
+			}),
+			expErr: true,
+		},
+		"contract invalid": {
+			src: FreezeContractProposalFixture(func(p *FreezeContractProposal) {
+				p.Contract = invalidAddress
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRegisterCronJobProposal(t *testing.T) {
+	invalidAddress := "invalid address"
+
+	specs := map[string]struct {
+		src    *RegisterCronJobProposal
+		expErr bool
+	}{
+		"all good": {
+			src: RegisterCronJobProposalFixture(),
+		},
+		"without max failures": {
+			src: RegisterCronJobProposalFixture(func(p *RegisterCronJobProposal) {
+				p.MaxFailures = 0
+			}),
+		},
+		"base data missing": {
+			src: RegisterCronJobProposalFixture(func(p *RegisterCronJobProposal) {
+				p.Title = ""
+			}),
+			expErr: true,
+		},
+		"contract invalid": {
+			src: RegisterCronJobProposalFixture(func(p *RegisterCronJobProposal) {
+				p.Contract = invalidAddress
+			}),
+			expErr: true,
+		},
+		"interval missing": {
+			src: RegisterCronJobProposalFixture(func(p *RegisterCronJobProposal) {
+				p.Interval = 0
+			}),
+			expErr: true,
+		},
+		"gas limit missing": {
+			src: RegisterCronJobProposalFixture(func(p *RegisterCronJobProposal) {
+				p.GasLimit = 0
+			}),
+			expErr: true,
+		},
+		"msg missing": {
+			src: RegisterCronJobProposalFixture(func(p *RegisterCronJobProposal) {
+				p.Msg = nil
+			}),
+			expErr: true,
+		},
+		"msg not json": {
+			src: RegisterCronJobProposalFixture(func(p *RegisterCronJobProposal) {
+				p.Msg = []byte("not json")
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateDeregisterCronJobProposal(t *testing.T) {
+	invalidAddress := "invalid address"
+
+	specs := map[string]struct {
+		src    *DeregisterCronJobProposal
+		expErr bool
+	}{
+		"all good": {
+			src: DeregisterCronJobProposalFixture(),
+		},
+		"base data missing": {
+			src: DeregisterCronJobProposalFixture(func(p *DeregisterCronJobProposal) {
+				p.Title = ""
+			}),
+			expErr: true,
+		},
+		"contract invalid": {
+			src: DeregisterCronJobProposalFixture(func(p *DeregisterCronJobProposal) {
+				p.Contract = invalidAddress
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateUnfreezeContractProposal(t *testing.T) {
+	invalidAddress := "invalid address"
+
+	specs := map[string]struct {
+		src    *UnfreezeContractProposal
+		expErr bool
+	}{
+		"all good": {
+			src: UnfreezeContractProposalFixture(),
+		},
+		"base data missing": {
+			src: UnfreezeContractProposalFixture(func(p *UnfreezeContractProposal) {
+				p.Title = ""
+			}),
+			expErr: true,
+		},
+		"contract missing": {
+			src: UnfreezeContractProposalFixture(func(p *UnfreezeContractProposal) {
+				p.Contract = ""
+			}),
+			expErr: true,
+		},
+		"contract invalid": {
+			src: UnfreezeContractProposalFixture(func(p *UnfreezeContractProposal) {
+				p.Contract = invalidAddress
+			}),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestProposalStrings(t *testing.T) {
 	specs := map[string]struct {
 		src govtypes.Content
@@ -867,6 +1134,59 @@ func TestProposalStrings(t *testing.T) {
   Title:       Foo
   Description: Bar
   Contract:    cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr
+`,
+		},
+		"delete contract": {
+			src: DeleteContractProposalFixture(),
+			exp: `Delete Contract Proposal:
+  Title:       Foo
+  Description: Bar
+  Contract:    cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr
+  Recipient:   cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqs2m6sx4
+`,
+		},
+		"remove code": {
+			src: RemoveCodeProposalFixture(),
+			exp: `Remove Code Proposal:
+  Title:       Foo
+  Description: Bar
+  Code ID:     1
+`,
+		},
+		"freeze contract": {
+			src: FreezeContractProposalFixture(),
+			exp: `Freeze Contract Proposal:
+  Title:       Foo
+  Description: Bar
+  Contract:    cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr
+`,
+		},
+		"unfreeze contract": {
+			src: UnfreezeContractProposalFixture(),
+			exp: `Unfreeze Contract Proposal:
+  Title:       Foo
+  Description: Bar
+  Contract:    cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr
+`,
+		},
+		"register cron job": {
+			src: RegisterCronJobProposalFixture(),
+			exp: `Register Cron Job Proposal:
+  Title:        Foo
+  Description:  Bar
+  Contract:     cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr
+  Interval:     10
+  Gas Limit:    100000
+  Msg:          "{\"tick\":{}}"
+  Max Failures: 3
+`,
+		},
+		"deregister cron job": {
+			src: DeregisterCronJobProposalFixture(),
+			exp: `Deregister Cron Job Proposal:
+  Title:       Foo
+  Description: Bar
+  Contract:    cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr
 `,
 		},
 		"pin codes": {
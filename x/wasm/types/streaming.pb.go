@@ -0,0 +1,668 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmwasm/wasm/v1/streaming.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = proto.Marshal
+	_ = fmt.Errorf
+	_ = math.Inf
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ContractStateChangeType the kind of contract data that was changed
+type ContractStateChangeType int32
+
+const (
+	// ContractStateChangeTypeUnspecified placeholder for empty value
+	ContractStateChangeTypeUnspecified ContractStateChangeType = 0
+	// ContractStateChangeTypeStorage an entry in the contract storage
+	ContractStateChangeTypeStorage ContractStateChangeType = 1
+	// ContractStateChangeTypeInfo the contract info
+	ContractStateChangeTypeInfo ContractStateChangeType = 2
+)
+
+var ContractStateChangeType_name = map[int32]string{
+	0: "CONTRACT_STATE_CHANGE_TYPE_UNSPECIFIED",
+	1: "CONTRACT_STATE_CHANGE_TYPE_STORAGE",
+	2: "CONTRACT_STATE_CHANGE_TYPE_INFO",
+}
+
+var ContractStateChangeType_value = map[string]int32{
+	"CONTRACT_STATE_CHANGE_TYPE_UNSPECIFIED": 0,
+	"CONTRACT_STATE_CHANGE_TYPE_STORAGE":     1,
+	"CONTRACT_STATE_CHANGE_TYPE_INFO":        2,
+}
+
+func (x ContractStateChangeType) String() string {
+	return proto.EnumName(ContractStateChangeType_name, int32(x))
+}
+
+func (ContractStateChangeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_96f50a3b8d46cfda, []int{0}
+}
+
+// BlockStage the stage of the block execution in which a change was made
+type BlockStage int32
+
+const (
+	// BlockStageUnspecified placeholder for empty value
+	BlockStageUnspecified BlockStage = 0
+	// BlockStageBeginBlock changes made in the begin blocker. On the first
+	// block this includes the genesis state
+	BlockStageBeginBlock BlockStage = 1
+	// BlockStageDeliverTx changes made by a transaction
+	BlockStageDeliverTx BlockStage = 2
+	// BlockStageEndBlock changes made in the end blocker
+	BlockStageEndBlock BlockStage = 3
+)
+
+var BlockStage_name = map[int32]string{
+	0: "BLOCK_STAGE_UNSPECIFIED",
+	1: "BLOCK_STAGE_BEGIN_BLOCK",
+	2: "BLOCK_STAGE_DELIVER_TX",
+	3: "BLOCK_STAGE_END_BLOCK",
+}
+
+var BlockStage_value = map[string]int32{
+	"BLOCK_STAGE_UNSPECIFIED": 0,
+	"BLOCK_STAGE_BEGIN_BLOCK": 1,
+	"BLOCK_STAGE_DELIVER_TX":  2,
+	"BLOCK_STAGE_END_BLOCK":   3,
+}
+
+func (x BlockStage) String() string {
+	return proto.EnumName(BlockStage_name, int32(x))
+}
+
+func (BlockStage) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_96f50a3b8d46cfda, []int{1}
+}
+
+// ContractStateChange is a single write to the contract storage or contract
+// info that was committed with a block. The changes of a block are emitted in
+// the order they were made
+type ContractStateChange struct {
+	// Height of the block that contains the change
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// ContractAddress bech32 address of the contract
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// Type of the changed contract data
+	Type ContractStateChangeType `protobuf:"varint,3,opt,name=type,proto3,enum=cosmwasm.wasm.v1.ContractStateChangeType" json:"type,omitempty"`
+	// Key in the contract storage. Empty for contract info changes
+	Key []byte `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	// Value is the new raw value. For contract info changes this is the proto
+	// encoded ContractInfo. Empty on delete
+	Value []byte `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	// Delete is true when the entry was removed
+	Delete bool `protobuf:"varint,6,opt,name=delete,proto3" json:"delete,omitempty"`
+	// Stage of the block execution in which the change was made
+	Stage BlockStage `protobuf:"varint,7,opt,name=stage,proto3,enum=cosmwasm.wasm.v1.BlockStage" json:"stage,omitempty"`
+	// TxIndex position of the transaction in the block. Only set for the
+	// deliver tx stage
+	TxIndex uint32 `protobuf:"varint,8,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+}
+
+func (m *ContractStateChange) Reset()         { *m = ContractStateChange{} }
+func (m *ContractStateChange) String() string { return proto.CompactTextString(m) }
+func (*ContractStateChange) ProtoMessage()    {}
+func (*ContractStateChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96f50a3b8d46cfda, []int{0}
+}
+
+func (m *ContractStateChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ContractStateChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractStateChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *ContractStateChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractStateChange.Merge(m, src)
+}
+
+func (m *ContractStateChange) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ContractStateChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractStateChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractStateChange proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("cosmwasm.wasm.v1.ContractStateChangeType", ContractStateChangeType_name, ContractStateChangeType_value)
+	proto.RegisterEnum("cosmwasm.wasm.v1.BlockStage", BlockStage_name, BlockStage_value)
+	proto.RegisterType((*ContractStateChange)(nil), "cosmwasm.wasm.v1.ContractStateChange")
+}
+
+func init() { proto.RegisterFile("cosmwasm/wasm/v1/streaming.proto", fileDescriptor_96f50a3b8d46cfda) }
+
+var fileDescriptor_96f50a3b8d46cfda = []byte{
+	// 567 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xcb, 0x6e, 0x9b, 0x40,
+	0x14, 0x65, 0xec, 0xbc, 0x3a, 0xea, 0x03, 0x4d, 0x5e, 0x84, 0x56, 0x64, 0xe4, 0x45, 0x44, 0xb2,
+	0xb0, 0x95, 0x44, 0xed, 0xae, 0x0b, 0x1b, 0x88, 0x4b, 0x1b, 0xe1, 0x08, 0x48, 0x5f, 0x1b, 0x44,
+	0x60, 0x82, 0x51, 0x6c, 0xb0, 0x60, 0xe2, 0xda, 0x1f, 0x50, 0xa9, 0x62, 0xd5, 0x1f, 0x60, 0x55,
+	0xa9, 0xdf, 0x92, 0x65, 0x96, 0x5d, 0xb6, 0xf6, 0xaa, 0x7f, 0x51, 0x01, 0xb6, 0xec, 0xa6, 0x75,
+	0x36, 0xa3, 0x39, 0x97, 0x73, 0xce, 0xbd, 0x07, 0xcd, 0x85, 0xd8, 0x09, 0xe3, 0xee, 0x27, 0x3b,
+	0xee, 0xd6, 0xf2, 0xa3, 0x7f, 0x58, 0x8b, 0x69, 0x44, 0xec, 0xae, 0x1f, 0x78, 0xd5, 0x5e, 0x14,
+	0xd2, 0x10, 0xb1, 0x53, 0x46, 0x35, 0x3f, 0xfa, 0x87, 0xfc, 0x86, 0x17, 0x7a, 0x61, 0xfe, 0xb1,
+	0x96, 0xdd, 0x0a, 0x5e, 0xe5, 0x7b, 0x09, 0xae, 0x4b, 0x61, 0x40, 0x23, 0xdb, 0xa1, 0x06, 0xb5,
+	0x29, 0x91, 0xda, 0x76, 0xe0, 0x11, 0xb4, 0x05, 0x57, 0xda, 0xc4, 0xf7, 0xda, 0x94, 0x03, 0x18,
+	0x88, 0x65, 0x7d, 0x82, 0xd0, 0x3e, 0x64, 0x9d, 0x09, 0xdd, 0xb2, 0x5d, 0x37, 0x22, 0x71, 0xcc,
+	0x95, 0x30, 0x10, 0x1f, 0xe8, 0x4f, 0xa6, 0xf5, 0x7a, 0x51, 0x46, 0x2f, 0xe1, 0x12, 0x1d, 0xf6,
+	0x08, 0x57, 0xc6, 0x40, 0x7c, 0x7c, 0xb4, 0x5f, 0xbd, 0x3b, 0x51, 0xf5, 0x3f, 0x7d, 0xcd, 0x61,
+	0x8f, 0xe8, 0xb9, 0x0c, 0xb1, 0xb0, 0x7c, 0x45, 0x86, 0xdc, 0x12, 0x06, 0xe2, 0x43, 0x3d, 0xbb,
+	0xa2, 0x0d, 0xb8, 0xdc, 0xb7, 0x3b, 0xd7, 0x84, 0x5b, 0xce, 0x6b, 0x05, 0xc8, 0x26, 0x75, 0x49,
+	0x87, 0x50, 0xc2, 0xad, 0x60, 0x20, 0xae, 0xe9, 0x13, 0x84, 0x8e, 0xe0, 0x72, 0x4c, 0x6d, 0x8f,
+	0x70, 0xab, 0x79, 0xff, 0x67, 0xff, 0xf6, 0x6f, 0x74, 0x42, 0xe7, 0xca, 0xc8, 0x38, 0x7a, 0x41,
+	0x45, 0x3b, 0x70, 0x8d, 0x0e, 0x2c, 0x3f, 0x70, 0xc9, 0x80, 0x5b, 0xc3, 0x40, 0x7c, 0xa4, 0xaf,
+	0xd2, 0x81, 0x9a, 0xc1, 0x83, 0xcf, 0x25, 0xb8, 0xbd, 0x60, 0x60, 0xa4, 0xc3, 0x3d, 0xa9, 0xa5,
+	0x99, 0x7a, 0x5d, 0x32, 0x2d, 0xc3, 0xac, 0x9b, 0x8a, 0x25, 0xbd, 0xaa, 0x6b, 0x4d, 0xc5, 0x32,
+	0x3f, 0x9c, 0x29, 0xd6, 0xb9, 0x66, 0x9c, 0x29, 0x92, 0x7a, 0xa2, 0x2a, 0x32, 0xcb, 0xf0, 0x7b,
+	0x49, 0x8a, 0x2b, 0x0b, 0x8c, 0xce, 0x83, 0xb8, 0x47, 0x1c, 0xff, 0xd2, 0x27, 0x2e, 0x7a, 0x0d,
+	0x2b, 0xf7, 0x78, 0x1a, 0x66, 0x4b, 0xaf, 0x37, 0x15, 0x16, 0xf0, 0x95, 0x24, 0xc5, 0xc2, 0x02,
+	0x3f, 0x83, 0x86, 0x51, 0x16, 0x4b, 0x86, 0xbb, 0xf7, 0x78, 0xa9, 0xda, 0x49, 0x8b, 0x2d, 0xf1,
+	0xbb, 0x49, 0x8a, 0x9f, 0x2e, 0x30, 0x52, 0x83, 0xcb, 0x90, 0x5f, 0xfa, 0xf2, 0x4d, 0x60, 0x0e,
+	0x7e, 0x03, 0x08, 0x67, 0x3f, 0x0e, 0xbd, 0x80, 0xdb, 0x8d, 0xd3, 0x96, 0xf4, 0x26, 0xf3, 0x6d,
+	0xde, 0xcd, 0xba, 0x93, 0xa4, 0x78, 0x73, 0x46, 0x9e, 0x8f, 0xf7, 0xfc, 0x6f, 0x5d, 0x43, 0x69,
+	0xaa, 0x9a, 0x95, 0x57, 0x58, 0xc0, 0x73, 0x49, 0x8a, 0x37, 0x66, 0xba, 0x06, 0xf1, 0xfc, 0x20,
+	0x87, 0xe8, 0x18, 0x6e, 0xcd, 0xcb, 0x64, 0xe5, 0x54, 0x7d, 0xab, 0xe8, 0x96, 0xf9, 0x9e, 0x2d,
+	0xf1, 0xdb, 0x49, 0x8a, 0xd7, 0x67, 0x2a, 0x99, 0x74, 0xfc, 0x3e, 0x89, 0xcc, 0x01, 0x3a, 0x84,
+	0x9b, 0xf3, 0x22, 0x45, 0x93, 0x27, 0x9d, 0xca, 0xfc, 0x56, 0x92, 0x62, 0x34, 0xd3, 0x28, 0x81,
+	0x9b, 0x83, 0x22, 0x6b, 0x43, 0xbe, 0xf9, 0x25, 0x30, 0x37, 0x23, 0x01, 0xdc, 0x8e, 0x04, 0xf0,
+	0x73, 0x24, 0x80, 0xaf, 0x63, 0x81, 0xb9, 0x1d, 0x0b, 0xcc, 0x8f, 0xb1, 0xc0, 0x7c, 0xdc, 0xf3,
+	0x7c, 0xda, 0xbe, 0xbe, 0xa8, 0x3a, 0x61, 0xb7, 0x26, 0x85, 0x71, 0xf7, 0xdd, 0x74, 0x1f, 0xdd,
+	0xda, 0xa0, 0xd8, 0xcb, 0xec, 0x1d, 0xc7, 0x17, 0x2b, 0xf9, 0xa6, 0x1d, 0xff, 0x19, 0x00, 0xc7,
+	0xe9, 0x59, 0x5f, 0xb5, 0x03, 0x00, 0x00,
+}
+
+func (m *ContractStateChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractStateChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractStateChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxIndex != 0 {
+		i = encodeVarintStreaming(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Stage != 0 {
+		i = encodeVarintStreaming(dAtA, i, uint64(m.Stage))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Delete {
+		i--
+		if m.Delete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintStreaming(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintStreaming(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Type != 0 {
+		i = encodeVarintStreaming(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintStreaming(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintStreaming(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStreaming(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStreaming(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *ContractStateChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovStreaming(uint64(m.Height))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovStreaming(uint64(m.Type))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	if m.Delete {
+		n += 2
+	}
+	if m.Stage != 0 {
+		n += 1 + sovStreaming(uint64(m.Stage))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovStreaming(uint64(m.TxIndex))
+	}
+	return n
+}
+
+func sovStreaming(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozStreaming(x uint64) (n int) {
+	return sovStreaming(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *ContractStateChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractStateChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractStateChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ContractStateChangeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delete = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+			}
+			m.Stage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Stage |= BlockStage(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreaming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipStreaming(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStreaming
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStreaming
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStreaming
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStreaming        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStreaming          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStreaming = fmt.Errorf("proto: unexpected end of group")
+)
@@ -8,6 +8,8 @@ import (
 	"math/rand"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
 )
 
 func GenesisFixture(mutators ...func(*GenesisState)) GenesisState {
@@ -429,6 +431,145 @@ func UpdateAdminProposalFixture(mutators ...func(p *UpdateAdminProposal)) *Updat
 	return p
 }
 
+func DeleteContractProposalFixture(mutators ...func(p *DeleteContractProposal)) *DeleteContractProposal {
+	const (
+		contractAddr = "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr"
+		anyAddress   = "cosmos1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqs2m6sx4"
+	)
+	p := &DeleteContractProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Contract:    contractAddr,
+		Recipient:   anyAddress,
+	}
+	for _, m := range mutators {
+		m(p)
+	}
+	return p
+}
+
+func RemoveCodeProposalFixture(mutators ...func(p *RemoveCodeProposal)) *RemoveCodeProposal {
+	p := &RemoveCodeProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		CodeID:      1,
+	}
+	for _, m := range mutators {
+		m(p)
+	}
+	return p
+}
+
+func FreezeContractProposalFixture(mutators ...func(p *FreezeContractProposal)) *FreezeContractProposal {
+	const contractAddr = "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr"
+	p := &FreezeContractProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Contract:    contractAddr,
+	}
+	for _, m := range mutators {
+		m(p)
+	}
+	return p
+}
+
+func UnfreezeContractProposalFixture(mutators ...func(p *UnfreezeContractProposal)) *UnfreezeContractProposal {
+	const contractAddr = "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr"
+	p := &UnfreezeContractProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Contract:    contractAddr,
+	}
+	for _, m := range mutators {
+		m(p)
+	}
+	return p
+}
+
+func RegisterCronJobProposalFixture(mutators ...func(p *RegisterCronJobProposal)) *RegisterCronJobProposal {
+	const contractAddr = "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr"
+	p := &RegisterCronJobProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Contract:    contractAddr,
+		Interval:    10,
+		GasLimit:    100_000,
+		Msg:         []byte(`{"tick":{}}`),
+		MaxFailures: 3,
+	}
+	for _, m := range mutators {
+		m(p)
+	}
+	return p
+}
+
+func DeregisterCronJobProposalFixture(mutators ...func(p *DeregisterCronJobProposal)) *DeregisterCronJobProposal {
+	const contractAddr = "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr"
+	p := &DeregisterCronJobProposal{
+		Title:       "Foo",
+		Description: "Bar",
+		Contract:    contractAddr,
+	}
+	for _, m := range mutators {
+		m(p)
+	}
+	return p
+}
+
+func CronJobFixture(mutators ...func(*CronJob)) CronJob {
+	const contractAddr = "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr"
+	fixture := CronJob{
+		Contract:      contractAddr,
+		Interval:      10,
+		GasLimit:      100_000,
+		Msg:           []byte(`{"tick":{}}`),
+		MaxFailures:   3,
+		NextRunHeight: 20,
+	}
+	for _, m := range mutators {
+		m(&fixture)
+	}
+	return fixture
+}
+
+func CallbackFixture(mutators ...func(*Callback)) Callback {
+	const contractAddr = "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr"
+	fixture := Callback{
+		ID:       1,
+		Contract: contractAddr,
+		Height:   20,
+		GasLimit: 100_000,
+		Msg:      []byte(`{"wake_up":{}}`),
+		Fee:      sdk.NewCoins(sdk.NewInt64Coin("ufoo", 100)),
+	}
+	for _, m := range mutators {
+		m(&fixture)
+	}
+	return fixture
+}
+
+func IBCTransferCallbackFixture(mutators ...func(*IBCTransferCallback)) IBCTransferCallback {
+	const contractAddr = "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr"
+	fixture := IBCTransferCallback{
+		ChannelID: "channel-1",
+		Sequence:  7,
+		Contract:  contractAddr,
+	}
+	for _, m := range mutators {
+		m(&fixture)
+	}
+	return fixture
+}
+
+func AsyncAckPacketFixture(mutators ...func(*channeltypes.Packet)) channeltypes.Packet {
+	const contractAddr = "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr"
+	fixture := channeltypes.NewPacket([]byte(`{"foo":"bar"}`), 7, "transfer", "channel-0", "wasm."+contractAddr, "channel-1", clienttypes.NewHeight(1, 100), 0)
+	for _, m := range mutators {
+		m(&fixture)
+	}
+	return fixture
+}
+
 func ClearAdminProposalFixture(mutators ...func(p *ClearAdminProposal)) *ClearAdminProposal {
 	const contractAddr = "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr"
 	p := &ClearAdminProposal{
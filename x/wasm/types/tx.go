@@ -15,14 +15,21 @@ import (
 // any assumptions made that the data is valid syntax or semantic.
 type RawContractMessage []byte
 
+// MarshalJSON encodes the raw message. An empty message is encoded as `null`.
 func (r RawContractMessage) MarshalJSON() ([]byte, error) {
 	return json.RawMessage(r).MarshalJSON()
 }
 
+// UnmarshalJSON decodes the raw message. `null` is decoded as empty message so that an empty message, like the one
+// of an admin change in the contract history, survives the JSON round trip.
 func (r *RawContractMessage) UnmarshalJSON(b []byte) error {
 	if r == nil {
 		return errors.New("unmarshalJSON on nil pointer")
 	}
+	if bytes.Equal(b, []byte("null")) {
+		*r = nil
+		return nil
+	}
 	*r = append((*r)[0:0], b...)
 	return nil
 }
@@ -74,6 +81,11 @@ func (msg MsgStoreCode) ValidateBasic() error {
 			return ErrInvalid.Wrap("unsupported type, use AccessTypeAnyOfAddresses instead")
 		}
 	}
+	if msg.Source != "" || msg.Builder != "" {
+		if err := ValidateCodeMetadata(msg.Source, msg.Builder); err != nil {
+			return sdkerrors.Wrap(ErrInvalid, err.Error())
+		}
+	}
 	return nil
 }
 
@@ -445,3 +457,225 @@ func (msg MsgUpdateInstantiateConfig) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{senderAddr}
 }
+
+func (msg MsgDeleteContract) Route() string {
+	return RouterKey
+}
+
+func (msg MsgDeleteContract) Type() string {
+	return "delete-contract"
+}
+
+func (msg MsgDeleteContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.Wrap(err, "recipient")
+	}
+	return nil
+}
+
+func (msg MsgDeleteContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgDeleteContract) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgRemoveCode) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRemoveCode) Type() string {
+	return "remove-code"
+}
+
+func (msg MsgRemoveCode) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if msg.CodeID == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "code id")
+	}
+	return nil
+}
+
+func (msg MsgRemoveCode) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRemoveCode) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgFreezeContract) Route() string {
+	return RouterKey
+}
+
+func (msg MsgFreezeContract) Type() string {
+	return "freeze-contract"
+}
+
+func (msg MsgFreezeContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgFreezeContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgFreezeContract) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgUnfreezeContract) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUnfreezeContract) Type() string {
+	return "unfreeze-contract"
+}
+
+func (msg MsgUnfreezeContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgUnfreezeContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUnfreezeContract) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgDisableOperations) Route() string {
+	return RouterKey
+}
+
+func (msg MsgDisableOperations) Type() string {
+	return "disable-operations"
+}
+
+func (msg MsgDisableOperations) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if len(msg.Operations) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "operations")
+	}
+	if err := ValidateOperationTypes(msg.Operations); err != nil {
+		return sdkerrors.Wrap(err, "operations")
+	}
+	return nil
+}
+
+func (msg MsgDisableOperations) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgDisableOperations) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgEnableOperations) Route() string {
+	return RouterKey
+}
+
+func (msg MsgEnableOperations) Type() string {
+	return "enable-operations"
+}
+
+func (msg MsgEnableOperations) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if len(msg.Operations) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "operations")
+	}
+	if err := ValidateOperationTypes(msg.Operations); err != nil {
+		return sdkerrors.Wrap(err, "operations")
+	}
+	return nil
+}
+
+func (msg MsgEnableOperations) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgEnableOperations) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgUpdateCodeMetadata) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUpdateCodeMetadata) Type() string {
+	return "update-code-metadata"
+}
+
+func (msg MsgUpdateCodeMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrap(err, "sender")
+	}
+	if msg.CodeID == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "code id")
+	}
+	if err := ValidateCodeMetadata(msg.Source, msg.Builder); err != nil {
+		return sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	return nil
+}
+
+func (msg MsgUpdateCodeMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateCodeMetadata) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
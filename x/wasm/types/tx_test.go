@@ -53,9 +53,28 @@ func TestStoreCodeValidation(t *testing.T) {
 			msg: MsgStoreCode{
 				Sender:       goodAddress,
 				WASMByteCode: []byte("foo"),
+				Source:       "https://example.com/foo.tar.gz",
+				Builder:      "cosmwasm/rust-optimizer:0.12.9",
 			},
 			valid: true,
 		},
+		"source without builder": {
+			msg: MsgStoreCode{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Source:       "https://example.com/foo.tar.gz",
+			},
+			valid: false,
+		},
+		"invalid builder": {
+			msg: MsgStoreCode{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Source:       "https://example.com/foo.tar.gz",
+				Builder:      "INVALID!",
+			},
+			valid: false,
+		},
 		"invalid InstantiatePermission": {
 			msg: MsgStoreCode{
 				Sender:                goodAddress,
@@ -554,6 +573,419 @@ func TestMsgClearAdministrator(t *testing.T) {
 	}
 }
 
+func TestMsgDeleteContract(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgDeleteContract
+		expErr bool
+	}{
+		"all good": {
+			src: MsgDeleteContract{
+				Sender:    goodAddress,
+				Contract:  anotherGoodAddress,
+				Recipient: goodAddress,
+			},
+		},
+		"bad sender": {
+			src: MsgDeleteContract{
+				Sender:    badAddress,
+				Contract:  anotherGoodAddress,
+				Recipient: goodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgDeleteContract{
+				Sender:    goodAddress,
+				Contract:  badAddress,
+				Recipient: goodAddress,
+			},
+			expErr: true,
+		},
+		"contract missing": {
+			src: MsgDeleteContract{
+				Sender:    goodAddress,
+				Recipient: goodAddress,
+			},
+			expErr: true,
+		},
+		"bad recipient": {
+			src: MsgDeleteContract{
+				Sender:    goodAddress,
+				Contract:  anotherGoodAddress,
+				Recipient: badAddress,
+			},
+			expErr: true,
+		},
+		"recipient missing": {
+			src: MsgDeleteContract{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRemoveCode(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgRemoveCode
+		expErr bool
+	}{
+		"all good": {
+			src: MsgRemoveCode{
+				Sender: goodAddress,
+				CodeID: 1,
+			},
+		},
+		"bad sender": {
+			src: MsgRemoveCode{
+				Sender: badAddress,
+				CodeID: 1,
+			},
+			expErr: true,
+		},
+		"sender missing": {
+			src: MsgRemoveCode{
+				CodeID: 1,
+			},
+			expErr: true,
+		},
+		"code id missing": {
+			src: MsgRemoveCode{
+				Sender: goodAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateCodeMetadata(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	const (
+		mySource  = "https://example.com/foo.tar.gz"
+		myBuilder = "cosmwasm/rust-optimizer:0.12.9"
+	)
+
+	specs := map[string]struct {
+		src    MsgUpdateCodeMetadata
+		expErr bool
+	}{
+		"all good": {
+			src: MsgUpdateCodeMetadata{
+				Sender:  goodAddress,
+				CodeID:  1,
+				Source:  mySource,
+				Builder: myBuilder,
+			},
+		},
+		"bad sender": {
+			src: MsgUpdateCodeMetadata{
+				Sender:  badAddress,
+				CodeID:  1,
+				Source:  mySource,
+				Builder: myBuilder,
+			},
+			expErr: true,
+		},
+		"code id missing": {
+			src: MsgUpdateCodeMetadata{
+				Sender:  goodAddress,
+				Source:  mySource,
+				Builder: myBuilder,
+			},
+			expErr: true,
+		},
+		"source missing": {
+			src: MsgUpdateCodeMetadata{
+				Sender:  goodAddress,
+				CodeID:  1,
+				Builder: myBuilder,
+			},
+			expErr: true,
+		},
+		"invalid source": {
+			src: MsgUpdateCodeMetadata{
+				Sender:  goodAddress,
+				CodeID:  1,
+				Source:  "not a url",
+				Builder: myBuilder,
+			},
+			expErr: true,
+		},
+		"builder missing": {
+			src: MsgUpdateCodeMetadata{
+				Sender: goodAddress,
+				CodeID: 1,
+				Source: mySource,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgFreezeContract(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgFreezeContract
+		expErr bool
+	}{
+		"all good": {
+			src: MsgFreezeContract{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+			},
+		},
+		"bad sender": {
+			src: MsgFreezeContract{
+				Sender:   badAddress,
+				Contract: anotherGoodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgFreezeContract{
+				Sender:   goodAddress,
+				Contract: badAddress,
+			},
+			expErr: true,
+		},
+		"contract missing": {
+			src: MsgFreezeContract{
+				Sender: goodAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUnfreezeContract(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgUnfreezeContract
+		expErr bool
+	}{
+		"all good": {
+			src: MsgUnfreezeContract{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+			},
+		},
+		"bad sender": {
+			src: MsgUnfreezeContract{
+				Sender:   badAddress,
+				Contract: anotherGoodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgUnfreezeContract{
+				Sender:   goodAddress,
+				Contract: badAddress,
+			},
+			expErr: true,
+		},
+		"contract missing": {
+			src: MsgUnfreezeContract{
+				Sender: goodAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgDisableOperations(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgDisableOperations
+		expErr bool
+	}{
+		"all good": {
+			src: MsgDisableOperations{
+				Sender:     goodAddress,
+				Operations: []OperationType{OperationTypeExecute, OperationTypeIBCSend},
+			},
+		},
+		"bad sender": {
+			src: MsgDisableOperations{
+				Sender:     badAddress,
+				Operations: []OperationType{OperationTypeExecute},
+			},
+			expErr: true,
+		},
+		"operations missing": {
+			src: MsgDisableOperations{
+				Sender: goodAddress,
+			},
+			expErr: true,
+		},
+		"unspecified operation": {
+			src: MsgDisableOperations{
+				Sender:     goodAddress,
+				Operations: []OperationType{OperationTypeUnspecified},
+			},
+			expErr: true,
+		},
+		"unknown operation": {
+			src: MsgDisableOperations{
+				Sender:     goodAddress,
+				Operations: []OperationType{OperationType(999)},
+			},
+			expErr: true,
+		},
+		"duplicate operations": {
+			src: MsgDisableOperations{
+				Sender:     goodAddress,
+				Operations: []OperationType{OperationTypeExecute, OperationTypeExecute},
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgEnableOperations(t *testing.T) {
+	bad, err := sdk.AccAddressFromHex("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgEnableOperations
+		expErr bool
+	}{
+		"all good": {
+			src: MsgEnableOperations{
+				Sender:     goodAddress,
+				Operations: []OperationType{OperationTypeStoreCode},
+			},
+		},
+		"bad sender": {
+			src: MsgEnableOperations{
+				Sender:     badAddress,
+				Operations: []OperationType{OperationTypeStoreCode},
+			},
+			expErr: true,
+		},
+		"operations missing": {
+			src: MsgEnableOperations{
+				Sender: goodAddress,
+			},
+			expErr: true,
+		},
+		"duplicate operations": {
+			src: MsgEnableOperations{
+				Sender:     goodAddress,
+				Operations: []OperationType{OperationTypeStoreCode, OperationTypeStoreCode},
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestMsgMigrateContract(t *testing.T) {
 	bad, err := sdk.AccAddressFromHex("012345")
 	require.NoError(t, err)
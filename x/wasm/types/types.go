@@ -3,11 +3,13 @@ package types
 import (
 	"fmt"
 	"reflect"
+	"strings"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
 	"github.com/gogo/protobuf/proto"
 )
 
@@ -39,6 +41,11 @@ func (c CodeInfo) ValidateBasic() error {
 	if err := c.InstantiateConfig.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "instantiate config")
 	}
+	if c.Source != "" || c.Builder != "" {
+		if err := ValidateCodeMetadata(c.Source, c.Builder); err != nil {
+			return sdkerrors.Wrap(ErrInvalid, err.Error())
+		}
+	}
 	return nil
 }
 
@@ -51,7 +58,27 @@ func NewCodeInfo(codeHash []byte, creator sdk.AccAddress, instantiatePermission
 	}
 }
 
-var AllCodeHistoryTypes = []ContractCodeHistoryOperationType{ContractCodeHistoryOperationTypeGenesis, ContractCodeHistoryOperationTypeInit, ContractCodeHistoryOperationTypeMigrate}
+var AllCodeHistoryTypes = []ContractCodeHistoryOperationType{ContractCodeHistoryOperationTypeGenesis, ContractCodeHistoryOperationTypeInit, ContractCodeHistoryOperationTypeMigrate, ContractCodeHistoryOperationTypeAdminUpdate, ContractCodeHistoryOperationTypeAdminClear}
+
+// IsCodeUpdate returns true for operations that set the code of the contract and false for admin changes
+func (t ContractCodeHistoryOperationType) IsCodeUpdate() bool {
+	return t != ContractCodeHistoryOperationTypeAdminUpdate && t != ContractCodeHistoryOperationTypeAdminClear
+}
+
+// ParseContractCodeHistoryOperationType parses a history operation type from its proto name or the short form without
+// the `CONTRACT_CODE_HISTORY_OPERATION_TYPE_` prefix, case insensitive. For example `migrate` or `admin_update`.
+func ParseContractCodeHistoryOperationType(s string) (ContractCodeHistoryOperationType, error) {
+	const prefix = "CONTRACT_CODE_HISTORY_OPERATION_TYPE_"
+	name := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(s), "-", "_"))
+	if !strings.HasPrefix(name, prefix) {
+		name = prefix + name
+	}
+	v, ok := ContractCodeHistoryOperationType_value[name]
+	if !ok || ContractCodeHistoryOperationType(v) == ContractCodeHistoryOperationTypeUnspecified {
+		return ContractCodeHistoryOperationTypeUnspecified, sdkerrors.Wrapf(ErrInvalid, "unknown history operation type: %q", s)
+	}
+	return ContractCodeHistoryOperationType(v), nil
+}
 
 // NewContractInfo creates a new instance of a given WASM contract info
 func NewContractInfo(codeID uint64, creator, admin sdk.AccAddress, label string, createdAt *AbsoluteTxPosition) ContractInfo {
@@ -157,6 +184,7 @@ func (c ContractInfo) InitialHistory(initMsg []byte) ContractCodeHistoryEntry {
 		CodeID:    c.CodeID,
 		Updated:   c.Created,
 		Msg:       initMsg,
+		Admin:     c.Admin,
 	}
 }
 
@@ -166,11 +194,28 @@ func (c *ContractInfo) AddMigration(ctx sdk.Context, codeID uint64, msg []byte)
 		CodeID:    codeID,
 		Updated:   NewAbsoluteTxPosition(ctx),
 		Msg:       msg,
+		Admin:     c.Admin,
 	}
 	c.CodeID = codeID
 	return h
 }
 
+// SetAdmin updates the admin and returns the history entry for the change. An empty admin clears it.
+func (c *ContractInfo) SetAdmin(ctx sdk.Context, admin sdk.AccAddress) ContractCodeHistoryEntry {
+	c.Admin = ""
+	operation := ContractCodeHistoryOperationTypeAdminClear
+	if !admin.Empty() {
+		c.Admin = admin.String()
+		operation = ContractCodeHistoryOperationTypeAdminUpdate
+	}
+	return ContractCodeHistoryEntry{
+		Operation: operation,
+		CodeID:    c.CodeID,
+		Updated:   NewAbsoluteTxPosition(ctx),
+		Admin:     c.Admin,
+	}
+}
+
 // AdminAddr convert into sdk.AccAddress or nil when not set
 func (c *ContractInfo) AdminAddr() sdk.AccAddress {
 	if c.Admin == "" {
@@ -261,6 +306,22 @@ func (c ContractCodeHistoryEntry) ValidateBasic() error {
 	if c.Updated == nil {
 		return ErrEmpty.Wrap("updated")
 	}
+	if c.Sender != "" {
+		if _, err := sdk.AccAddressFromBech32(c.Sender); err != nil {
+			return sdkerrors.Wrap(err, "sender")
+		}
+	}
+	if c.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(c.Admin); err != nil {
+			return sdkerrors.Wrap(err, "admin")
+		}
+	}
+	if !c.Operation.IsCodeUpdate() {
+		if len(c.Msg) != 0 {
+			return ErrInvalid.Wrap("msg not allowed for admin changes")
+		}
+		return nil
+	}
 	return sdkerrors.Wrap(c.Msg.ValidateBasic(), "msg")
 }
 
@@ -322,6 +383,14 @@ type WasmConfig struct {
 	MemoryCacheSize uint32
 	// ContractDebugMode log what contract print
 	ContractDebugMode bool
+	// EmitTypedEvents emits proto typed events for wasm operations in addition to the legacy events
+	EmitTypedEvents bool
+	// StateStreamingFile is the file to stream the contract state changes of each block to.
+	// Relative paths are resolved against the node home dir. Streaming is disabled when empty
+	StateStreamingFile string
+	// MaxContractEventSubscriptions is the max number of concurrent gRPC subscriptions to contract events.
+	// Subscriptions are disabled when 0
+	MaxContractEventSubscriptions uint32
 }
 
 // DefaultWasmConfig returns the default settings for WasmConfig
@@ -407,3 +476,105 @@ func (a AccessConfig) AllAuthorizedAddresses() []string {
 	}
 	return []string{}
 }
+
+// AllOperationTypes contains the operations that can be disabled by the circuit breaker
+var AllOperationTypes = []OperationType{
+	OperationTypeStoreCode,
+	OperationTypeInstantiate,
+	OperationTypeExecute,
+	OperationTypeMigrate,
+	OperationTypeIBCSend,
+}
+
+// ValidateBasic checks that the operation type is known
+func (o OperationType) ValidateBasic() error {
+	for _, v := range AllOperationTypes {
+		if v == o {
+			return nil
+		}
+	}
+	return sdkerrors.Wrapf(ErrInvalid, "unknown operation type: %d", o)
+}
+
+// ValidateOperationTypes checks that the given operation types are known and not duplicated
+func ValidateOperationTypes(ops []OperationType) error {
+	unique := make(map[OperationType]struct{}, len(ops))
+	for _, o := range ops {
+		if err := o.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, exists := unique[o]; exists {
+			return sdkerrors.Wrapf(ErrDuplicate, "operation type: %s", o)
+		}
+		unique[o] = struct{}{}
+	}
+	return nil
+}
+
+// ParseOperationType parses an operation type from its proto name or the short form without the
+// `OPERATION_TYPE_` prefix, case insensitive. For example `execute` or `ibc_send`.
+func ParseOperationType(s string) (OperationType, error) {
+	name := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(s), "-", "_"))
+	if !strings.HasPrefix(name, "OPERATION_TYPE_") {
+		name = "OPERATION_TYPE_" + name
+	}
+	v, ok := OperationType_value[name]
+	if !ok || OperationType(v) == OperationTypeUnspecified {
+		return OperationTypeUnspecified, sdkerrors.Wrapf(ErrInvalid, "unknown operation type: %q", s)
+	}
+	return OperationType(v), nil
+}
+
+// ValidateBasic syntax checks
+func (c CronJob) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(c.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if c.Interval == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "interval")
+	}
+	if c.GasLimit == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "gas limit")
+	}
+	if err := c.Msg.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "payload msg")
+	}
+	return nil
+}
+
+// ValidateBasic syntax checks
+func (c Callback) ValidateBasic() error {
+	if c.ID == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "id")
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	if c.Height <= 0 {
+		return sdkerrors.Wrap(ErrInvalid, "height")
+	}
+	if c.GasLimit == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "gas limit")
+	}
+	if err := c.Msg.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "payload msg")
+	}
+	if !c.Fee.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "fee")
+	}
+	return nil
+}
+
+// ValidateBasic syntax checks
+func (c IBCTransferCallback) ValidateBasic() error {
+	if err := host.ChannelIdentifierValidator(c.ChannelID); err != nil {
+		return sdkerrors.Wrap(err, "channel id")
+	}
+	if c.Sequence == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "sequence")
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Contract); err != nil {
+		return sdkerrors.Wrap(err, "contract")
+	}
+	return nil
+}
@@ -12,6 +12,8 @@ import (
 
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_tendermint_tendermint_libs_bytes "github.com/tendermint/tendermint/libs/bytes"
@@ -67,6 +69,84 @@ func (AccessType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e6155d98fa173e02, []int{0}
 }
 
+// OperationType wasm module operations that can be disabled by the circuit
+// breaker
+type OperationType int32
+
+const (
+	// OperationTypeUnspecified placeholder for empty value
+	OperationTypeUnspecified OperationType = 0
+	// OperationTypeStoreCode uploading wasm code
+	OperationTypeStoreCode OperationType = 1
+	// OperationTypeInstantiate contract instantiation
+	OperationTypeInstantiate OperationType = 2
+	// OperationTypeExecute contract execution
+	OperationTypeExecute OperationType = 3
+	// OperationTypeMigrate contract migration
+	OperationTypeMigrate OperationType = 4
+	// OperationTypeIBCSend IBC packets sent by contracts
+	OperationTypeIBCSend OperationType = 5
+)
+
+var OperationType_name = map[int32]string{
+	0: "OPERATION_TYPE_UNSPECIFIED",
+	1: "OPERATION_TYPE_STORE_CODE",
+	2: "OPERATION_TYPE_INSTANTIATE",
+	3: "OPERATION_TYPE_EXECUTE",
+	4: "OPERATION_TYPE_MIGRATE",
+	5: "OPERATION_TYPE_IBC_SEND",
+}
+
+var OperationType_value = map[string]int32{
+	"OPERATION_TYPE_UNSPECIFIED": 0,
+	"OPERATION_TYPE_STORE_CODE":  1,
+	"OPERATION_TYPE_INSTANTIATE": 2,
+	"OPERATION_TYPE_EXECUTE":     3,
+	"OPERATION_TYPE_MIGRATE":     4,
+	"OPERATION_TYPE_IBC_SEND":    5,
+}
+
+func (x OperationType) String() string {
+	return proto.EnumName(OperationType_name, int32(x))
+}
+
+func (OperationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{1}
+}
+
+// LabelIndexScope scope of the index of contracts by label
+type LabelIndexScope int32
+
+const (
+	// LabelIndexScopeNone contracts are not indexed by label
+	LabelIndexScopeNone LabelIndexScope = 0
+	// LabelIndexScopeCreator contracts are indexed by label per creator
+	LabelIndexScopeCreator LabelIndexScope = 1
+	// LabelIndexScopeGlobal contracts are indexed by label per creator and
+	// across all creators
+	LabelIndexScopeGlobal LabelIndexScope = 2
+)
+
+var LabelIndexScope_name = map[int32]string{
+	0: "LABEL_INDEX_SCOPE_NONE",
+	1: "LABEL_INDEX_SCOPE_CREATOR",
+	2: "LABEL_INDEX_SCOPE_GLOBAL",
+}
+
+var LabelIndexScope_value = map[string]int32{
+	"LABEL_INDEX_SCOPE_NONE":    0,
+	"LABEL_INDEX_SCOPE_CREATOR": 1,
+	"LABEL_INDEX_SCOPE_GLOBAL":  2,
+}
+
+func (x LabelIndexScope) String() string {
+	return proto.EnumName(LabelIndexScope_name, int32(x))
+}
+
+func (LabelIndexScope) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{2}
+}
+
 // ContractCodeHistoryOperationType actions that caused a code change
 type ContractCodeHistoryOperationType int32
 
@@ -79,6 +159,10 @@ const (
 	ContractCodeHistoryOperationTypeMigrate ContractCodeHistoryOperationType = 2
 	// ContractCodeHistoryOperationTypeGenesis based on genesis data
 	ContractCodeHistoryOperationTypeGenesis ContractCodeHistoryOperationType = 3
+	// ContractCodeHistoryOperationTypeAdminUpdate contract admin set or changed
+	ContractCodeHistoryOperationTypeAdminUpdate ContractCodeHistoryOperationType = 4
+	// ContractCodeHistoryOperationTypeAdminClear contract admin removed
+	ContractCodeHistoryOperationTypeAdminClear ContractCodeHistoryOperationType = 5
 )
 
 var ContractCodeHistoryOperationType_name = map[int32]string{
@@ -86,13 +170,17 @@ var ContractCodeHistoryOperationType_name = map[int32]string{
 	1: "CONTRACT_CODE_HISTORY_OPERATION_TYPE_INIT",
 	2: "CONTRACT_CODE_HISTORY_OPERATION_TYPE_MIGRATE",
 	3: "CONTRACT_CODE_HISTORY_OPERATION_TYPE_GENESIS",
+	4: "CONTRACT_CODE_HISTORY_OPERATION_TYPE_ADMIN_UPDATE",
+	5: "CONTRACT_CODE_HISTORY_OPERATION_TYPE_ADMIN_CLEAR",
 }
 
 var ContractCodeHistoryOperationType_value = map[string]int32{
-	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_UNSPECIFIED": 0,
-	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_INIT":        1,
-	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_MIGRATE":     2,
-	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_GENESIS":     3,
+	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_UNSPECIFIED":  0,
+	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_INIT":         1,
+	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_MIGRATE":      2,
+	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_GENESIS":      3,
+	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_ADMIN_UPDATE": 4,
+	"CONTRACT_CODE_HISTORY_OPERATION_TYPE_ADMIN_CLEAR":  5,
 }
 
 func (x ContractCodeHistoryOperationType) String() string {
@@ -100,7 +188,7 @@ func (x ContractCodeHistoryOperationType) String() string {
 }
 
 func (ContractCodeHistoryOperationType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{1}
+	return fileDescriptor_e6155d98fa173e02, []int{3}
 }
 
 // AccessTypeParam
@@ -197,6 +285,45 @@ var xxx_messageInfo_AccessConfig proto.InternalMessageInfo
 type Params struct {
 	CodeUploadAccess             AccessConfig `protobuf:"bytes,1,opt,name=code_upload_access,json=codeUploadAccess,proto3" json:"code_upload_access" yaml:"code_upload_access"`
 	InstantiateDefaultPermission AccessType   `protobuf:"varint,2,opt,name=instantiate_default_permission,json=instantiateDefaultPermission,proto3,enum=cosmwasm.wasm.v1.AccessType" json:"instantiate_default_permission,omitempty" yaml:"instantiate_default_permission"`
+	// MaxContractStorageBytes is the maximum number of key and value bytes a
+	// single contract can store. Zero means unlimited.
+	MaxContractStorageBytes uint64 `protobuf:"varint,3,opt,name=max_contract_storage_bytes,json=maxContractStorageBytes,proto3" json:"max_contract_storage_bytes,omitempty" yaml:"max_contract_storage_bytes"`
+	// StorageDepositDenom is the denom of the deposit locked for contract
+	// storage. Storage deposits are disabled when empty.
+	StorageDepositDenom string `protobuf:"bytes,4,opt,name=storage_deposit_denom,json=storageDepositDenom,proto3" json:"storage_deposit_denom,omitempty" yaml:"storage_deposit_denom"`
+	// StorageDepositPrice is the amount of the storage deposit denom to lock per
+	// stored byte. Storage deposits are disabled when zero.
+	StorageDepositPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=storage_deposit_price,json=storageDepositPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"storage_deposit_price" yaml:"storage_deposit_price"`
+	// EmergencyAuthority is an optional address that can freeze and unfreeze
+	// contracts in addition to governance and disable module operations.
+	EmergencyAuthority string `protobuf:"bytes,6,opt,name=emergency_authority,json=emergencyAuthority,proto3" json:"emergency_authority,omitempty" yaml:"emergency_authority"`
+	// CronBlockGasLimit is the total gas that can be consumed by cron jobs in a
+	// single block. Cron jobs are not executed when zero.
+	CronBlockGasLimit uint64 `protobuf:"varint,7,opt,name=cron_block_gas_limit,json=cronBlockGasLimit,proto3" json:"cron_block_gas_limit,omitempty" yaml:"cron_block_gas_limit"`
+	// MaxCallbacksPerBlock is the maximum number of contract callbacks executed
+	// in a single block. Callbacks are neither scheduled nor executed when zero.
+	MaxCallbacksPerBlock uint32 `protobuf:"varint,8,opt,name=max_callbacks_per_block,json=maxCallbacksPerBlock,proto3" json:"max_callbacks_per_block,omitempty" yaml:"max_callbacks_per_block"`
+	// MaxCallbackGasLimit is the maximum gas limit of a single callback.
+	// Callbacks can not be scheduled when zero.
+	MaxCallbackGasLimit uint64 `protobuf:"varint,9,opt,name=max_callback_gas_limit,json=maxCallbackGasLimit,proto3" json:"max_callback_gas_limit,omitempty" yaml:"max_callback_gas_limit"`
+	// CallbackFeeDenom is the denom of the fee prepaid for callbacks. Callbacks
+	// are free when empty.
+	CallbackFeeDenom string `protobuf:"bytes,10,opt,name=callback_fee_denom,json=callbackFeeDenom,proto3" json:"callback_fee_denom,omitempty" yaml:"callback_fee_denom"`
+	// CallbackGasPrice is the amount of the callback fee denom to prepay per
+	// unit of callback gas limit. Callbacks are free when zero.
+	CallbackGasPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=callback_gas_price,json=callbackGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"callback_gas_price" yaml:"callback_gas_price"`
+	// EnforceCodeReuse makes every upload return the code id of an existing code
+	// with the same checksum and instantiate permission instead of storing a
+	// duplicate.
+	EnforceCodeReuse bool `protobuf:"varint,12,opt,name=enforce_code_reuse,json=enforceCodeReuse,proto3" json:"enforce_code_reuse,omitempty" yaml:"enforce_code_reuse"`
+	// EnforceUniqueLabels rejects the instantiation of a contract with a label
+	// that is already used by another contract of the same creator. Contracts
+	// are indexed by label per creator when enabled.
+	EnforceUniqueLabels bool `protobuf:"varint,13,opt,name=enforce_unique_labels,json=enforceUniqueLabels,proto3" json:"enforce_unique_labels,omitempty" yaml:"enforce_unique_labels"`
+	// LabelIndexScope is the scope of the index of contracts by label that
+	// serves the ContractsByLabel query. Contracts are not indexed when none.
+	// The index is rebuilt at the beginning of the next block when changed.
+	LabelIndexScope LabelIndexScope `protobuf:"varint,14,opt,name=label_index_scope,json=labelIndexScope,proto3,enum=cosmwasm.wasm.v1.LabelIndexScope" json:"label_index_scope,omitempty" yaml:"label_index_scope"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -244,6 +371,17 @@ type CodeInfo struct {
 	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
 	// InstantiateConfig access control to apply on contract creation, optional
 	InstantiateConfig AccessConfig `protobuf:"bytes,5,opt,name=instantiate_config,json=instantiateConfig,proto3" json:"instantiate_config"`
+	// Source is the URL where the code is hosted, optional
+	Source string `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+	// Builder is the docker image used to build the code deterministically,
+	// optional
+	Builder string `protobuf:"bytes,7,opt,name=builder,proto3" json:"builder,omitempty"`
+	// Created Tx position when the code was stored, not set for codes stored
+	// before it was tracked
+	Created *AbsoluteTxPosition `protobuf:"bytes,8,opt,name=created,proto3" json:"created,omitempty"`
+	// WasmSize is the length of the uncompressed wasm byte code, zero when not
+	// tracked
+	WasmSize uint64 `protobuf:"varint,9,opt,name=wasm_size,json=wasmSize,proto3" json:"wasm_size,omitempty"`
 }
 
 func (m *CodeInfo) Reset()         { *m = CodeInfo{} }
@@ -300,6 +438,9 @@ type ContractInfo struct {
 	// Extension is an extension point to store custom metadata within the
 	// persistence model.
 	Extension *types.Any `protobuf:"bytes,7,opt,name=extension,proto3" json:"extension,omitempty"`
+	// IsFrozen is set when the contract was paused and rejects executions,
+	// migrations and IBC packets.
+	IsFrozen bool `protobuf:"varint,8,opt,name=is_frozen,json=isFrozen,proto3" json:"is_frozen,omitempty"`
 }
 
 func (m *ContractInfo) Reset()         { *m = ContractInfo{} }
@@ -348,6 +489,13 @@ type ContractCodeHistoryEntry struct {
 	// Updated Tx position when the operation was executed.
 	Updated *AbsoluteTxPosition `protobuf:"bytes,3,opt,name=updated,proto3" json:"updated,omitempty"`
 	Msg     RawContractMessage  `protobuf:"bytes,4,opt,name=msg,proto3,casttype=RawContractMessage" json:"msg,omitempty"`
+	// Sender is the actor that executed the operation. This is the gov module
+	// account for governance operations. Empty for genesis entries and entries
+	// created before it was recorded.
+	Sender string `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Admin is the contract admin after the operation. Empty when the contract
+	// has no admin.
+	Admin string `protobuf:"bytes,6,opt,name=admin,proto3" json:"admin,omitempty"`
 }
 
 func (m *ContractCodeHistoryEntry) Reset()         { *m = ContractCodeHistoryEntry{} }
@@ -436,6 +584,216 @@ func (m *AbsoluteTxPosition) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AbsoluteTxPosition proto.InternalMessageInfo
 
+// ContractStorageStats is the accounted size of a contract's state
+type ContractStorageStats struct {
+	// Bytes is the sum of all key and value lengths
+	Bytes uint64 `protobuf:"varint,1,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	// Entries is the number of stored keys
+	Entries uint64 `protobuf:"varint,2,opt,name=entries,proto3" json:"entries,omitempty"`
+	// Deposit is the amount locked for the stored bytes
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+}
+
+func (m *ContractStorageStats) Reset()         { *m = ContractStorageStats{} }
+func (m *ContractStorageStats) String() string { return proto.CompactTextString(m) }
+func (*ContractStorageStats) ProtoMessage()    {}
+func (*ContractStorageStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{7}
+}
+
+func (m *ContractStorageStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ContractStorageStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractStorageStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *ContractStorageStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractStorageStats.Merge(m, src)
+}
+
+func (m *ContractStorageStats) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ContractStorageStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractStorageStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractStorageStats proto.InternalMessageInfo
+
+// CronJob is a contract registered by governance to receive a sudo message
+// every N blocks from the end blocker
+type CronJob struct {
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Interval is the number of blocks between two executions
+	Interval uint64 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// GasLimit is the max gas that can be consumed by a single execution
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// Msg json encoded message to be passed to the contract as sudo
+	Msg RawContractMessage `protobuf:"bytes,4,opt,name=msg,proto3,casttype=RawContractMessage" json:"msg,omitempty"`
+	// MaxFailures is the number of consecutive failed executions after which the
+	// job is deregistered. Failed executions are skipped only when zero.
+	MaxFailures uint32 `protobuf:"varint,5,opt,name=max_failures,json=maxFailures,proto3" json:"max_failures,omitempty"`
+	// Failures is the number of consecutive failed executions
+	Failures uint32 `protobuf:"varint,6,opt,name=failures,proto3" json:"failures,omitempty"`
+	// NextRunHeight is the block height of the next execution
+	NextRunHeight int64 `protobuf:"varint,7,opt,name=next_run_height,json=nextRunHeight,proto3" json:"next_run_height,omitempty"`
+}
+
+func (m *CronJob) Reset()         { *m = CronJob{} }
+func (m *CronJob) String() string { return proto.CompactTextString(m) }
+func (*CronJob) ProtoMessage()    {}
+func (*CronJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{8}
+}
+
+func (m *CronJob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *CronJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CronJob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *CronJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronJob.Merge(m, src)
+}
+
+func (m *CronJob) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *CronJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CronJob proto.InternalMessageInfo
+
+// Callback is a one-shot sudo call to a contract scheduled by the contract
+// itself
+type Callback struct {
+	// ID is the unique identifier of the callback
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Height is the block height at which the callback is executed
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// GasLimit is the max gas that can be consumed by the execution
+	GasLimit uint64 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// Msg json encoded message to be passed to the contract as sudo
+	Msg RawContractMessage `protobuf:"bytes,5,opt,name=msg,proto3,casttype=RawContractMessage" json:"msg,omitempty"`
+	// Fee is the amount prepaid by the contract for the gas limit
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+}
+
+func (m *Callback) Reset()         { *m = Callback{} }
+func (m *Callback) String() string { return proto.CompactTextString(m) }
+func (*Callback) ProtoMessage()    {}
+func (*Callback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{9}
+}
+
+func (m *Callback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *Callback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Callback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *Callback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Callback.Merge(m, src)
+}
+
+func (m *Callback) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *Callback) XXX_DiscardUnknown() {
+	xxx_messageInfo_Callback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Callback proto.InternalMessageInfo
+
+// IBCTransferCallback is a contract that is called back when the ICS-20
+// packet that it sent is acknowledged or timed out
+type IBCTransferCallback struct {
+	// ChannelID is the source channel of the packet
+	ChannelID string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// Sequence of the packet on the source channel
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Contract is the address of the smart contract that sent the packet
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *IBCTransferCallback) Reset()         { *m = IBCTransferCallback{} }
+func (m *IBCTransferCallback) String() string { return proto.CompactTextString(m) }
+func (*IBCTransferCallback) ProtoMessage()    {}
+func (*IBCTransferCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{10}
+}
+
+func (m *IBCTransferCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *IBCTransferCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IBCTransferCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *IBCTransferCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCTransferCallback.Merge(m, src)
+}
+
+func (m *IBCTransferCallback) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *IBCTransferCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCTransferCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IBCTransferCallback proto.InternalMessageInfo
+
 // Model is a struct that holds a KV pair
 type Model struct {
 	// hex-encode key to read it better (this is often ascii)
@@ -448,7 +806,7 @@ func (m *Model) Reset()         { *m = Model{} }
 func (m *Model) String() string { return proto.CompactTextString(m) }
 func (*Model) ProtoMessage()    {}
 func (*Model) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{7}
+	return fileDescriptor_e6155d98fa173e02, []int{11}
 }
 
 func (m *Model) XXX_Unmarshal(b []byte) error {
@@ -484,6 +842,8 @@ var xxx_messageInfo_Model proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterEnum("cosmwasm.wasm.v1.AccessType", AccessType_name, AccessType_value)
+	proto.RegisterEnum("cosmwasm.wasm.v1.OperationType", OperationType_name, OperationType_value)
+	proto.RegisterEnum("cosmwasm.wasm.v1.LabelIndexScope", LabelIndexScope_name, LabelIndexScope_value)
 	proto.RegisterEnum("cosmwasm.wasm.v1.ContractCodeHistoryOperationType", ContractCodeHistoryOperationType_name, ContractCodeHistoryOperationType_value)
 	proto.RegisterType((*AccessTypeParam)(nil), "cosmwasm.wasm.v1.AccessTypeParam")
 	proto.RegisterType((*AccessConfig)(nil), "cosmwasm.wasm.v1.AccessConfig")
@@ -492,87 +852,157 @@ func init() {
 	proto.RegisterType((*ContractInfo)(nil), "cosmwasm.wasm.v1.ContractInfo")
 	proto.RegisterType((*ContractCodeHistoryEntry)(nil), "cosmwasm.wasm.v1.ContractCodeHistoryEntry")
 	proto.RegisterType((*AbsoluteTxPosition)(nil), "cosmwasm.wasm.v1.AbsoluteTxPosition")
+	proto.RegisterType((*ContractStorageStats)(nil), "cosmwasm.wasm.v1.ContractStorageStats")
+	proto.RegisterType((*CronJob)(nil), "cosmwasm.wasm.v1.CronJob")
+	proto.RegisterType((*Callback)(nil), "cosmwasm.wasm.v1.Callback")
+	proto.RegisterType((*IBCTransferCallback)(nil), "cosmwasm.wasm.v1.IBCTransferCallback")
 	proto.RegisterType((*Model)(nil), "cosmwasm.wasm.v1.Model")
 }
 
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/types.proto", fileDescriptor_e6155d98fa173e02) }
 
 var fileDescriptor_e6155d98fa173e02 = []byte{
-	// 1182 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcf, 0x8f, 0xdb, 0xc4,
-	0x17, 0x8f, 0x93, 0xec, 0x8f, 0x4c, 0xf7, 0xdb, 0xaf, 0x3b, 0x6c, 0x69, 0x36, 0xac, 0x92, 0xd4,
-	0x94, 0xb2, 0xfd, 0x95, 0xd0, 0x05, 0x01, 0xea, 0xa1, 0x52, 0x7e, 0xb8, 0x5d, 0x57, 0x6c, 0x1c,
-	0x4d, 0x52, 0xaa, 0x45, 0x2a, 0x96, 0x63, 0xcf, 0x66, 0xad, 0x3a, 0x9e, 0xc8, 0x33, 0xd9, 0xc6,
-	0xff, 0x01, 0x8a, 0x84, 0xe0, 0xc8, 0x25, 0x12, 0x02, 0x84, 0xca, 0x9d, 0x3f, 0xa2, 0x02, 0x09,
-	0xf5, 0xc8, 0x29, 0x82, 0xed, 0x85, 0x73, 0x8e, 0xe5, 0x82, 0x3c, 0x13, 0x37, 0xa6, 0xed, 0x76,
-	0xc3, 0xc5, 0x9a, 0x79, 0xef, 0x7d, 0x3e, 0xef, 0xbd, 0xcf, 0xcc, 0x1b, 0x19, 0x6c, 0x5a, 0x84,
-	0xf6, 0x1e, 0x9a, 0xb4, 0x57, 0xe6, 0x9f, 0xc3, 0xeb, 0x65, 0x16, 0xf4, 0x31, 0x2d, 0xf5, 0x7d,
-	0xc2, 0x08, 0x94, 0x23, 0x6f, 0x89, 0x7f, 0x0e, 0xaf, 0xe7, 0x36, 0x42, 0x0b, 0xa1, 0x06, 0xf7,
-	0x97, 0xc5, 0x46, 0x04, 0xe7, 0xd6, 0xbb, 0xa4, 0x4b, 0x84, 0x3d, 0x5c, 0xcd, 0xac, 0x1b, 0x5d,
-	0x42, 0xba, 0x2e, 0x2e, 0xf3, 0x5d, 0x67, 0xb0, 0x5f, 0x36, 0xbd, 0x40, 0xb8, 0x94, 0xfb, 0xe0,
-	0xff, 0x15, 0xcb, 0xc2, 0x94, 0xb6, 0x83, 0x3e, 0x6e, 0x9a, 0xbe, 0xd9, 0x83, 0x75, 0xb0, 0x74,
-	0x68, 0xba, 0x03, 0x9c, 0x95, 0x8a, 0xd2, 0xd6, 0xe9, 0xed, 0xcd, 0xd2, 0x8b, 0x05, 0x94, 0xe6,
-	0x88, 0xaa, 0x3c, 0x9d, 0x14, 0xd6, 0x02, 0xb3, 0xe7, 0xde, 0x50, 0x38, 0x48, 0x41, 0x02, 0x7c,
-	0x23, 0xfd, 0xcd, 0xb7, 0x05, 0x49, 0xf9, 0x55, 0x02, 0x6b, 0x22, 0xba, 0x46, 0xbc, 0x7d, 0xa7,
-	0x0b, 0x5b, 0x00, 0xf4, 0xb1, 0xdf, 0x73, 0x28, 0x75, 0x88, 0xb7, 0x50, 0x86, 0xb3, 0xd3, 0x49,
-	0xe1, 0x8c, 0xc8, 0x30, 0x47, 0x2a, 0x28, 0x46, 0x03, 0xaf, 0x82, 0x15, 0xd3, 0xb6, 0x7d, 0x4c,
-	0x69, 0x36, 0x59, 0x94, 0xb6, 0x32, 0x55, 0x38, 0x9d, 0x14, 0x4e, 0x0b, 0xcc, 0xcc, 0xa1, 0xa0,
-	0x28, 0x04, 0x6e, 0x83, 0xcc, 0x6c, 0x89, 0x69, 0x36, 0x55, 0x4c, 0x6d, 0x65, 0xaa, 0xeb, 0xd3,
-	0x49, 0x41, 0xfe, 0x57, 0x3c, 0xa6, 0x0a, 0x9a, 0x87, 0xcd, 0xba, 0xf9, 0x2a, 0x09, 0x96, 0xb9,
-	0x46, 0x14, 0x12, 0x00, 0x2d, 0x62, 0x63, 0x63, 0xd0, 0x77, 0x89, 0x69, 0x1b, 0x26, 0xaf, 0x97,
-	0xf7, 0x73, 0x6a, 0x3b, 0x7f, 0x5c, 0x3f, 0x42, 0x83, 0xea, 0xf9, 0xc7, 0x93, 0x42, 0x62, 0x3a,
-	0x29, 0x6c, 0x88, 0x8c, 0x2f, 0xf3, 0x28, 0x48, 0x0e, 0x8d, 0x77, 0xb9, 0x4d, 0x40, 0xe1, 0x97,
-	0x12, 0xc8, 0x3b, 0x1e, 0x65, 0xa6, 0xc7, 0x1c, 0x93, 0x61, 0xc3, 0xc6, 0xfb, 0xe6, 0xc0, 0x65,
-	0x46, 0x4c, 0xcd, 0xe4, 0x02, 0x6a, 0x5e, 0x9a, 0x4e, 0x0a, 0xef, 0x88, 0xbc, 0xaf, 0x67, 0x53,
-	0xd0, 0x66, 0x2c, 0xa0, 0x2e, 0xfc, 0xcd, 0xe7, 0x6e, 0xae, 0x48, 0x42, 0xf9, 0x4e, 0x02, 0xab,
-	0x35, 0x62, 0x63, 0xcd, 0xdb, 0x27, 0xf0, 0x2d, 0x90, 0xe1, 0xbd, 0x1c, 0x98, 0xf4, 0x80, 0x4b,
-	0xb1, 0x86, 0x56, 0x43, 0xc3, 0x8e, 0x49, 0x0f, 0x60, 0x16, 0xac, 0x58, 0x3e, 0x36, 0x19, 0xf1,
-	0xc5, 0x19, 0xa1, 0x68, 0x0b, 0x5b, 0x00, 0xc6, 0x4b, 0xb1, 0xb8, 0x48, 0xd9, 0xa5, 0x85, 0xa4,
-	0x4c, 0x87, 0x52, 0xa2, 0x33, 0x31, 0xbc, 0x70, 0xdc, 0x49, 0xaf, 0xa6, 0xe4, 0xf4, 0x9d, 0xf4,
-	0x6a, 0x5a, 0x5e, 0x52, 0x7e, 0x4b, 0x82, 0xb5, 0x1a, 0xf1, 0x98, 0x6f, 0x5a, 0x8c, 0x17, 0xfa,
-	0x36, 0x58, 0xe1, 0x85, 0x3a, 0x36, 0x2f, 0x33, 0x5d, 0x05, 0x47, 0x93, 0xc2, 0x32, 0xef, 0xa3,
-	0x8e, 0x96, 0x43, 0x97, 0x66, 0xbf, 0xa6, 0xe0, 0x75, 0xb0, 0x64, 0xda, 0x3d, 0xc7, 0xcb, 0xa6,
-	0xb8, 0x5d, 0x6c, 0x42, 0xab, 0x6b, 0x76, 0xb0, 0x9b, 0x4d, 0x0b, 0x2b, 0xdf, 0xc0, 0x9b, 0x33,
-	0x16, 0x6c, 0xcf, 0x3a, 0xba, 0xf0, 0x8a, 0x8e, 0x3a, 0x94, 0xb8, 0x03, 0x86, 0xdb, 0xc3, 0x26,
-	0xa1, 0x0e, 0x73, 0x88, 0x87, 0x22, 0x10, 0xbc, 0x06, 0x4e, 0x39, 0x1d, 0xcb, 0xe8, 0x13, 0x9f,
-	0x85, 0xe5, 0x2e, 0xf3, 0xeb, 0xfd, 0xbf, 0xa3, 0x49, 0x21, 0xa3, 0x55, 0x6b, 0x4d, 0xe2, 0x33,
-	0xad, 0x8e, 0x32, 0x4e, 0xc7, 0xe2, 0x4b, 0x1b, 0x7e, 0x0e, 0x32, 0x78, 0xc8, 0xb0, 0xc7, 0xef,
-	0xc3, 0x0a, 0x4f, 0xb8, 0x5e, 0x12, 0xd3, 0x5f, 0x8a, 0xa6, 0xbf, 0x54, 0xf1, 0x82, 0xea, 0xe5,
-	0x5f, 0x7e, 0xbe, 0x76, 0xf1, 0xa5, 0x4a, 0xe2, 0x2a, 0xa9, 0x11, 0x0f, 0x9a, 0x53, 0xde, 0x48,
-	0xff, 0x15, 0xce, 0xc1, 0xdf, 0x12, 0xc8, 0x46, 0xa1, 0xa1, 0x6a, 0x3b, 0x0e, 0x65, 0xc4, 0x0f,
-	0x54, 0x8f, 0xf9, 0x01, 0x6c, 0x82, 0x0c, 0xe9, 0x63, 0xdf, 0x64, 0xf3, 0x01, 0xdf, 0x2e, 0x1d,
-	0x9b, 0x29, 0x06, 0xd7, 0x23, 0x54, 0x78, 0x51, 0xd1, 0x9c, 0x24, 0x7e, 0x5c, 0xc9, 0x63, 0x8f,
-	0xeb, 0x26, 0x58, 0x19, 0xf4, 0x6d, 0x2e, 0x74, 0xea, 0xbf, 0x08, 0x3d, 0x03, 0xc1, 0x2d, 0x90,
-	0xea, 0xd1, 0x2e, 0x3f, 0xbc, 0xb5, 0xea, 0x9b, 0xcf, 0x26, 0x05, 0x88, 0xcc, 0x87, 0x51, 0x95,
-	0xbb, 0x98, 0x52, 0xb3, 0x8b, 0x51, 0x18, 0xa2, 0x20, 0x00, 0x5f, 0x26, 0x82, 0xe7, 0xc1, 0x5a,
-	0xc7, 0x25, 0xd6, 0x03, 0xe3, 0x00, 0x3b, 0xdd, 0x03, 0x26, 0x2e, 0x16, 0x3a, 0xc5, 0x6d, 0x3b,
-	0xdc, 0x04, 0x37, 0xc0, 0x2a, 0x1b, 0x1a, 0x8e, 0x67, 0xe3, 0xa1, 0x68, 0x04, 0xad, 0xb0, 0xa1,
-	0x16, 0x6e, 0x15, 0x07, 0x2c, 0xed, 0x12, 0x1b, 0xbb, 0xf0, 0x0e, 0x48, 0x3d, 0xc0, 0x81, 0x98,
-	0x9e, 0xea, 0xc7, 0xcf, 0x26, 0x85, 0x0f, 0xba, 0x0e, 0x3b, 0x18, 0x74, 0x4a, 0x16, 0xe9, 0x95,
-	0x19, 0xf6, 0xec, 0x70, 0x02, 0x3d, 0x16, 0x5f, 0xba, 0x4e, 0x87, 0x96, 0x3b, 0x01, 0xc3, 0xb4,
-	0xb4, 0x83, 0x87, 0xd5, 0x70, 0x81, 0x42, 0x92, 0xf0, 0x46, 0x8a, 0x87, 0x3c, 0xc9, 0x67, 0x51,
-	0x6c, 0x2e, 0xff, 0x94, 0x04, 0x60, 0xfe, 0x20, 0xc0, 0x0f, 0xc1, 0xb9, 0x4a, 0xad, 0xa6, 0xb6,
-	0x5a, 0x46, 0x7b, 0xaf, 0xa9, 0x1a, 0x77, 0x1b, 0xad, 0xa6, 0x5a, 0xd3, 0x6e, 0x69, 0x6a, 0x5d,
-	0x4e, 0xe4, 0x36, 0x46, 0xe3, 0xe2, 0xd9, 0x79, 0xf0, 0x5d, 0x8f, 0xf6, 0xb1, 0xe5, 0xec, 0x3b,
-	0xd8, 0x86, 0x57, 0x01, 0x8c, 0xe3, 0x1a, 0x7a, 0x55, 0xaf, 0xef, 0xc9, 0x52, 0x6e, 0x7d, 0x34,
-	0x2e, 0xca, 0x73, 0x48, 0x83, 0x74, 0x88, 0x1d, 0xc0, 0x8f, 0x40, 0x36, 0x1e, 0xad, 0x37, 0x3e,
-	0xd9, 0x33, 0x2a, 0xf5, 0x3a, 0x52, 0x5b, 0x2d, 0x39, 0xf9, 0x62, 0x1a, 0xdd, 0x73, 0x83, 0xca,
-	0xf3, 0xc7, 0xfa, 0x6c, 0x1c, 0xa8, 0x7e, 0xaa, 0xa2, 0x3d, 0x9e, 0x29, 0x95, 0x3b, 0x37, 0x1a,
-	0x17, 0xdf, 0x98, 0xa3, 0xd4, 0x43, 0xec, 0x07, 0x3c, 0xd9, 0x4d, 0xb0, 0x19, 0xc7, 0x54, 0x1a,
-	0x7b, 0x86, 0x7e, 0x2b, 0x4a, 0xa7, 0xb6, 0xe4, 0x74, 0x6e, 0x73, 0x34, 0x2e, 0x66, 0xe7, 0xd0,
-	0x8a, 0x17, 0xe8, 0xfb, 0x95, 0xe8, 0xb1, 0xcf, 0xad, 0x7e, 0xf1, 0x7d, 0x3e, 0xf1, 0xe8, 0x87,
-	0x7c, 0xe2, 0xf2, 0x8f, 0x29, 0x50, 0x3c, 0xe9, 0xa6, 0x42, 0x0c, 0xde, 0xab, 0xe9, 0x8d, 0x36,
-	0xaa, 0xd4, 0xda, 0x46, 0x4d, 0xaf, 0xab, 0xc6, 0x8e, 0xd6, 0x6a, 0xeb, 0x68, 0xcf, 0xd0, 0x9b,
-	0x2a, 0xaa, 0xb4, 0x35, 0xbd, 0xf1, 0x2a, 0x69, 0xcb, 0xa3, 0x71, 0xf1, 0xca, 0x49, 0xdc, 0x71,
-	0xc1, 0xef, 0x81, 0x4b, 0x0b, 0xa5, 0xd1, 0x1a, 0x5a, 0x5b, 0x96, 0x72, 0x5b, 0xa3, 0x71, 0xf1,
-	0xc2, 0x49, 0xfc, 0x9a, 0xe7, 0x30, 0x78, 0x1f, 0x5c, 0x5d, 0x88, 0x78, 0x57, 0xbb, 0x8d, 0x2a,
-	0x6d, 0x55, 0x4e, 0xe6, 0xae, 0x8c, 0xc6, 0xc5, 0x77, 0x4f, 0xe2, 0xde, 0x75, 0xba, 0xbe, 0xc9,
-	0xf0, 0xc2, 0xf4, 0xb7, 0xd5, 0x86, 0xda, 0xd2, 0x5a, 0x72, 0x6a, 0x31, 0xfa, 0xdb, 0xd8, 0xc3,
-	0xd4, 0xa1, 0xb9, 0x74, 0x78, 0x58, 0xd5, 0x9d, 0xc7, 0x7f, 0xe6, 0x13, 0x8f, 0x8e, 0xf2, 0xd2,
-	0xe3, 0xa3, 0xbc, 0xf4, 0xe4, 0x28, 0x2f, 0xfd, 0x71, 0x94, 0x97, 0xbe, 0x7e, 0x9a, 0x4f, 0x3c,
-	0x79, 0x9a, 0x4f, 0xfc, 0xfe, 0x34, 0x9f, 0xf8, 0xec, 0x62, 0x6c, 0x8e, 0x6a, 0x84, 0xf6, 0xee,
-	0x45, 0xff, 0x5b, 0x76, 0x79, 0x28, 0xfe, 0xbb, 0xf8, 0x4f, 0x57, 0x67, 0x99, 0x3f, 0x93, 0xef,
-	0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0x7b, 0xae, 0x51, 0x21, 0x95, 0x09, 0x00, 0x00,
+	// 2238 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0x17, 0x3f, 0x24, 0x91, 0x23, 0x29, 0xa6, 0x47, 0xb2, 0x4c, 0x31, 0x0a, 0x97, 0xda, 0x26,
+	0xa9, 0xe2, 0xd8, 0x94, 0xad, 0xa4, 0x4d, 0x6b, 0x14, 0x06, 0xc8, 0xe5, 0x5a, 0x5a, 0x5b, 0x26,
+	0x85, 0x21, 0x95, 0x44, 0x05, 0xdc, 0xc5, 0x72, 0x77, 0x48, 0x2d, 0xbc, 0xdc, 0x65, 0x76, 0x96,
+	0x0a, 0xe9, 0xde, 0x7a, 0x2a, 0x04, 0x14, 0xe8, 0xb1, 0x17, 0x01, 0x05, 0xda, 0x43, 0x52, 0xa0,
+	0x87, 0x02, 0xfd, 0x03, 0x7a, 0x34, 0xda, 0x4b, 0x8e, 0x45, 0x0f, 0x6c, 0x2b, 0x5f, 0x0a, 0xf4,
+	0x10, 0x80, 0xe8, 0x29, 0xc8, 0xa1, 0x98, 0x99, 0x5d, 0x71, 0xf9, 0x61, 0x9b, 0x2e, 0x7a, 0xb1,
+	0xf8, 0x3e, 0x7e, 0xef, 0xbd, 0x79, 0xef, 0xcd, 0x9b, 0xb7, 0x06, 0x9b, 0xba, 0x43, 0x5a, 0x9f,
+	0x6b, 0xa4, 0xb5, 0xc3, 0xfe, 0x39, 0xbd, 0xb3, 0xe3, 0xf5, 0xda, 0x98, 0xe4, 0xdb, 0xae, 0xe3,
+	0x39, 0x30, 0x15, 0x48, 0xf3, 0xec, 0x9f, 0xd3, 0x3b, 0x99, 0x0d, 0xca, 0x71, 0x88, 0xca, 0xe4,
+	0x3b, 0x9c, 0xe0, 0xca, 0x99, 0x2c, 0xa7, 0x76, 0xea, 0x1a, 0xc1, 0x3b, 0xa7, 0x77, 0xea, 0xd8,
+	0xd3, 0xee, 0xec, 0xe8, 0x8e, 0x69, 0xfb, 0xf2, 0xb5, 0xa6, 0xd3, 0x74, 0x38, 0x8e, 0xfe, 0xf2,
+	0xb9, 0x1b, 0x4d, 0xc7, 0x69, 0x5a, 0x78, 0x87, 0x51, 0xf5, 0x4e, 0x63, 0x47, 0xb3, 0x7b, 0x5c,
+	0x24, 0x3e, 0x06, 0x57, 0x0a, 0xba, 0x8e, 0x09, 0xa9, 0xf5, 0xda, 0xf8, 0x50, 0x73, 0xb5, 0x16,
+	0x2c, 0x81, 0xf9, 0x53, 0xcd, 0xea, 0xe0, 0x74, 0x24, 0x17, 0xd9, 0x7e, 0x63, 0x77, 0x33, 0x3f,
+	0x1e, 0x60, 0x7e, 0x88, 0x28, 0xa6, 0x06, 0x7d, 0x61, 0xb9, 0xa7, 0xb5, 0xac, 0xbb, 0x22, 0x03,
+	0x89, 0x88, 0x83, 0xef, 0xc6, 0x7f, 0xf5, 0x6b, 0x21, 0x22, 0xfe, 0x25, 0x02, 0x96, 0xb9, 0xb6,
+	0xe4, 0xd8, 0x0d, 0xb3, 0x09, 0xab, 0x00, 0xb4, 0xb1, 0xdb, 0x32, 0x09, 0x31, 0x1d, 0x7b, 0x26,
+	0x0f, 0xd7, 0x06, 0x7d, 0xe1, 0x2a, 0xf7, 0x30, 0x44, 0x8a, 0x28, 0x64, 0x06, 0xde, 0x04, 0x8b,
+	0x9a, 0x61, 0xb8, 0x98, 0x90, 0x74, 0x34, 0x17, 0xd9, 0x4e, 0x16, 0xe1, 0xa0, 0x2f, 0xbc, 0xc1,
+	0x31, 0xbe, 0x40, 0x44, 0x81, 0x0a, 0xdc, 0x05, 0x49, 0xff, 0x27, 0x26, 0xe9, 0x58, 0x2e, 0xb6,
+	0x9d, 0x2c, 0xae, 0x0d, 0xfa, 0x42, 0x6a, 0x44, 0x1f, 0x13, 0x11, 0x0d, 0xd5, 0xfc, 0xd3, 0x7c,
+	0x0b, 0xc0, 0x02, 0xcb, 0x11, 0x81, 0x0e, 0x80, 0xba, 0x63, 0x60, 0xb5, 0xd3, 0xb6, 0x1c, 0xcd,
+	0x50, 0x35, 0x16, 0x2f, 0x3b, 0xcf, 0xd2, 0x6e, 0xf6, 0x45, 0xe7, 0xe1, 0x39, 0x28, 0x6e, 0x3d,
+	0xeb, 0x0b, 0x73, 0x83, 0xbe, 0xb0, 0xc1, 0x3d, 0x4e, 0xda, 0x11, 0x51, 0x8a, 0x32, 0x8f, 0x18,
+	0x8f, 0x43, 0xe1, 0x2f, 0x22, 0x20, 0x6b, 0xda, 0xc4, 0xd3, 0x6c, 0xcf, 0xd4, 0x3c, 0xac, 0x1a,
+	0xb8, 0xa1, 0x75, 0x2c, 0x4f, 0x0d, 0x65, 0x33, 0x3a, 0x43, 0x36, 0xdf, 0x1b, 0xf4, 0x85, 0x77,
+	0xb8, 0xdf, 0x97, 0x5b, 0x13, 0xd1, 0x66, 0x48, 0xa1, 0xc4, 0xe5, 0x87, 0xc3, 0x9c, 0xd7, 0x41,
+	0xa6, 0xa5, 0x75, 0x55, 0xdd, 0xb1, 0x3d, 0x57, 0xd3, 0x3d, 0x95, 0x78, 0x8e, 0xab, 0x35, 0xb1,
+	0x5a, 0xef, 0x79, 0x2c, 0xad, 0x91, 0xed, 0x78, 0xf1, 0x9d, 0x41, 0x5f, 0xd8, 0xe2, 0xce, 0x5e,
+	0xac, 0x2b, 0xa2, 0xeb, 0x2d, 0xad, 0x2b, 0xf9, 0xb2, 0x2a, 0x17, 0x15, 0xa9, 0x04, 0xd6, 0xc0,
+	0xb5, 0x40, 0xd5, 0xc0, 0x6d, 0x87, 0x98, 0x9e, 0x6a, 0x60, 0xdb, 0x69, 0xa5, 0xe3, 0xac, 0xca,
+	0xb9, 0x41, 0x5f, 0xd8, 0xe4, 0xe6, 0xa7, 0xaa, 0x89, 0x68, 0xd5, 0xe7, 0x97, 0x38, 0xbb, 0x44,
+	0xb9, 0xf0, 0x67, 0x91, 0x49, 0xb3, 0x6d, 0xd7, 0xd4, 0x71, 0x7a, 0x9e, 0x99, 0x2d, 0xd3, 0xf2,
+	0xfc, 0xad, 0x2f, 0xbc, 0xdb, 0x34, 0xbd, 0x93, 0x4e, 0x3d, 0xaf, 0x3b, 0x2d, 0xff, 0x12, 0xfa,
+	0x7f, 0x6e, 0x11, 0xe3, 0x89, 0x7f, 0x85, 0x4b, 0x58, 0x7f, 0x71, 0x10, 0xcc, 0xe8, 0x44, 0x10,
+	0x87, 0x94, 0x0b, 0x2b, 0x60, 0x15, 0xb7, 0xb0, 0xdb, 0xc4, 0xb6, 0xde, 0x53, 0xb5, 0x8e, 0x77,
+	0xe2, 0xb8, 0xa6, 0xd7, 0x4b, 0x2f, 0xb0, 0x08, 0xb2, 0x83, 0xbe, 0x90, 0xe1, 0x36, 0xa7, 0x28,
+	0x89, 0x08, 0x5e, 0x72, 0x0b, 0x01, 0x13, 0x1e, 0x82, 0x35, 0xdd, 0x75, 0x6c, 0xb5, 0x6e, 0x39,
+	0xfa, 0x13, 0xb5, 0xa9, 0x11, 0xd5, 0x32, 0x5b, 0xa6, 0x97, 0x5e, 0x64, 0x95, 0x10, 0x06, 0x7d,
+	0xe1, 0x4d, 0xbf, 0xdd, 0xa6, 0x68, 0x89, 0xe8, 0x2a, 0x65, 0x17, 0x29, 0x77, 0x4f, 0x23, 0x07,
+	0x94, 0x07, 0x8f, 0xc1, 0x75, 0x56, 0x35, 0xcd, 0xb2, 0xea, 0x9a, 0xfe, 0x84, 0xd0, 0xe6, 0xe0,
+	0xc0, 0x74, 0x22, 0x17, 0xd9, 0x5e, 0x29, 0x8a, 0x83, 0xbe, 0x90, 0x0d, 0x95, 0x77, 0x52, 0x51,
+	0x44, 0x6b, 0xb4, 0xb6, 0x81, 0xe0, 0x10, 0xbb, 0xcc, 0x05, 0xfc, 0x18, 0xac, 0x87, 0x11, 0xa1,
+	0x70, 0x93, 0x2c, 0xdc, 0xad, 0x41, 0x5f, 0x78, 0x6b, 0xd2, 0x72, 0x38, 0xe0, 0xd5, 0x90, 0xe1,
+	0xcb, 0x90, 0x1f, 0x02, 0x78, 0xa9, 0xdb, 0xc0, 0xd8, 0xef, 0x16, 0xc0, 0x92, 0xfa, 0x56, 0xe8,
+	0xc6, 0x4d, 0xe8, 0xd0, 0x1b, 0xe7, 0x33, 0xef, 0x63, 0xcc, 0xfb, 0xa4, 0x07, 0xe0, 0x88, 0x63,
+	0xde, 0x23, 0x4b, 0xcc, 0xd8, 0xc3, 0xd7, 0xee, 0x91, 0x71, 0xd7, 0x97, 0x16, 0x43, 0xae, 0xf7,
+	0x34, 0xc2, 0xbb, 0xe3, 0x21, 0x80, 0xd8, 0x6e, 0x38, 0xae, 0x8e, 0x55, 0x36, 0x1d, 0x5c, 0xdc,
+	0x21, 0x38, 0xbd, 0x9c, 0x8b, 0x6c, 0x27, 0xc2, 0xe7, 0x98, 0xd4, 0x11, 0x51, 0xca, 0x67, 0x4a,
+	0x8e, 0x81, 0x11, 0x65, 0xd1, 0x5b, 0x14, 0x28, 0x76, 0x6c, 0xf3, 0xb3, 0x0e, 0x56, 0x2d, 0xad,
+	0x8e, 0x2d, 0x92, 0x5e, 0x61, 0xf6, 0x42, 0xb7, 0x68, 0xaa, 0x9a, 0x88, 0x56, 0x7d, 0xfe, 0x11,
+	0x63, 0x1f, 0x30, 0x2e, 0x7c, 0x02, 0xae, 0x32, 0xb9, 0x6a, 0xda, 0x06, 0xee, 0xaa, 0x44, 0x77,
+	0xda, 0x38, 0xfd, 0x06, 0x9b, 0x40, 0x5b, 0x93, 0x13, 0x88, 0x81, 0x14, 0xaa, 0x59, 0xa5, 0x8a,
+	0xc5, 0xcd, 0x41, 0x5f, 0x48, 0x73, 0xa7, 0x13, 0x56, 0x44, 0x74, 0xc5, 0x1a, 0x55, 0x67, 0xe3,
+	0x77, 0x4e, 0xfc, 0x43, 0x14, 0x24, 0xe8, 0xb1, 0x14, 0xbb, 0xe1, 0xc0, 0x37, 0x41, 0x92, 0x1d,
+	0xfb, 0x44, 0x23, 0x27, 0x6c, 0xee, 0x2e, 0xa3, 0x04, 0x65, 0xec, 0x6b, 0xe4, 0x04, 0xa6, 0xc1,
+	0xa2, 0xee, 0x62, 0xcd, 0x73, 0x5c, 0xfe, 0x20, 0xa0, 0x80, 0x84, 0x55, 0x00, 0xc3, 0x73, 0x4f,
+	0x67, 0x13, 0x39, 0x3d, 0x3f, 0xd3, 0xdc, 0x8e, 0xd3, 0xa2, 0xa3, 0xab, 0x21, 0x3c, 0x17, 0xc0,
+	0x75, 0xb0, 0x40, 0x9c, 0x8e, 0xab, 0x63, 0x7e, 0x7f, 0x91, 0x4f, 0xd1, 0x30, 0xea, 0x1d, 0xd3,
+	0x32, 0xb0, 0xcb, 0xae, 0x61, 0x12, 0x05, 0x24, 0xbc, 0xe7, 0x07, 0x88, 0x0d, 0x76, 0x97, 0x96,
+	0x76, 0xdf, 0x9e, 0xe2, 0xbb, 0x4e, 0x1c, 0xab, 0xe3, 0xe1, 0x5a, 0xf7, 0x90, 0xce, 0x0d, 0xd3,
+	0xb1, 0x51, 0x00, 0xa2, 0xa7, 0xa7, 0x6a, 0x2a, 0x31, 0x9f, 0x62, 0x7e, 0x67, 0x50, 0x82, 0x32,
+	0xaa, 0xe6, 0x53, 0xfc, 0x20, 0x9e, 0x88, 0xa5, 0xe2, 0x0f, 0xe2, 0x89, 0x78, 0x6a, 0x5e, 0xfc,
+	0x77, 0x14, 0x2c, 0x07, 0xb3, 0x95, 0xe5, 0xed, 0x3b, 0x60, 0x91, 0xe5, 0xcd, 0x34, 0x58, 0xd6,
+	0xe2, 0x45, 0x70, 0xd1, 0x17, 0x16, 0x58, 0x5a, 0x4b, 0x68, 0x81, 0x8a, 0x14, 0xe3, 0x25, 0xf9,
+	0x5b, 0x03, 0xf3, 0x9a, 0xd1, 0x32, 0x6d, 0x36, 0xe1, 0x93, 0x88, 0x13, 0x94, 0xcb, 0x4a, 0xc6,
+	0x07, 0x33, 0xe2, 0x44, 0xf8, 0x90, 0xf3, 0xff, 0xcb, 0x21, 0x6f, 0x81, 0x25, 0xb3, 0xae, 0xab,
+	0x6d, 0xc7, 0xf5, 0x68, 0xb8, 0x7c, 0x36, 0xae, 0x5c, 0xf4, 0x85, 0xa4, 0x52, 0x94, 0x0e, 0x1d,
+	0xd7, 0x53, 0x4a, 0x28, 0x69, 0xd6, 0x75, 0xf6, 0xd3, 0x80, 0x3f, 0x01, 0x49, 0xdc, 0xf5, 0xb0,
+	0xcd, 0xde, 0xc2, 0x45, 0xe6, 0x70, 0x2d, 0xcf, 0x37, 0x9f, 0x7c, 0xb0, 0xf9, 0xe4, 0x0b, 0x76,
+	0xaf, 0x78, 0xe3, 0xcf, 0x7f, 0xbc, 0xf5, 0xee, 0x44, 0x24, 0xe1, 0x2c, 0xc9, 0x81, 0x1d, 0x34,
+	0x34, 0x49, 0x73, 0x6e, 0x12, 0xb5, 0xe1, 0x3a, 0x4f, 0xb1, 0xcd, 0xaa, 0x96, 0x40, 0x09, 0x93,
+	0xdc, 0x67, 0xf4, 0xdd, 0xf8, 0xbf, 0xe8, 0x82, 0xf0, 0x65, 0x14, 0xa4, 0x03, 0x3b, 0x34, 0xa5,
+	0xfb, 0x26, 0x9d, 0xfd, 0x3d, 0xd9, 0xf6, 0x5c, 0x3a, 0xa1, 0x93, 0x4e, 0x1b, 0xbb, 0x9a, 0x37,
+	0xdc, 0x7c, 0x76, 0xf3, 0x2f, 0x0c, 0x23, 0x04, 0xaf, 0x04, 0x28, 0xfa, 0x82, 0xa3, 0xa1, 0x91,
+	0x70, 0x2d, 0xa3, 0x2f, 0xac, 0xe5, 0x3d, 0xb0, 0xd8, 0x69, 0x1b, 0xac, 0x0a, 0xb1, 0xd7, 0xa9,
+	0x82, 0x0f, 0x82, 0xdb, 0x20, 0xd6, 0x22, 0x4d, 0x56, 0xd9, 0xe5, 0xe2, 0xfa, 0x37, 0x7d, 0x01,
+	0x22, 0xed, 0xf3, 0x20, 0xca, 0x47, 0x98, 0x10, 0xad, 0x89, 0x11, 0x55, 0x61, 0xd7, 0x00, 0xdb,
+	0xb4, 0xdb, 0xe7, 0xfd, 0x6b, 0xc0, 0xa8, 0x61, 0xcf, 0x2c, 0x84, 0x7a, 0x46, 0x44, 0x00, 0x4e,
+	0xba, 0x85, 0x5b, 0x60, 0x99, 0xbf, 0x4d, 0x27, 0xd8, 0x6c, 0x9e, 0x78, 0xbc, 0x47, 0xd1, 0x12,
+	0xe3, 0xed, 0x33, 0x16, 0xdc, 0x00, 0x09, 0xaf, 0xcb, 0x07, 0x06, 0x3f, 0x36, 0x5a, 0xf4, 0xba,
+	0x6c, 0x58, 0x88, 0xbf, 0x8f, 0x80, 0xb5, 0xb1, 0x4d, 0xa2, 0xea, 0x69, 0x1e, 0xa1, 0x21, 0xf0,
+	0xc5, 0x84, 0xdb, 0xe3, 0x04, 0x6d, 0x73, 0x6c, 0x7b, 0xae, 0x89, 0x49, 0x60, 0xc8, 0x27, 0x21,
+	0x06, 0x8b, 0xfe, 0x2b, 0xce, 0x36, 0xc4, 0xa5, 0xdd, 0x8d, 0xbc, 0xbf, 0x87, 0xd3, 0xcd, 0x3b,
+	0xef, 0x6f, 0xde, 0x79, 0xc9, 0x31, 0xed, 0xe2, 0x6d, 0x3a, 0x16, 0x7e, 0xf7, 0x77, 0x61, 0x7b,
+	0x86, 0xb7, 0x80, 0x02, 0x08, 0x0a, 0x6c, 0x8b, 0x5f, 0x47, 0xc0, 0xa2, 0xe4, 0x3a, 0xf6, 0x03,
+	0xa7, 0x0e, 0x33, 0x20, 0x11, 0x2c, 0x48, 0x2c, 0xca, 0x24, 0xba, 0xa4, 0xa9, 0xcc, 0xb4, 0x3d,
+	0xec, 0x9e, 0x6a, 0x96, 0x1f, 0xe9, 0x25, 0x4d, 0xdb, 0x72, 0xf8, 0x7c, 0xc6, 0xb8, 0xb0, 0x19,
+	0x3c, 0x88, 0xb3, 0x17, 0x6f, 0x0b, 0x2c, 0xd3, 0xa7, 0xb6, 0xa1, 0x99, 0x56, 0xc7, 0xc5, 0x84,
+	0x95, 0x70, 0x05, 0x2d, 0xb5, 0xb4, 0xee, 0x7d, 0x9f, 0x45, 0xa3, 0xb8, 0x14, 0x2f, 0x30, 0xf1,
+	0x25, 0x0d, 0xdf, 0x05, 0x57, 0x6c, 0xdc, 0xf5, 0x54, 0xb7, 0x63, 0x07, 0xa5, 0xa3, 0x57, 0x30,
+	0x86, 0x56, 0x28, 0x1b, 0x75, 0x6c, 0x5e, 0x3c, 0xf1, 0xdb, 0x08, 0x48, 0x04, 0xcf, 0x36, 0x5c,
+	0x07, 0xd1, 0xcb, 0x31, 0xb4, 0x70, 0xd1, 0x17, 0xa2, 0x4a, 0x09, 0x45, 0x4d, 0x63, 0x24, 0x15,
+	0xd1, 0xb1, 0x54, 0xac, 0x83, 0x05, 0xdf, 0x7e, 0x8c, 0xd9, 0xf7, 0xa9, 0xd1, 0x34, 0xc4, 0xa7,
+	0xa7, 0x61, 0xfe, 0xd5, 0x69, 0x78, 0x0c, 0x62, 0x0d, 0x4c, 0xe7, 0xf8, 0xff, 0xbd, 0xe8, 0xd4,
+	0xae, 0xf8, 0x53, 0xb0, 0xaa, 0x14, 0xa5, 0x9a, 0xab, 0xd9, 0xa4, 0x81, 0xdd, 0xcb, 0x44, 0xdc,
+	0x04, 0x40, 0x3f, 0xd1, 0x6c, 0x9b, 0x3e, 0x84, 0x3c, 0x21, 0xfe, 0xa0, 0x93, 0x38, 0x97, 0x0e,
+	0x3a, 0x5f, 0x41, 0x61, 0xe9, 0x21, 0xf8, 0xb3, 0x0e, 0xb6, 0x75, 0x1c, 0x74, 0x43, 0x40, 0x8f,
+	0xa4, 0x2e, 0x36, 0x9a, 0x3a, 0xd1, 0x04, 0xf3, 0x8f, 0x1c, 0x03, 0x5b, 0xf0, 0x01, 0x88, 0x3d,
+	0xc1, 0x3d, 0xfe, 0x6a, 0x16, 0x7f, 0xf0, 0x4d, 0x5f, 0xf8, 0x30, 0x74, 0x0a, 0x8f, 0x5d, 0xda,
+	0x96, 0x69, 0x7b, 0xe1, 0x9f, 0x96, 0x59, 0x27, 0x3b, 0xec, 0xd6, 0xe4, 0xf7, 0x71, 0x97, 0xad,
+	0xe7, 0x88, 0x1a, 0xa1, 0x37, 0x8b, 0x7f, 0x2d, 0x46, 0xd9, 0x1b, 0xcc, 0x89, 0x1b, 0x5f, 0x46,
+	0x01, 0x18, 0x7e, 0x75, 0xc0, 0xef, 0x83, 0xeb, 0x05, 0x49, 0x92, 0xab, 0x55, 0xb5, 0x76, 0x7c,
+	0x28, 0xab, 0x47, 0xe5, 0xea, 0xa1, 0x2c, 0x29, 0xf7, 0x15, 0xb9, 0x94, 0x9a, 0xcb, 0x6c, 0x9c,
+	0x9d, 0xe7, 0xae, 0x0d, 0x95, 0x8f, 0x6c, 0xd2, 0xc6, 0xba, 0xd9, 0x30, 0xb1, 0x01, 0x6f, 0x02,
+	0x18, 0xc6, 0x95, 0x2b, 0xc5, 0x4a, 0xe9, 0x38, 0x15, 0xc9, 0xac, 0x9d, 0x9d, 0xe7, 0x52, 0x43,
+	0x48, 0xd9, 0xa9, 0x3b, 0x46, 0x0f, 0x7e, 0x04, 0xd2, 0x61, 0xed, 0x4a, 0xf9, 0xe0, 0x58, 0x2d,
+	0x94, 0x4a, 0x48, 0xae, 0x56, 0x53, 0xd1, 0x71, 0x37, 0x15, 0xdb, 0xea, 0x15, 0x2e, 0xbf, 0x08,
+	0xaf, 0x85, 0x81, 0xf2, 0xc7, 0x32, 0x3a, 0x66, 0x9e, 0x62, 0x99, 0xeb, 0x67, 0xe7, 0xb9, 0xd5,
+	0x21, 0x4a, 0x3e, 0xc5, 0x6e, 0x8f, 0x39, 0xbb, 0x07, 0x36, 0xc3, 0x98, 0x42, 0xf9, 0x58, 0xad,
+	0xdc, 0x0f, 0xdc, 0xc9, 0xd5, 0x54, 0x3c, 0xb3, 0x79, 0x76, 0x9e, 0x4b, 0x0f, 0xa1, 0x05, 0xbb,
+	0x57, 0x69, 0x14, 0x82, 0x2f, 0xca, 0x4c, 0xe2, 0xe7, 0xbf, 0xc9, 0xce, 0x7d, 0xf1, 0xdb, 0xec,
+	0xdc, 0x8d, 0xaf, 0xa3, 0x60, 0x65, 0x64, 0xc4, 0xc3, 0x1f, 0x81, 0x4c, 0xe5, 0x50, 0x46, 0x85,
+	0x9a, 0x52, 0x29, 0x4f, 0xcb, 0x18, 0xb3, 0x3c, 0x02, 0x09, 0x27, 0xed, 0x87, 0x60, 0x63, 0x0c,
+	0x5d, 0xad, 0x55, 0x90, 0xac, 0x4a, 0x95, 0x92, 0x9c, 0x8a, 0x64, 0x32, 0x67, 0xe7, 0xb9, 0xf5,
+	0x11, 0x30, 0x9d, 0x94, 0x6c, 0x5f, 0x9c, 0xe2, 0x58, 0x29, 0x57, 0x6b, 0x85, 0x72, 0x4d, 0x29,
+	0xd4, 0xe4, 0x54, 0x74, 0x8a, 0x63, 0x65, 0xb8, 0x0c, 0xc1, 0x0f, 0xc1, 0xfa, 0x18, 0x5a, 0xfe,
+	0x54, 0x96, 0x8e, 0x6a, 0x72, 0x2a, 0x96, 0x49, 0x9f, 0x9d, 0xe7, 0xd6, 0x46, 0x90, 0x72, 0x17,
+	0xeb, 0x9d, 0xa9, 0xa8, 0x47, 0xca, 0x1e, 0xa2, 0xfe, 0xe2, 0x53, 0x50, 0x8f, 0xcc, 0xa6, 0x4b,
+	0x7d, 0x7d, 0x0f, 0x5c, 0x1f, 0x8f, 0xb4, 0x28, 0xa9, 0x55, 0xb9, 0x5c, 0x4a, 0xcd, 0x4f, 0x81,
+	0x29, 0x45, 0xa9, 0x8a, 0x6d, 0x23, 0x13, 0xa7, 0x59, 0xbf, 0xf1, 0xa7, 0x08, 0xb8, 0x32, 0xb6,
+	0x91, 0xc2, 0x0f, 0xc0, 0xfa, 0x41, 0xa1, 0x28, 0x1f, 0xa8, 0x4a, 0xb9, 0x24, 0x7f, 0xaa, 0x56,
+	0xa5, 0x0a, 0x6b, 0xb8, 0xb2, 0x9c, 0x9a, 0xe3, 0x4d, 0x30, 0x06, 0x28, 0x3b, 0x36, 0xa6, 0xa9,
+	0x9e, 0x04, 0x49, 0x48, 0x2e, 0xd4, 0x2a, 0x28, 0x48, 0xf5, 0x18, 0x4e, 0xf2, 0x17, 0xa9, 0x8f,
+	0x40, 0x7a, 0x12, 0xba, 0x77, 0x50, 0x29, 0x16, 0x0e, 0x82, 0x66, 0x1d, 0x43, 0xee, 0x59, 0x4e,
+	0x5d, 0xb3, 0xfc, 0x23, 0xfc, 0x27, 0x0e, 0x72, 0xaf, 0x5a, 0x15, 0x20, 0x06, 0xb7, 0xa5, 0x4a,
+	0xb9, 0x86, 0x0a, 0x52, 0x8d, 0x55, 0x5f, 0xdd, 0x57, 0x68, 0x2b, 0x1c, 0xab, 0x2f, 0xed, 0xae,
+	0x9d, 0xb3, 0xf3, 0xdc, 0xfb, 0xaf, 0xb2, 0x1d, 0x6e, 0xb8, 0x4f, 0xc0, 0x7b, 0x33, 0xb9, 0x51,
+	0xca, 0x4a, 0x2d, 0x15, 0xc9, 0x6c, 0x9f, 0x9d, 0xe7, 0xde, 0x7e, 0x95, 0x7d, 0xc5, 0x36, 0x3d,
+	0xf8, 0x18, 0xdc, 0x9c, 0xc9, 0x70, 0xd0, 0x30, 0xd1, 0xcc, 0xfb, 0x67, 0xe7, 0xb9, 0xef, 0xbe,
+	0xca, 0x76, 0xd0, 0x43, 0xb3, 0x9a, 0xdf, 0x93, 0xcb, 0x72, 0x55, 0xa9, 0xa6, 0x62, 0xb3, 0x99,
+	0xdf, 0xc3, 0x36, 0x26, 0x26, 0x81, 0x0d, 0x70, 0x67, 0x26, 0xf3, 0x85, 0xd2, 0x23, 0xa5, 0xac,
+	0x1e, 0x1d, 0x96, 0x78, 0xcf, 0xcf, 0x94, 0xfe, 0x02, 0x5d, 0xa1, 0x8e, 0xd8, 0x86, 0x06, 0x0d,
+	0x70, 0xfb, 0x35, 0xfc, 0x48, 0x07, 0x72, 0x01, 0xa5, 0xe6, 0x33, 0xf9, 0xb3, 0xf3, 0xdc, 0x8d,
+	0x99, 0xdc, 0x48, 0x16, 0xd6, 0x5c, 0xde, 0x76, 0xc5, 0xfd, 0x67, 0xff, 0xcc, 0xce, 0x7d, 0x71,
+	0x91, 0x8d, 0x3c, 0xbb, 0xc8, 0x46, 0xbe, 0xba, 0xc8, 0x46, 0xfe, 0x71, 0x91, 0x8d, 0xfc, 0xf2,
+	0x79, 0x76, 0xee, 0xab, 0xe7, 0xd9, 0xb9, 0xbf, 0x3e, 0xcf, 0xce, 0xfd, 0x38, 0xfc, 0x45, 0x2c,
+	0x39, 0xa4, 0xf5, 0x49, 0xf0, 0xff, 0x9e, 0xc6, 0x4e, 0x97, 0xfd, 0xe5, 0x8f, 0x62, 0x7d, 0x81,
+	0xad, 0xe4, 0x1f, 0xfc, 0x77, 0x00, 0xc3, 0x42, 0x76, 0x84, 0x1d, 0x15, 0x00, 0x00,
 }
 
 func (this *AccessTypeParam) Equal(that interface{}) bool {
@@ -661,6 +1091,42 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.InstantiateDefaultPermission != that1.InstantiateDefaultPermission {
 		return false
 	}
+	if this.MaxContractStorageBytes != that1.MaxContractStorageBytes {
+		return false
+	}
+	if this.StorageDepositDenom != that1.StorageDepositDenom {
+		return false
+	}
+	if !this.StorageDepositPrice.Equal(that1.StorageDepositPrice) {
+		return false
+	}
+	if this.EmergencyAuthority != that1.EmergencyAuthority {
+		return false
+	}
+	if this.CronBlockGasLimit != that1.CronBlockGasLimit {
+		return false
+	}
+	if this.MaxCallbacksPerBlock != that1.MaxCallbacksPerBlock {
+		return false
+	}
+	if this.MaxCallbackGasLimit != that1.MaxCallbackGasLimit {
+		return false
+	}
+	if this.CallbackFeeDenom != that1.CallbackFeeDenom {
+		return false
+	}
+	if !this.CallbackGasPrice.Equal(that1.CallbackGasPrice) {
+		return false
+	}
+	if this.EnforceCodeReuse != that1.EnforceCodeReuse {
+		return false
+	}
+	if this.EnforceUniqueLabels != that1.EnforceUniqueLabels {
+		return false
+	}
+	if this.LabelIndexScope != that1.LabelIndexScope {
+		return false
+	}
 	return true
 }
 
@@ -692,6 +1158,18 @@ func (this *CodeInfo) Equal(that interface{}) bool {
 	if !this.InstantiateConfig.Equal(&that1.InstantiateConfig) {
 		return false
 	}
+	if this.Source != that1.Source {
+		return false
+	}
+	if this.Builder != that1.Builder {
+		return false
+	}
+	if !this.Created.Equal(that1.Created) {
+		return false
+	}
+	if this.WasmSize != that1.WasmSize {
+		return false
+	}
 	return true
 }
 
@@ -735,6 +1213,9 @@ func (this *ContractInfo) Equal(that interface{}) bool {
 	if !this.Extension.Equal(that1.Extension) {
 		return false
 	}
+	if this.IsFrozen != that1.IsFrozen {
+		return false
+	}
 	return true
 }
 
@@ -769,6 +1250,12 @@ func (this *ContractCodeHistoryEntry) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Msg, that1.Msg) {
 		return false
 	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.Admin != that1.Admin {
+		return false
+	}
 	return true
 }
 
@@ -800,14 +1287,14 @@ func (this *AbsoluteTxPosition) Equal(that interface{}) bool {
 	return true
 }
 
-func (this *Model) Equal(that interface{}) bool {
+func (this *ContractStorageStats) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Model)
+	that1, ok := that.(*ContractStorageStats)
 	if !ok {
-		that2, ok := that.(Model)
+		that2, ok := that.(ContractStorageStats)
 		if ok {
 			that1 = &that2
 		} else {
@@ -819,86 +1306,241 @@ func (this *Model) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.Key, that1.Key) {
+	if this.Bytes != that1.Bytes {
 		return false
 	}
-	if !bytes.Equal(this.Value, that1.Value) {
+	if this.Entries != that1.Entries {
 		return false
 	}
-	return true
-}
-
-func (m *AccessTypeParam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(this.Deposit) != len(that1.Deposit) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AccessTypeParam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AccessTypeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x8
+	for i := range this.Deposit {
+		if !this.Deposit[i].Equal(&that1.Deposit[i]) {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
+	return true
 }
 
-func (m *AccessConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *CronJob) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AccessConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *AccessConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+	that1, ok := that.(*CronJob)
+	if !ok {
+		that2, ok := that.(CronJob)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Permission != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Permission))
-		i--
-		dAtA[i] = 0x8
+	if this.Contract != that1.Contract {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if !bytes.Equal(this.Msg, that1.Msg) {
+		return false
+	}
+	if this.MaxFailures != that1.MaxFailures {
+		return false
+	}
+	if this.Failures != that1.Failures {
+		return false
+	}
+	if this.NextRunHeight != that1.NextRunHeight {
+		return false
+	}
+	return true
+}
+
+func (this *Callback) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Callback)
+	if !ok {
+		that2, ok := that.(Callback)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if !bytes.Equal(this.Msg, that1.Msg) {
+		return false
+	}
+	if len(this.Fee) != len(that1.Fee) {
+		return false
+	}
+	for i := range this.Fee {
+		if !this.Fee[i].Equal(&that1.Fee[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *IBCTransferCallback) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*IBCTransferCallback)
+	if !ok {
+		that2, ok := that.(IBCTransferCallback)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ChannelID != that1.ChannelID {
+		return false
+	}
+	if this.Sequence != that1.Sequence {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	return true
+}
+
+func (this *Model) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Model)
+	if !ok {
+		that2, ok := that.(Model)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
+	}
+	if !bytes.Equal(this.Value, that1.Value) {
+		return false
+	}
+	return true
+}
+
+func (m *AccessTypeParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessTypeParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessTypeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Permission != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -920,6 +1562,92 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LabelIndexScope != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LabelIndexScope))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.EnforceUniqueLabels {
+		i--
+		if m.EnforceUniqueLabels {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.EnforceCodeReuse {
+		i--
+		if m.EnforceCodeReuse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.CallbackGasPrice.Size()
+		i -= size
+		if _, err := m.CallbackGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.CallbackFeeDenom) > 0 {
+		i -= len(m.CallbackFeeDenom)
+		copy(dAtA[i:], m.CallbackFeeDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CallbackFeeDenom)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.MaxCallbackGasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxCallbackGasLimit))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxCallbacksPerBlock != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxCallbacksPerBlock))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CronBlockGasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CronBlockGasLimit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.EmergencyAuthority) > 0 {
+		i -= len(m.EmergencyAuthority)
+		copy(dAtA[i:], m.EmergencyAuthority)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EmergencyAuthority)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.StorageDepositPrice.Size()
+		i -= size
+		if _, err := m.StorageDepositPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.StorageDepositDenom) > 0 {
+		i -= len(m.StorageDepositDenom)
+		copy(dAtA[i:], m.StorageDepositDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.StorageDepositDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxContractStorageBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxContractStorageBytes))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.InstantiateDefaultPermission != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.InstantiateDefaultPermission))
 		i--
@@ -958,6 +1686,37 @@ func (m *CodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WasmSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WasmSize))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Created != nil {
+		{
+			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Builder) > 0 {
+		i -= len(m.Builder)
+		copy(dAtA[i:], m.Builder)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Builder)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x32
+	}
 	{
 		size, err := m.InstantiateConfig.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1005,6 +1764,16 @@ func (m *ContractInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IsFrozen {
+		i--
+		if m.IsFrozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.Extension != nil {
 		{
 			size, err := m.Extension.MarshalToSizedBuffer(dAtA[:i])
@@ -1085,6 +1854,20 @@ func (m *ContractCodeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Msg) > 0 {
 		i -= len(m.Msg)
 		copy(dAtA[i:], m.Msg)
@@ -1150,7 +1933,7 @@ func (m *AbsoluteTxPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Model) Marshal() (dAtA []byte, err error) {
+func (m *ContractStorageStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1160,17 +1943,234 @@ func (m *Model) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Model) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContractStorageStats) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContractStorageStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Entries != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Entries))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Bytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Bytes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CronJob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextRunHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NextRunHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Failures != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Failures))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxFailures != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxFailures))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Callback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Callback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Callback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IBCTransferCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCTransferCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCTransferCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Model) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Model) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
 		i -= len(m.Value)
 		copy(dAtA[i:], m.Value)
 		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
@@ -1244,6 +2244,43 @@ func (m *Params) Size() (n int) {
 	if m.InstantiateDefaultPermission != 0 {
 		n += 1 + sovTypes(uint64(m.InstantiateDefaultPermission))
 	}
+	if m.MaxContractStorageBytes != 0 {
+		n += 1 + sovTypes(uint64(m.MaxContractStorageBytes))
+	}
+	l = len(m.StorageDepositDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.StorageDepositPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.EmergencyAuthority)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CronBlockGasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.CronBlockGasLimit))
+	}
+	if m.MaxCallbacksPerBlock != 0 {
+		n += 1 + sovTypes(uint64(m.MaxCallbacksPerBlock))
+	}
+	if m.MaxCallbackGasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.MaxCallbackGasLimit))
+	}
+	l = len(m.CallbackFeeDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.CallbackGasPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.EnforceCodeReuse {
+		n += 2
+	}
+	if m.EnforceUniqueLabels {
+		n += 2
+	}
+	if m.LabelIndexScope != 0 {
+		n += 1 + sovTypes(uint64(m.LabelIndexScope))
+	}
 	return n
 }
 
@@ -1263,6 +2300,21 @@ func (m *CodeInfo) Size() (n int) {
 	}
 	l = m.InstantiateConfig.Size()
 	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Builder)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Created != nil {
+		l = m.Created.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.WasmSize != 0 {
+		n += 1 + sovTypes(uint64(m.WasmSize))
+	}
 	return n
 }
 
@@ -1299,6 +2351,9 @@ func (m *ContractInfo) Size() (n int) {
 		l = m.Extension.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.IsFrozen {
+		n += 2
+	}
 	return n
 }
 
@@ -1322,6 +2377,14 @@ func (m *ContractCodeHistoryEntry) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1340,6 +2403,111 @@ func (m *AbsoluteTxPosition) Size() (n int) {
 	return n
 }
 
+func (m *ContractStorageStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Bytes != 0 {
+		n += 1 + sovTypes(uint64(m.Bytes))
+	}
+	if m.Entries != 0 {
+		n += 1 + sovTypes(uint64(m.Entries))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CronJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTypes(uint64(m.Interval))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.GasLimit))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.MaxFailures != 0 {
+		n += 1 + sovTypes(uint64(m.MaxFailures))
+	}
+	if m.Failures != 0 {
+		n += 1 + sovTypes(uint64(m.Failures))
+	}
+	if m.NextRunHeight != 0 {
+		n += 1 + sovTypes(uint64(m.NextRunHeight))
+	}
+	return n
+}
+
+func (m *Callback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovTypes(uint64(m.ID))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.GasLimit))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IBCTransferCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTypes(uint64(m.Sequence))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *Model) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1375,30 +2543,1124 @@ func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTypes
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessTypeParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessTypeParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *AccessConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeUploadAccess", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CodeUploadAccess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateDefaultPermission", wireType)
+			}
+			m.InstantiateDefaultPermission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstantiateDefaultPermission |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxContractStorageBytes", wireType)
+			}
+			m.MaxContractStorageBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxContractStorageBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageDepositDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageDepositDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageDepositPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StorageDepositPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmergencyAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronBlockGasLimit", wireType)
+			}
+			m.CronBlockGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CronBlockGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCallbacksPerBlock", wireType)
+			}
+			m.MaxCallbacksPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCallbacksPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCallbackGasLimit", wireType)
+			}
+			m.MaxCallbackGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCallbackGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackFeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackFeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CallbackGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnforceCodeReuse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnforceCodeReuse = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnforceUniqueLabels", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnforceUniqueLabels = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelIndexScope", wireType)
+			}
+			m.LabelIndexScope = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LabelIndexScope |= LabelIndexScope(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *CodeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantiateConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Builder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Builder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &AbsoluteTxPosition{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmSize", wireType)
+			}
+			m.WasmSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WasmSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ContractInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &AbsoluteTxPosition{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCPortID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IBCPortID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Extension == nil {
+				m.Extension = &types.Any{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AccessTypeParam: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessTypeParam: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Extension.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFrozen", wireType)
 			}
-			m.Value = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1408,11 +3670,12 @@ func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= AccessType(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IsFrozen = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1435,7 +3698,7 @@ func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *AccessConfig) Unmarshal(dAtA []byte) error {
+func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1458,17 +3721,17 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccessConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractCodeHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractCodeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
-			m.Permission = 0
+			m.Operation = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1478,14 +3741,103 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Permission |= AccessType(b&0x7F) << shift
+				m.Operation |= ContractCodeHistoryOperationType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Updated == nil {
+				m.Updated = &AbsoluteTxPosition{}
+			}
+			if err := m.Updated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1513,11 +3865,11 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1545,7 +3897,7 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1569,7 +3921,7 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1592,17 +3944,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: AbsoluteTxPosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AbsoluteTxPosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeUploadAccess", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var msglen int
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1612,30 +3964,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CodeUploadAccess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateDefaultPermission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
 			}
-			m.InstantiateDefaultPermission = 0
+			m.TxIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1645,7 +3983,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InstantiateDefaultPermission |= AccessType(b&0x7F) << shift
+				m.TxIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1672,7 +4010,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *CodeInfo) Unmarshal(dAtA []byte) error {
+func (m *ContractStorageStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1695,17 +4033,17 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CodeInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractStorageStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractStorageStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
 			}
-			var byteLen int
+			m.Bytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1715,31 +4053,16 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Bytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.CodeHash == nil {
-				m.CodeHash = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			m.Entries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1749,27 +4072,14 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Entries |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1796,7 +4106,8 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InstantiateConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Deposit = append(m.Deposit, types1.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1822,7 +4133,7 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *ContractInfo) Unmarshal(dAtA []byte) error {
+func (m *CronJob) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1845,34 +4156,15 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronJob: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronJob: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
-			}
-			m.CodeID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1900,13 +4192,13 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			var stringLen uint64
+			m.Interval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1916,29 +4208,35 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Interval |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1948,29 +4246,31 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFailures", wireType)
 			}
-			var msglen int
+			m.MaxFailures = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1980,33 +4280,16 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxFailures |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Created == nil {
-				m.Created = &AbsoluteTxPosition{}
-			}
-			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IBCPortID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
 			}
-			var stringLen uint64
+			m.Failures = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2016,29 +4299,16 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Failures |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IBCPortID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRunHeight", wireType)
 			}
-			var msglen int
+			m.NextRunHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2048,28 +4318,11 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NextRunHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Extension == nil {
-				m.Extension = &types.Any{}
-			}
-			if err := m.Extension.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2092,7 +4345,7 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
+func (m *Callback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2115,17 +4368,17 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCodeHistoryEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: Callback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCodeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Callback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			m.Operation = 0
+			m.ID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2135,16 +4388,16 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Operation |= ContractCodeHistoryOperationType(b&0x7F) << shift
+				m.ID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			m.CodeID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2154,16 +4407,67 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2173,33 +4477,31 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Updated == nil {
-				m.Updated = &AbsoluteTxPosition{}
-			}
-			if err := m.Updated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2209,24 +4511,24 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
-			if m.Msg == nil {
-				m.Msg = []byte{}
+			m.Fee = append(m.Fee, types1.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -2251,7 +4553,7 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
+func (m *IBCTransferCallback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2274,17 +4576,17 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AbsoluteTxPosition: wiretype end group for non-group")
+			return fmt.Errorf("proto: IBCTransferCallback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AbsoluteTxPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IBCTransferCallback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2294,16 +4596,29 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			m.TxIndex = 0
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2313,11 +4628,43 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxIndex |= uint64(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -109,6 +109,23 @@ func TestCodeInfoValidateBasic(t *testing.T) {
 			srcMutator: func(c *CodeInfo) { c.InstantiateConfig = AccessConfig{} },
 			expError:   true,
 		},
+		"with metadata": {
+			srcMutator: func(c *CodeInfo) {
+				c.Source = "https://example.com/foo.tar.gz"
+				c.Builder = "cosmwasm/rust-optimizer:0.12.9"
+			},
+		},
+		"source without builder": {
+			srcMutator: func(c *CodeInfo) { c.Source = "https://example.com/foo.tar.gz" },
+			expError:   true,
+		},
+		"builder invalid": {
+			srcMutator: func(c *CodeInfo) {
+				c.Source = "https://example.com/foo.tar.gz"
+				c.Builder = "INVALID!"
+			},
+			expError: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -733,6 +750,57 @@ func TestContractCodeHistoryEntryValidation(t *testing.T) {
 			}),
 			expErr: true,
 		},
+		"with sender and admin": {
+			src: ContractCodeHistoryEntryFixture(func(entry *ContractCodeHistoryEntry) {
+				entry.Sender = sdk.AccAddress(randBytes(ContractAddrLen)).String()
+				entry.Admin = sdk.AccAddress(randBytes(ContractAddrLen)).String()
+			}),
+		},
+		"invalid sender": {
+			src: ContractCodeHistoryEntryFixture(func(entry *ContractCodeHistoryEntry) {
+				entry.Sender = "invalid"
+			}),
+			expErr: true,
+		},
+		"invalid admin": {
+			src: ContractCodeHistoryEntryFixture(func(entry *ContractCodeHistoryEntry) {
+				entry.Admin = "invalid"
+			}),
+			expErr: true,
+		},
+		"invalid msg": {
+			src: ContractCodeHistoryEntryFixture(func(entry *ContractCodeHistoryEntry) {
+				entry.Msg = []byte("invalid")
+			}),
+			expErr: true,
+		},
+		"admin update without msg": {
+			src: ContractCodeHistoryEntryFixture(func(entry *ContractCodeHistoryEntry) {
+				entry.Operation = ContractCodeHistoryOperationTypeAdminUpdate
+				entry.Msg = nil
+				entry.Admin = sdk.AccAddress(randBytes(ContractAddrLen)).String()
+			}),
+		},
+		"admin clear without msg": {
+			src: ContractCodeHistoryEntryFixture(func(entry *ContractCodeHistoryEntry) {
+				entry.Operation = ContractCodeHistoryOperationTypeAdminClear
+				entry.Msg = nil
+			}),
+		},
+		"admin update with msg": {
+			src: ContractCodeHistoryEntryFixture(func(entry *ContractCodeHistoryEntry) {
+				entry.Operation = ContractCodeHistoryOperationTypeAdminUpdate
+				entry.Admin = sdk.AccAddress(randBytes(ContractAddrLen)).String()
+			}),
+			expErr: true,
+		},
+		"admin clear with msg": {
+			src: ContractCodeHistoryEntryFixture(func(entry *ContractCodeHistoryEntry) {
+				entry.Operation = ContractCodeHistoryOperationTypeAdminClear
+				entry.Msg = []byte("null")
+			}),
+			expErr: true,
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
@@ -745,3 +813,118 @@ func TestContractCodeHistoryEntryValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestContractCodeHistoryEntryJSONRoundTrip(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	specs := map[string]ContractCodeHistoryEntry{
+		"init with msg": ContractCodeHistoryEntryFixture(),
+		"admin clear without msg": ContractCodeHistoryEntryFixture(func(entry *ContractCodeHistoryEntry) {
+			entry.Operation = ContractCodeHistoryOperationTypeAdminClear
+			entry.Msg = nil
+		}),
+	}
+	for name, src := range specs {
+		t.Run(name, func(t *testing.T) {
+			bz, err := cdc.MarshalJSON(&src)
+			require.NoError(t, err)
+			var got ContractCodeHistoryEntry
+			require.NoError(t, cdc.UnmarshalJSON(bz, &got))
+			assert.Equal(t, src, got)
+			assert.NoError(t, got.ValidateBasic())
+		})
+	}
+}
+
+func TestParseContractCodeHistoryOperationType(t *testing.T) {
+	specs := map[string]struct {
+		src    string
+		exp    ContractCodeHistoryOperationType
+		expErr bool
+	}{
+		"proto name": {
+			src: "CONTRACT_CODE_HISTORY_OPERATION_TYPE_MIGRATE",
+			exp: ContractCodeHistoryOperationTypeMigrate,
+		},
+		"short name": {
+			src: "admin_update",
+			exp: ContractCodeHistoryOperationTypeAdminUpdate,
+		},
+		"short name with dash": {
+			src: "admin-clear",
+			exp: ContractCodeHistoryOperationTypeAdminClear,
+		},
+		"mixed case": {
+			src: "Init",
+			exp: ContractCodeHistoryOperationTypeInit,
+		},
+		"unspecified": {
+			src:    "unspecified",
+			expErr: true,
+		},
+		"unknown": {
+			src:    "foo",
+			expErr: true,
+		},
+		"empty": {
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := ParseContractCodeHistoryOperationType(spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestParseOperationType(t *testing.T) {
+	specs := map[string]struct {
+		src    string
+		exp    OperationType
+		expErr bool
+	}{
+		"proto name": {
+			src: "OPERATION_TYPE_EXECUTE",
+			exp: OperationTypeExecute,
+		},
+		"short name": {
+			src: "store_code",
+			exp: OperationTypeStoreCode,
+		},
+		"short name with dash": {
+			src: "ibc-send",
+			exp: OperationTypeIBCSend,
+		},
+		"mixed case": {
+			src: "Migrate",
+			exp: OperationTypeMigrate,
+		},
+		"unspecified": {
+			src:    "unspecified",
+			expErr: true,
+		},
+		"unknown": {
+			src:    "foo",
+			expErr: true,
+		},
+		"empty": {
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := ParseOperationType(spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
@@ -58,17 +58,8 @@ func ValidateSalt(salt []byte) error {
 func ValidateVerificationInfo(source, builder string, codeHash []byte) error {
 	// if any set require others to be set
 	if len(source) != 0 || len(builder) != 0 || codeHash != nil {
-		if source == "" {
-			return fmt.Errorf("source is required")
-		}
-		if _, err := url.ParseRequestURI(source); err != nil {
-			return fmt.Errorf("source: %s", err)
-		}
-		if builder == "" {
-			return fmt.Errorf("builder is required")
-		}
-		if _, err := reference.ParseDockerRef(builder); err != nil {
-			return fmt.Errorf("builder: %s", err)
+		if err := ValidateCodeMetadata(source, builder); err != nil {
+			return err
 		}
 		if codeHash == nil {
 			return fmt.Errorf("code hash is required")
@@ -77,3 +68,20 @@ func ValidateVerificationInfo(source, builder string, codeHash []byte) error {
 	}
 	return nil
 }
+
+// ValidateCodeMetadata ensure source and builder are set and well formed
+func ValidateCodeMetadata(source, builder string) error {
+	if source == "" {
+		return fmt.Errorf("source is required")
+	}
+	if _, err := url.ParseRequestURI(source); err != nil {
+		return fmt.Errorf("source: %s", err)
+	}
+	if builder == "" {
+		return fmt.Errorf("builder is required")
+	}
+	if _, err := reference.ParseDockerRef(builder); err != nil {
+		return fmt.Errorf("builder: %s", err)
+	}
+	return nil
+}
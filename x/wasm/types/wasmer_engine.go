@@ -136,6 +136,10 @@ type WasmerEngine interface {
 	// rust library
 	GetCode(code wasmvm.Checksum) (wasmvm.WasmCode, error)
 
+	// RemoveCode removes the wasm code and its compiled artifacts for the given checksum
+	// from the disk cache.
+	RemoveCode(checksum wasmvm.Checksum) error
+
 	// Cleanup should be called when no longer using this to free resources on the rust-side
 	Cleanup()
 